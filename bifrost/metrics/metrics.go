@@ -37,6 +37,8 @@ const (
 	SignerError   MetricName = `signer_error`
 
 	PubKeyManagerError MetricName = `pubkey_manager_error`
+
+	SolvencyDiscrepancy MetricName = `solvency_discrepancy`
 )
 
 // Metrics used to provide promethus metrics
@@ -132,6 +134,14 @@ var (
 		}, []string{
 			"error_name", "additional",
 		}),
+		SolvencyDiscrepancy: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "observer",
+			Subsystem: "solvency",
+			Name:      "discrepancy",
+			Help:      "number of times a vault was observed holding less than THORChain expect",
+		}, []string{
+			"chain", "asset",
+		}),
 	}
 
 	histograms = map[MetricName]prometheus.Histogram{
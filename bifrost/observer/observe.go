@@ -38,6 +38,8 @@ type Observer struct {
 	m                 *metrics.Metrics
 	errCounter        *prometheus.CounterVec
 	thorchainBridge   *thorclient.ThorchainBridge
+	// the external chain height the vault balances were last reported at
+	lastSolvencyHeights map[common.Chain]int64
 }
 
 // NewObserver create a new instance of Observer for chain
@@ -54,6 +56,8 @@ func NewObserver(pubkeyMgr pubkeymanager.PubKeyValidator, chains map[common.Chai
 		globalErrataQueue: make(chan types.ErrataBlock),
 		errCounter:        m.GetCounterVec(metrics.ObserverError),
 		thorchainBridge:   thorchainBridge,
+
+		lastSolvencyHeights: make(map[common.Chain]int64),
 	}, nil
 }
 
@@ -73,6 +77,7 @@ func (o *Observer) Start() error {
 	go o.processTxIns()
 	go o.processErrataTx()
 	go o.deck()
+	go o.reportSolvency()
 	return nil
 }
 
@@ -0,0 +1,207 @@
+package observer
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"gitlab.com/thorchain/thornode/bifrost/metrics"
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+// solvencyReportBlocks is how often , in blocks of the external chain , bifrost report the balances of the vaults ,
+// all the nodes vote for the same height , the balance they observed might differ a little , THORChain aggregate the reported balances
+var solvencyReportBlocks = map[common.Chain]int64{
+	common.BNBChain: 100,
+	common.BTCChain: 1,
+	common.ETHChain: 10,
+}
+
+// nativeDecimals is the number of decimals of the balances the chain clients report , THORChain use 1e8 for every asset ,
+// the chains not listed here report their balances with 8 decimals already
+var nativeDecimals = map[common.Chain]int64{
+	common.ETHChain: 18,
+}
+
+// nativeBalanceClient is implemented by the chain clients whose native balance can be too large for the uint64 amounts of GetAccount
+type nativeBalanceClient interface {
+	GetBalance(pk common.PubKey) (*big.Int, error)
+}
+
+const (
+	thorchainDecimals           int64 = 8
+	defaultSolvencyReportBlocks int64 = 10
+	// defaultSolvencyThresholdBasisPoints is used when THORChain can't be asked for the threshold
+	defaultSolvencyThresholdBasisPoints int64 = 100
+)
+
+func getSolvencyReportBlocks(chain common.Chain) int64 {
+	if blocks, ok := solvencyReportBlocks[chain]; ok {
+		return blocks
+	}
+	return defaultSolvencyReportBlocks
+}
+
+func (o *Observer) reportSolvency() {
+	for {
+		select {
+		case <-o.stopChan:
+			return
+		case <-time.After(constants.ThorchainBlockTime):
+			o.sendSolvency()
+		}
+	}
+}
+
+// sendSolvency report the balances of all the vaults on every chain that reached the next report height
+func (o *Observer) sendSolvency() {
+	threshold := int64(-1)
+	for _, chainClient := range o.chains {
+		chain := chainClient.GetChain()
+		height, err := chainClient.GetHeight()
+		if err != nil {
+			o.logger.Error().Err(err).Str("chain", chain.String()).Msg("fail to get chain height")
+			continue
+		}
+		blocks := getSolvencyReportBlocks(chain)
+		reportHeight := height - height%blocks
+		if reportHeight <= o.lastSolvencyHeights[chain] {
+			continue
+		}
+		o.lastSolvencyHeights[chain] = reportHeight
+		if threshold < 0 {
+			threshold = o.getSolvencyThreshold()
+		}
+		for _, pk := range o.pubkeyMgr.GetPubKeys() {
+			if err := o.sendVaultSolvency(chainClient, pk, reportHeight, threshold); err != nil {
+				o.errCounter.WithLabelValues("fail_to_send_solvency", chain.String()).Inc()
+				o.logger.Error().Err(err).Str("chain", chain.String()).Str("pubkey", pk.String()).Msg("fail to send solvency")
+			}
+		}
+	}
+}
+
+// sendVaultSolvency send the balance of the vault observed on chain to THORChain , and compare it with what THORChain expect
+func (o *Observer) sendVaultSolvency(chainClient chainclients.ChainClient, pk common.PubKey, height, threshold int64) error {
+	chain := chainClient.GetChain()
+	coins, err := getVaultCoins(chainClient, pk)
+	if err != nil {
+		return err
+	}
+	txID, err := o.thorchainBridge.PostSolvency(chain, pk, coins, height)
+	if err != nil {
+		return fmt.Errorf("fail to post solvency to thorchain: %w", err)
+	}
+	o.logger.Info().Str("chain", chain.String()).Str("pubkey", pk.String()).Int64("height", height).Str("thorchain hash", txID.String()).Msg("send solvency to thorchain")
+
+	vault, err := o.thorchainBridge.GetVault(chain, chainClient.GetAddress(pk))
+	if err != nil {
+		return fmt.Errorf("fail to get vault: %w", err)
+	}
+	for _, coin := range vault.Coins {
+		if !coin.Asset.Chain.Equals(chain) {
+			continue
+		}
+		observed := coins.GetCoin(coin.Asset).Amount
+		if !isInsolvent(coin.Amount, observed, threshold) {
+			continue
+		}
+		o.m.GetCounterVec(metrics.SolvencyDiscrepancy).WithLabelValues(chain.String(), coin.Asset.String()).Inc()
+		o.logger.Warn().Str("chain", chain.String()).Str("pubkey", pk.String()).Str("asset", coin.Asset.String()).
+			Str("expected", coin.Amount.String()).Str("observed", observed.String()).Msg("vault holds less than THORChain expect")
+	}
+	return nil
+}
+
+// getSolvencyThreshold ask THORChain how much a vault can be short before it is considered insolvent ,
+// SolvencyThresholdBasisPoints mimir takes precedence over the constant the same way it does on THORChain
+func (o *Observer) getSolvencyThreshold() int64 {
+	threshold, err := o.thorchainBridge.GetMimir(constants.SolvencyThresholdBasisPoints.String())
+	if err != nil {
+		o.logger.Error().Err(err).Msg("fail to get mimir from thorchain")
+	}
+	if err == nil && threshold >= 0 {
+		return threshold
+	}
+	values, err := o.thorchainBridge.GetConstants()
+	if err != nil {
+		o.logger.Error().Err(err).Msg("fail to get constants from thorchain")
+		return defaultSolvencyThresholdBasisPoints
+	}
+	if threshold, ok := values[constants.SolvencyThresholdBasisPoints.String()]; ok {
+		return threshold
+	}
+	return defaultSolvencyThresholdBasisPoints
+}
+
+// getVaultCoins return the balances of the vault observed on chain , in 1e8
+func getVaultCoins(chainClient chainclients.ChainClient, pk common.PubKey) (common.Coins, error) {
+	chain := chainClient.GetChain()
+	if client, ok := chainClient.(nativeBalanceClient); ok {
+		balance, err := client.GetBalance(pk)
+		if err != nil {
+			return nil, fmt.Errorf("fail to get balance: %w", err)
+		}
+		coins := make(common.Coins, 0)
+		amount := convertToThorchainDecimals(chain, cosmos.NewUintFromBigInt(balance))
+		if !amount.IsZero() {
+			coins = append(coins, common.NewCoin(chain.GetGasAsset(), amount))
+		}
+		return coins, nil
+	}
+	account, err := chainClient.GetAccount(pk)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get account: %w", err)
+	}
+	coins, err := getAccountCoins(chain, account.Coins)
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse account coins: %w", err)
+	}
+	return coins, nil
+}
+
+// getAccountCoins convert the coins of an account on the given chain to coins THORChain understand , empty balances are left out
+func getAccountCoins(chain common.Chain, accountCoins common.AccountCoins) (common.Coins, error) {
+	coins := make(common.Coins, 0)
+	for _, item := range accountCoins {
+		amount := convertToThorchainDecimals(chain, cosmos.NewUint(item.Amount))
+		if amount.IsZero() {
+			continue
+		}
+		denom := item.Denom
+		if !strings.Contains(denom, ".") {
+			denom = fmt.Sprintf("%s.%s", chain, denom)
+		}
+		asset, err := common.NewAsset(denom)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse asset(%s): %w", item.Denom, err)
+		}
+		coins = append(coins, common.NewCoin(asset, amount))
+	}
+	return coins, nil
+}
+
+// convertToThorchainDecimals convert an amount in the native decimals of the given chain to 1e8 , the dust below 1e8 is dropped
+func convertToThorchainDecimals(chain common.Chain, amount cosmos.Uint) cosmos.Uint {
+	decimals, ok := nativeDecimals[chain]
+	if !ok || decimals == thorchainDecimals {
+		return amount
+	}
+	if decimals > thorchainDecimals {
+		return amount.Quo(cosmos.NewUintFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals-thorchainDecimals), nil)))
+	}
+	return amount.Mul(cosmos.NewUintFromBigInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(thorchainDecimals-decimals), nil)))
+}
+
+// isInsolvent return true when the observed balance is short of the expected balance by more than the given basis points
+func isInsolvent(expected, observed cosmos.Uint, threshold int64) bool {
+	if observed.GTE(expected) {
+		return false
+	}
+	shortfall := common.SafeSub(expected, observed)
+	return shortfall.MulUint64(10000).GT(expected.MulUint64(uint64(threshold)))
+}
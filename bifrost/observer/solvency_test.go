@@ -0,0 +1,102 @@
+package observer
+
+import (
+	"math/big"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/bifrost/pkg/chainclients"
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/x/thorchain"
+)
+
+type SolvencySuite struct{}
+
+var _ = Suite(&SolvencySuite{})
+
+type solvencyTestChain struct {
+	chainclients.ChainClient
+	chain   common.Chain
+	account common.Account
+}
+
+func (t solvencyTestChain) GetChain() common.Chain { return t.chain }
+
+func (t solvencyTestChain) GetAccount(pk common.PubKey) (common.Account, error) {
+	return t.account, nil
+}
+
+type solvencyTestNativeChain struct {
+	solvencyTestChain
+	balance *big.Int
+}
+
+func (t solvencyTestNativeChain) GetBalance(pk common.PubKey) (*big.Int, error) {
+	return t.balance, nil
+}
+
+func (s *SolvencySuite) TestGetAccountCoins(c *C) {
+	coins, err := getAccountCoins(common.BNBChain, common.AccountCoins{
+		{Amount: 100, Denom: "BNB"},
+		{Amount: 0, Denom: "LOK-3C0"},
+		{Amount: 200, Denom: "RUNE-A1F"},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 2)
+	c.Check(coins[0].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(coins[0].Amount.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(coins[1].Asset.Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(coins[1].Asset.Symbol.String(), Equals, "RUNE-A1F")
+
+	coins, err = getAccountCoins(common.BTCChain, common.AccountCoins{
+		{Amount: 100, Denom: common.BTCAsset.String()},
+	})
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 1)
+	c.Check(coins[0].Asset.Equals(common.BTCAsset), Equals, true)
+}
+
+func (s *SolvencySuite) TestGetVaultCoins(c *C) {
+	pk := thorchain.GetRandomPubKey()
+	coins, err := getVaultCoins(solvencyTestChain{
+		chain:   common.BNBChain,
+		account: common.NewAccount(0, 0, common.AccountCoins{{Amount: 100, Denom: "BNB"}}, false),
+	}, pk)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 1)
+	c.Check(coins[0].Amount.Equal(cosmos.NewUint(100)), Equals, true)
+
+	// 1000 ETH in wei doesn't fit in uint64 , it is reported in 1e8
+	balance, ok := new(big.Int).SetString("1000000000000000000001", 10)
+	c.Assert(ok, Equals, true)
+	coins, err = getVaultCoins(solvencyTestNativeChain{
+		solvencyTestChain: solvencyTestChain{chain: common.ETHChain},
+		balance:           balance,
+	}, pk)
+	c.Assert(err, IsNil)
+	c.Assert(coins, HasLen, 1)
+	c.Check(coins[0].Asset.Equals(common.ETHAsset), Equals, true)
+	c.Check(coins[0].Amount.Equal(cosmos.NewUint(1000*common.One)), Equals, true)
+
+	// less than 1e-8 ETH is dust
+	coins, err = getVaultCoins(solvencyTestNativeChain{
+		solvencyTestChain: solvencyTestChain{chain: common.ETHChain},
+		balance:           big.NewInt(9999999999),
+	}, pk)
+	c.Assert(err, IsNil)
+	c.Check(coins, HasLen, 0)
+
+	c.Check(convertToThorchainDecimals(common.BTCChain, cosmos.NewUint(100)).Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(convertToThorchainDecimals(common.ETHChain, cosmos.NewUint(common.One*10000000000)).Equal(cosmos.NewUint(common.One)), Equals, true)
+}
+
+func (s *SolvencySuite) TestIsInsolvent(c *C) {
+	c.Check(isInsolvent(cosmos.NewUint(100), cosmos.NewUint(100), 0), Equals, false)
+	c.Check(isInsolvent(cosmos.NewUint(100), cosmos.NewUint(120), 0), Equals, false)
+	c.Check(isInsolvent(cosmos.NewUint(100), cosmos.NewUint(99), 100), Equals, false)
+	c.Check(isInsolvent(cosmos.NewUint(100), cosmos.NewUint(98), 100), Equals, true)
+	c.Check(isInsolvent(cosmos.NewUint(100), cosmos.ZeroUint(), 100), Equals, true)
+	c.Check(getSolvencyReportBlocks(common.BNBChain), Equals, int64(100))
+	c.Check(getSolvencyReportBlocks(common.THORChain), Equals, defaultSolvencyReportBlocks)
+}
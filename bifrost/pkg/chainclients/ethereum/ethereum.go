@@ -256,7 +256,7 @@ func (c *Client) GetAccount(pkey common.PubKey) (common.Account, error) {
 	if err != nil {
 		return common.Account{}, err
 	}
-	balance, err := c.client.BalanceAt(context.Background(), ecommon.HexToAddress(addr), nil)
+	balance, err := c.GetBalance(pkey)
 	if err != nil {
 		return common.Account{}, fmt.Errorf("fail to get account nonce: %w", err)
 	}
@@ -264,6 +264,12 @@ func (c *Client) GetAccount(pkey common.PubKey) (common.Account, error) {
 	return account, nil
 }
 
+// GetBalance gets the balance of the given pubkey in wei , which can be too large for the amounts of GetAccount
+func (c *Client) GetBalance(pkey common.PubKey) (*big.Int, error) {
+	addr := c.GetAddress(pkey)
+	return c.client.BalanceAt(context.Background(), ecommon.HexToAddress(addr), nil)
+}
+
 func (c *Client) GetAccountByAddress(address string) (common.Account, error) {
 	return common.Account{}, nil
 }
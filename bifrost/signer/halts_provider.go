@@ -0,0 +1,53 @@
+package signer
+
+import (
+	"fmt"
+	"sync"
+
+	"gitlab.com/thorchain/thornode/bifrost/thorclient"
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// HaltsProvider which will query thorchain to get the chain halts
+// the halts are cached internally , and only requested again once thorchain produced a new block
+type HaltsProvider struct {
+	requestHeight int64 // the block height last request to thorchain to retrieve the halts
+	bridge        *thorclient.ThorchainBridge
+	haltsLock     *sync.Mutex
+	halts         map[common.Chain]bool // whether the chain is halted , cached in memory
+}
+
+// NewHaltsProvider create a new instance of HaltsProvider
+func NewHaltsProvider(bridge *thorclient.ThorchainBridge) *HaltsProvider {
+	return &HaltsProvider{
+		halts:         make(map[common.Chain]bool),
+		requestHeight: 0,
+		bridge:        bridge,
+		haltsLock:     &sync.Mutex{},
+	}
+}
+
+// IsChainHalted check whether the given chain had been halted by thorchain at the given block height
+func (hp *HaltsProvider) IsChainHalted(thorchainBlockHeight int64, chain common.Chain) (bool, error) {
+	hp.haltsLock.Lock()
+	defer hp.haltsLock.Unlock()
+	if hp.requestHeight == 0 || hp.requestHeight != thorchainBlockHeight {
+		if err := hp.getHaltsFromThorchain(thorchainBlockHeight); err != nil {
+			return false, fmt.Errorf("fail to get chain halts from thorchain: %w", err)
+		}
+	}
+	return hp.halts[chain], nil
+}
+
+func (hp *HaltsProvider) getHaltsFromThorchain(height int64) error {
+	halts, err := hp.bridge.GetChainHalts()
+	if err != nil {
+		return fmt.Errorf("fail to get chain halts: %w", err)
+	}
+	hp.halts = make(map[common.Chain]bool)
+	for _, halt := range halts {
+		hp.halts[halt.Chain] = halt.ChainHalted
+	}
+	hp.requestHeight = height
+	return nil
+}
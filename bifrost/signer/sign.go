@@ -44,6 +44,7 @@ type Signer struct {
 	tssKeygen             *tss.KeyGen
 	pubkeyMgr             pubkeymanager.PubKeyValidator
 	constantsProvider     *ConstantsProvider
+	haltsProvider         *HaltsProvider
 }
 
 // NewSigner create a new instance of signer
@@ -114,6 +115,7 @@ func NewSigner(cfg config.SignerConfiguration,
 		thorchainBridge:       thorchainBridge,
 		tssKeygen:             kg,
 		constantsProvider:     constantProvider,
+		haltsProvider:         NewHaltsProvider(thorchainBridge),
 	}, nil
 }
 
@@ -141,8 +143,17 @@ func (s *Signer) Start() error {
 	return nil
 }
 
-func (s *Signer) shouldSign(tx types.TxOutItem) bool {
-	return s.pubkeyMgr.HasPubKey(tx.VaultPubKey)
+// shouldSign check whether the given tx out item should be signed by this node , it return an error when the chain had been halted
+// by thorchain at the given block height , so the item stay in storage and will be signed once the chain resumed
+func (s *Signer) shouldSign(blockHeight int64, tx types.TxOutItem) (bool, error) {
+	halted, err := s.haltsProvider.IsChainHalted(blockHeight, tx.Chain)
+	if err != nil {
+		return false, fmt.Errorf("fail to check whether chain(%s) is halted: %w", tx.Chain, err)
+	}
+	if halted {
+		return false, fmt.Errorf("chain(%s) is halted", tx.Chain)
+	}
+	return s.pubkeyMgr.HasPubKey(tx.VaultPubKey), nil
 }
 
 // signTransactions - looks for work to do by getting a list of all unsigned
@@ -322,7 +333,11 @@ func (s *Signer) signAndBroadcast(item TxOutStoreItem) error {
 		return err
 	}
 
-	if !s.shouldSign(tx) {
+	ok, err := s.shouldSign(blockHeight, tx)
+	if err != nil {
+		return err
+	}
+	if !ok {
 		s.logger.Info().Str("signer_address", chain.GetAddress(tx.VaultPubKey)).Msg("different pool address, ignore")
 		return nil
 	}
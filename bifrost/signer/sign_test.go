@@ -120,6 +120,9 @@ func (s *SignSuite) SetUpSuite(c *C) {
 		}
 	`))
 			c.Assert(err, IsNil)
+		} else if strings.HasPrefix(req.RequestURI, "/thorchain/halts") {
+			_, err := rw.Write([]byte(`[{ "chain": "BNB", "trading_halted": false, "chain_halted": false, "halt_trading_height": "0", "halt_chain_height": "0" }, { "chain": "BTC", "trading_halted": true, "chain_halted": true, "halt_trading_height": "0", "halt_chain_height": "1" }]`))
+			c.Assert(err, IsNil)
 		} else if strings.HasSuffix(req.RequestURI, "/signers") {
 			_, err := rw.Write([]byte(`[
   "tthorpub1addwnpepqflvfv08t6qt95lmttd6wpf3ss8wx63e9vf6fvyuj2yy6nnyna576rfzjks",
@@ -297,6 +300,43 @@ func (s *SignSuite) TestHandleYggReturn_Success_NotEnough(c *C) {
 	c.Check(newItem.Coins, HasLen, 0)
 }
 
+func (s *SignSuite) TestShouldSign(c *C) {
+	sign := &Signer{
+		thorchainBridge: s.bridge,
+		pubkeyMgr:       pubkeymanager.NewMockPoolAddressValidator(),
+		haltsProvider:   NewHaltsProvider(s.bridge),
+	}
+	ok, err := sign.shouldSign(1024, stypes.TxOutItem{Chain: common.BNBChain})
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, false)
+
+	// halted chain
+	ok, err = sign.shouldSign(1024, stypes.TxOutItem{Chain: common.BTCChain})
+	c.Check(err, NotNil)
+	c.Check(ok, Equals, false)
+}
+
+func (s *SignSuite) TestHaltsProvider(c *C) {
+	hp := NewHaltsProvider(s.bridge)
+	halted, err := hp.IsChainHalted(1024, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, true)
+	c.Check(hp.requestHeight, Equals, int64(1024))
+
+	// the halts are only requested once per block
+	hp.halts[common.BTCChain] = false
+	halted, err = hp.IsChainHalted(1024, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, false)
+	halted, err = hp.IsChainHalted(1025, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, true)
+	c.Check(hp.requestHeight, Equals, int64(1025))
+	halted, err = hp.IsChainHalted(1025, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, false)
+}
+
 func (s *SignSuite) TestProcess(c *C) {
 	cfg := config.SignerConfiguration{
 		SignerDbPath: filepath.Join(os.TempDir(), "/var/data/bifrost/signer"),
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -45,6 +46,9 @@ const (
 	PubKeysEndpoint          = "/thorchain/vaults/pubkeys"
 	ThorchainConstants       = "/thorchain/constants"
 	RagnarokEndpoint         = "/thorchain/ragnarok"
+	VaultEndpoint            = "/thorchain/vault/%s/%s"
+	ChainHaltsEndpoint       = "/thorchain/halts"
+	MimirEndpoint            = "/thorchain/mimir"
 )
 
 // ThorchainBridge will be used to send tx to thorchain
@@ -436,6 +440,46 @@ func (b *ThorchainBridge) PostNetworkFee(height int64, chain common.Chain, trans
 	return b.Broadcast(stdTx, types.TxSync)
 }
 
+// PostSolvency send the balance of a vault observed on chain to THORNode
+func (b *ThorchainBridge) PostSolvency(chain common.Chain, pubKey common.PubKey, coins common.Coins, height int64) (common.TxID, error) {
+	nodeStatus, err := b.FetchNodeStatus()
+	if err != nil {
+		return common.BlankTxID, fmt.Errorf("failed to get node status: %w", err)
+	}
+
+	if nodeStatus != stypes.Active {
+		return common.BlankTxID, nil
+	}
+	start := time.Now()
+	defer func() {
+		b.m.GetHistograms(metrics.SignToThorchainDuration).Observe(time.Since(start).Seconds())
+	}()
+	msg := stypes.NewMsgSolvency(chain, pubKey, coins, height, b.keys.GetSignerInfo().GetAddress())
+	stdTx := authtypes.NewStdTx(
+		[]cosmos.Msg{msg},
+		authtypes.NewStdFee(100000000, nil), // fee
+		nil,                                 // signatures
+		"",                                  // memo
+	)
+	return b.Broadcast(stdTx, types.TxSync)
+}
+
+// GetVault retrieve the vault that has the given address on the given chain from thorchain
+func (b *ThorchainBridge) GetVault(chain common.Chain, address string) (stypes.Vault, error) {
+	buf, s, err := b.getWithPath(fmt.Sprintf(VaultEndpoint, chain, address))
+	if err != nil {
+		return stypes.Vault{}, fmt.Errorf("fail to get vault: %w", err)
+	}
+	if s != http.StatusOK {
+		return stypes.Vault{}, fmt.Errorf("unexpected status code %d", s)
+	}
+	var vault stypes.Vault
+	if err := b.cdc.UnmarshalJSON(buf, &vault); err != nil {
+		return stypes.Vault{}, fmt.Errorf("fail to unmarshal vault from json: %w", err)
+	}
+	return vault, nil
+}
+
 // GetConstants from thornode
 func (b *ThorchainBridge) GetConstants() (map[string]int64, error) {
 	var result struct {
@@ -454,6 +498,28 @@ func (b *ThorchainBridge) GetConstants() (map[string]int64, error) {
 	return result.Int64Values, nil
 }
 
+// GetMimir query thorchain for the value of the given mimir key , -1 is returned when it is not set
+func (b *ThorchainBridge) GetMimir(key string) (int64, error) {
+	buf, s, err := b.getWithPath(MimirEndpoint)
+	if err != nil {
+		return -1, fmt.Errorf("fail to get mimir: %w", err)
+	}
+	if s != http.StatusOK {
+		return -1, fmt.Errorf("unexpected status code: %d", s)
+	}
+	var values map[string]int64
+	if err := json.Unmarshal(buf, &values); err != nil {
+		return -1, fmt.Errorf("fail to unmarshal to json: %w", err)
+	}
+	// the keys are reported as they are stored , with the mimir prefix and in upper case
+	for k, v := range values {
+		if strings.HasSuffix(k, "/"+strings.ToUpper(key)) {
+			return v, nil
+		}
+	}
+	return -1, nil
+}
+
 // RagnarokInProgress is to query thorchain to check whether ragnarok had been triggered
 func (b *ThorchainBridge) RagnarokInProgress() (bool, error) {
 	buf, s, err := b.getWithPath(RagnarokEndpoint)
@@ -469,3 +535,33 @@ func (b *ThorchainBridge) RagnarokInProgress() (bool, error) {
 	}
 	return ragnarok, nil
 }
+
+// GetChainHalts query thorchain for the halt state of every chain
+func (b *ThorchainBridge) GetChainHalts() ([]stypes.QueryChainHalt, error) {
+	buf, s, err := b.getWithPath(ChainHaltsEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get chain halts: %w", err)
+	}
+	if s != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", s)
+	}
+	var halts []stypes.QueryChainHalt
+	if err := b.cdc.UnmarshalJSON(buf, &halts); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal chain halts from json: %w", err)
+	}
+	return halts, nil
+}
+
+// IsChainHalted query thorchain to check whether the given chain had been halted , bifrost should not sign for a halted chain
+func (b *ThorchainBridge) IsChainHalted(chain common.Chain) (bool, error) {
+	halts, err := b.GetChainHalts()
+	if err != nil {
+		return false, err
+	}
+	for _, halt := range halts {
+		if halt.Chain.Equals(chain) {
+			return halt.ChainHalted, nil
+		}
+	}
+	return false, nil
+}
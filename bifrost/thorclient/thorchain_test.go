@@ -50,6 +50,8 @@ func (s *ThorchainSuite) SetUpSuite(c *C) {
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/keysign/template.json")
 		case strings.HasPrefix(req.RequestURI, "/thorchain/vaults") && strings.HasSuffix(req.RequestURI, "/signers"):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/tss/keysign_party.json")
+		case strings.HasPrefix(req.RequestURI, "/thorchain/vault/"):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/vault.json")
 		case strings.HasPrefix(req.RequestURI, AsgardVault):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/vaults/asgard.json")
 		case strings.HasPrefix(req.RequestURI, PubKeysEndpoint):
@@ -60,6 +62,10 @@ func (s *ThorchainSuite) SetUpSuite(c *C) {
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/constants/constants.json")
 		case strings.HasPrefix(req.RequestURI, RagnarokEndpoint):
 			httpTestHandler(c, rw, "../../test/fixtures/endpoints/ragnarok/ragnarok.json")
+		case strings.HasPrefix(req.RequestURI, ChainHaltsEndpoint):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/halts/halts.json")
+		case strings.HasPrefix(req.RequestURI, MimirEndpoint):
+			httpTestHandler(c, rw, "../../test/fixtures/endpoints/mimir/mimir.json")
 
 		}
 	}))
@@ -240,12 +246,56 @@ func (s *ThorchainSuite) TestPostNetworkFee(c *C) {
 	c.Assert(txid.IsEmpty(), Equals, false)
 }
 
+func (s *ThorchainSuite) TestPostSolvency(c *C) {
+	s.authAccountFixture = "../../test/fixtures/endpoints/auth/accounts/template.json"
+	pk, err := common.NewPubKey("tthorpub1addwnpepqwn78ny4tzcwuzs9dj7a35ja655twr2zupsng9xq7flxyhd0vd4f6nh0shh")
+	c.Assert(err, IsNil)
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))}
+	txid, err := s.bridge.PostSolvency(common.BNBChain, pk, coins, 1024)
+	c.Assert(err, IsNil)
+	c.Assert(txid.IsEmpty(), Equals, false)
+}
+
+func (s *ThorchainSuite) TestGetVault(c *C) {
+	vault, err := s.bridge.GetVault(common.BNBChain, "tbnb1yeuljgpkg2c2qvx3nlmgv7gvnyss6ye2u8rasf")
+	c.Assert(err, IsNil)
+	c.Check(vault.PubKey.IsEmpty(), Equals, false)
+	c.Check(vault.GetCoin(common.BNBAsset).Amount.Equal(cosmos.NewUint(common.One)), Equals, true)
+}
+
 func (s *ThorchainSuite) TestGetConstants(c *C) {
 	result, err := s.bridge.GetConstants()
 	c.Assert(err, IsNil)
 	c.Assert(result, NotNil)
 }
 
+func (s *ThorchainSuite) TestGetMimir(c *C) {
+	value, err := s.bridge.GetMimir("SolvencyThresholdBasisPoints")
+	c.Assert(err, IsNil)
+	c.Check(value, Equals, int64(500))
+	value, err = s.bridge.GetMimir("NotSet")
+	c.Assert(err, IsNil)
+	c.Check(value, Equals, int64(-1))
+}
+
+func (s *ThorchainSuite) TestGetChainHalts(c *C) {
+	halts, err := s.bridge.GetChainHalts()
+	c.Assert(err, IsNil)
+	c.Assert(halts, HasLen, 2)
+	c.Check(halts[1].Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(halts[1].HaltChainHeight, Equals, int64(1024))
+
+	halted, err := s.bridge.IsChainHalted(common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, false)
+	halted, err = s.bridge.IsChainHalted(common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, true)
+	halted, err = s.bridge.IsChainHalted(common.ETHChain)
+	c.Assert(err, IsNil)
+	c.Check(halted, Equals, false)
+}
+
 func (s *ThorchainSuite) TestGetRagnarok(c *C) {
 	result, err := s.bridge.RagnarokInProgress()
 	c.Assert(err, IsNil)
@@ -45,6 +45,35 @@ const (
 	JailTimeKeygen
 	JailTimeKeysign
 	CliTxCost
+	MinSwapsPerBlock
+	MaxSwapsPerBlock
+	SwapQueueDivisor
+	MaxAffiliateFeeBasisPoints
+	PendingRuneExpiryBlocks
+	PoolSnapshotInterval
+	PoolSnapshotRetentionBlocks
+	TWAPWindowBlocks
+	MinRunePoolDepth
+	SolvencyThresholdBasisPoints
+	OutboundWindowBlocks
+	MaxOutboundValueBasisPoints
+	OutboundDelayValue
+	OutboundDelayBlocks
+	MaxOutboundDelayBlocks
+	SlashRecordRetentionBlocks
+	ChainObservationWindowBlocks
+	MaxMaintenanceBlocks
+	MaintenanceCooldownBlocks
+	MaxMaintenanceNodesBasisPoints
+	MaxLimitOrdersPerBlock
+	MinLimitOrderValue
+	MaxLimitOrderExpiryBlocks
+	MaxStreamingSwapQuantity
+	MaxStreamingSwapInterval
+	PriceCheckpointInterval
+	PriceCheckpointRetentionBlocks
+	PoolDelistAssetWaitBlocks
+	MaxSolvencyHeightDrift
 )
 
 var nameToString = map[ConstantName]string{
@@ -83,6 +112,35 @@ var nameToString = map[ConstantName]string{
 	JailTimeKeygen:                  "JailTimeKeygen",
 	JailTimeKeysign:                 "JailTimeKeysign",
 	CliTxCost:                       "CliTxCost",
+	MinSwapsPerBlock:                "MinSwapsPerBlock",
+	MaxSwapsPerBlock:                "MaxSwapsPerBlock",
+	SwapQueueDivisor:                "SwapQueueDivisor",
+	MaxAffiliateFeeBasisPoints:      "MaxAffiliateFeeBasisPoints",
+	PendingRuneExpiryBlocks:         "PendingRuneExpiryBlocks",
+	PoolSnapshotInterval:            "PoolSnapshotInterval",
+	PoolSnapshotRetentionBlocks:     "PoolSnapshotRetentionBlocks",
+	TWAPWindowBlocks:                "TWAPWindowBlocks",
+	MinRunePoolDepth:                "MinRunePoolDepth",
+	SolvencyThresholdBasisPoints:    "SolvencyThresholdBasisPoints",
+	OutboundWindowBlocks:            "OutboundWindowBlocks",
+	MaxOutboundValueBasisPoints:     "MaxOutboundValueBasisPoints",
+	OutboundDelayValue:              "OutboundDelayValue",
+	OutboundDelayBlocks:             "OutboundDelayBlocks",
+	MaxOutboundDelayBlocks:          "MaxOutboundDelayBlocks",
+	SlashRecordRetentionBlocks:      "SlashRecordRetentionBlocks",
+	ChainObservationWindowBlocks:    "ChainObservationWindowBlocks",
+	MaxMaintenanceBlocks:            "MaxMaintenanceBlocks",
+	MaintenanceCooldownBlocks:       "MaintenanceCooldownBlocks",
+	MaxMaintenanceNodesBasisPoints:  "MaxMaintenanceNodesBasisPoints",
+	MaxLimitOrdersPerBlock:          "MaxLimitOrdersPerBlock",
+	MinLimitOrderValue:              "MinLimitOrderValue",
+	MaxLimitOrderExpiryBlocks:       "MaxLimitOrderExpiryBlocks",
+	MaxStreamingSwapQuantity:        "MaxStreamingSwapQuantity",
+	MaxStreamingSwapInterval:        "MaxStreamingSwapInterval",
+	PriceCheckpointInterval:         "PriceCheckpointInterval",
+	PriceCheckpointRetentionBlocks:  "PriceCheckpointRetentionBlocks",
+	PoolDelistAssetWaitBlocks:       "PoolDelistAssetWaitBlocks",
+	MaxSolvencyHeightDrift:          "MaxSolvencyHeightDrift",
 }
 
 // String implement fmt.stringer
@@ -30,6 +30,35 @@ func (ConstantsTestSuite) TestConstantName_String(c *C) {
 		SigningTransactionPeriod,
 		DoubleSignMaxAge,
 		MinimumBondInRune,
+		MinSwapsPerBlock,
+		MaxSwapsPerBlock,
+		SwapQueueDivisor,
+		MaxAffiliateFeeBasisPoints,
+		PendingRuneExpiryBlocks,
+		PoolSnapshotInterval,
+		PoolSnapshotRetentionBlocks,
+		TWAPWindowBlocks,
+		MinRunePoolDepth,
+		SolvencyThresholdBasisPoints,
+		OutboundWindowBlocks,
+		MaxOutboundValueBasisPoints,
+		OutboundDelayValue,
+		OutboundDelayBlocks,
+		MaxOutboundDelayBlocks,
+		SlashRecordRetentionBlocks,
+		ChainObservationWindowBlocks,
+		MaxMaintenanceBlocks,
+		MaintenanceCooldownBlocks,
+		MaxMaintenanceNodesBasisPoints,
+		MaxLimitOrdersPerBlock,
+		MinLimitOrderValue,
+		MaxLimitOrderExpiryBlocks,
+		MaxStreamingSwapQuantity,
+		MaxStreamingSwapInterval,
+		PriceCheckpointInterval,
+		PriceCheckpointRetentionBlocks,
+		PoolDelistAssetWaitBlocks,
+		MaxSolvencyHeightDrift,
 	}
 	for _, item := range constantNames {
 		c.Assert(item.String(), Not(Equals), "NA")
@@ -33,6 +33,35 @@ func NewConstantValue010() *ConstantVals {
 			JailTimeKeygen:                  720 * 6,            // blocks a node account is jailed for failing to keygen. DO NOT drop below tss timeout
 			JailTimeKeysign:                 60,                 // blocks a node account is jailed for failing to keysign. DO NOT drop below tss timeout
 			CliTxCost:                       1_00000000,         // amount of bonded rune to move to the reserve when using a cli command
+			MinSwapsPerBlock:                10,                 // when the swap queue is this long or shorter, process the whole queue in one block
+			MaxSwapsPerBlock:                100,                // maximum number of swaps to process in one block
+			SwapQueueDivisor:                2,                  // process 1/n of the swap queue each block
+			MaxAffiliateFeeBasisPoints:      1000,               // maximum affiliate fee in basis points a swap or stake memo can ask for
+			PendingRuneExpiryBlocks:         120960,             // number of blocks the RUNE side of a stake wait for the asset side , one week
+			PoolSnapshotInterval:            10,                 // record the depths of every pool every n blocks
+			PoolSnapshotRetentionBlocks:     518400,             // number of blocks pool snapshots are kept before they are pruned , 30 days
+			TWAPWindowBlocks:                720,                // default number of blocks the time weighted average price of a pool is taken over , one hour
+			MinRunePoolDepth:                0,                  // minimum RUNE depth a bootstrap pool needs before it can be enabled , 0 to rank by depth only
+			SolvencyThresholdBasisPoints:    100,                // a vault is insolvent when the balance observed on chain is short of what THORChain expect by more than 1%
+			OutboundWindowBlocks:            720,                // number of blocks the outbound value of a chain is summed over , one hour
+			MaxOutboundValueBasisPoints:     2000,               // trading on a chain is halted when the outbound value in the window is more than 20% of the RUNE pooled on the chain
+			OutboundDelayValue:              10000_00000000,     // outbound worth this much RUNE or more is held before it is sent , 0 to disable
+			OutboundDelayBlocks:             60,                 // number of blocks an outbound is held for every OutboundDelayValue of RUNE it is worth
+			MaxOutboundDelayBlocks:          720,                // maximum number of blocks an outbound is held , one hour
+			SlashRecordRetentionBlocks:      120960,             // number of blocks the slash records of a node account are kept before they are pruned , one week
+			ChainObservationWindowBlocks:    14400,              // number of blocks in a period of the rolling window the chain observations of a node account are counted over , one day
+			MaxMaintenanceBlocks:            14400,              // maximum number of blocks a node account can be in maintenance for , one day
+			MaintenanceCooldownBlocks:       120960,             // minimum number of blocks between two maintenance requests of a node account , one week
+			MaxMaintenanceNodesBasisPoints:  1000,               // maximum share of the active node accounts that can be in maintenance at once , never more than the BFT fault tolerance
+			MaxLimitOrdersPerBlock:          50,                 // maximum number of limit orders evaluated or expired in one block
+			MinLimitOrderValue:              10_00000000,        // minimum value in RUNE of the deposit of a limit order
+			MaxLimitOrderExpiryBlocks:       120960,             // maximum number of blocks a limit order can stay open for , one week
+			MaxStreamingSwapQuantity:        100,                // maximum number of sub-swaps a streaming swap can be split into
+			MaxStreamingSwapInterval:        720,                // maximum number of blocks between two sub-swaps of a streaming swap , one hour
+			PriceCheckpointInterval:         10,                 // record the price accumulator of every pool every n blocks
+			PriceCheckpointRetentionBlocks:  120960,             // number of blocks pool price checkpoints are kept before they are pruned , one week , the longest TWAP window
+			PoolDelistAssetWaitBlocks:       720,                // maximum number of blocks a delisted pool is kept once its stakers are out , while it still hold asset for the gas of the last outbounds
+			MaxSolvencyHeightDrift:          14400,              // maximum number of blocks of the external chain a vault balance report can be ahead of the last height THORChain know of
 		},
 		boolValues: map[ConstantName]bool{
 			StrictBondStakeRatio: true,
@@ -0,0 +1,93 @@
+package thorchain
+
+import (
+	"errors"
+	"fmt"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// validateAffiliateFee make sure the affiliate fee is not more than the maximum allowed , mimir can override the constant
+func validateAffiliateFee(ctx cosmos.Context, keeper keeper.Keeper, basisPoints cosmos.Uint, constAccessor constants.ConstantValues) error {
+	maxBasisPoints, err := keeper.GetMimir(ctx, constants.MaxAffiliateFeeBasisPoints.String())
+	if maxBasisPoints < 0 || err != nil {
+		maxBasisPoints = constAccessor.GetInt64Value(constants.MaxAffiliateFeeBasisPoints)
+	}
+	if basisPoints.GT(cosmos.NewUint(uint64(maxBasisPoints))) {
+		return se.Wrapf(errInvalidAffiliateFee, "affiliate fee %s basis points more than %d", basisPoints, maxBasisPoints)
+	}
+	return nil
+}
+
+// getAffiliateFee return the portion of the given coin that goes to the affiliate
+func getAffiliateFee(coin common.Coin, basisPoints cosmos.Uint) common.Coin {
+	return common.NewCoin(coin.Asset, common.GetShare(basisPoints, cosmos.NewUint(10000), coin.Amount))
+}
+
+// payAffiliateFee send the skimmed coin to the affiliate in RUNE , when the coin is not RUNE it will be swapped to RUNE first
+// when the affiliate can't be paid , the skimmed coin will be refunded to the sender
+func payAffiliateFee(ctx cosmos.Context, mgr Manager, keeper keeper.Keeper, tx common.Tx, coin common.Coin, affiliate common.Address, basisPoints cosmos.Uint, constAccessor constants.ConstantValues) {
+	if coin.IsEmpty() {
+		return
+	}
+	err := sendAffiliateFee(ctx, mgr, keeper, tx, coin, affiliate, basisPoints)
+	if err == nil {
+		return
+	}
+	ctx.Logger().Error("fail to pay affiliate fee", "tx id", tx.ID, "affiliate", affiliate, "coin", coin, "error", err)
+	refund := tx
+	refund.Coins = common.Coins{coin}
+	if err := refundTx(ctx, ObservedTx{Tx: refund}, mgr, keeper, constAccessor, CodeInvalidAffiliateFee, err.Error(), ""); err != nil {
+		ctx.Logger().Error("fail to refund affiliate fee", "tx id", tx.ID, "error", err)
+	}
+}
+
+func sendAffiliateFee(ctx cosmos.Context, mgr Manager, keeper keeper.Keeper, tx common.Tx, coin common.Coin, affiliate common.Address, basisPoints cosmos.Uint) error {
+	runeAmt := coin.Amount
+	if !coin.Asset.IsRune() {
+		swapTx := tx
+		swapTx.Coins = common.Coins{coin}
+		amt, events, err := swap(ctx, keeper, swapTx, common.RuneAsset(), affiliate, cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint())
+		if err != nil {
+			return fmt.Errorf("fail to swap affiliate fee to RUNE: %w", err)
+		}
+		for _, evt := range events {
+			if err := mgr.EventMgr().EmitSwapEvent(ctx, evt); err != nil {
+				ctx.Logger().Error("fail to emit swap event", "error", err)
+			}
+			if err := keeper.AddToLiquidityFees(ctx, evt.Pool, evt.LiquidityFeeInRune); err != nil {
+				ctx.Logger().Error("fail to add liquidity fees", "error", err)
+			}
+		}
+		runeAmt = amt
+	}
+
+	toi := &TxOutItem{
+		Chain:     common.RuneAsset().Chain,
+		InHash:    tx.ID,
+		ToAddress: affiliate,
+		Coin:      common.NewCoin(common.RuneAsset(), runeAmt),
+	}
+	ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi)
+	if err != nil {
+		// when the affiliate fee is not enough to pay for tx fee, consider it as a success
+		if !errors.Is(err, ErrNotEnoughToPayFee) {
+			return fmt.Errorf("fail to add affiliate fee outbound tx: %w", err)
+		}
+		ok = true
+	}
+	if !ok {
+		return errFailAddOutboundTx
+	}
+
+	evt := NewEventAffiliateFee(tx.ID, tx.Memo, affiliate, basisPoints, coin, runeAmt)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		ctx.Logger().Error("fail to emit affiliate fee event", "error", err)
+	}
+	return nil
+}
@@ -21,8 +21,30 @@ const (
 	PoolBootstrap = types.Bootstrap
 	PoolSuspended = types.Suspended
 
+	// limit order status
+	LimitOrderPlaced    = types.LimitOrderPlaced
+	LimitOrderFilled    = types.LimitOrderFilled
+	LimitOrderExpired   = types.LimitOrderExpired
+	LimitOrderCancelled = types.LimitOrderCancelled
+
+	// pending rune actions
+	PendingRuneStaked   = types.PendingRuneStaked
+	PendingRuneRefunded = types.PendingRuneRefunded
+	PendingRuneReserved = types.PendingRuneReserved
+
+	// pool delist phases
+	PoolDelistStarted   = types.PoolDelistStarted
+	PoolDelistCompleted = types.PoolDelistCompleted
+
+	// chain halt reasons and actions
+	ChainHaltInsolvency    = types.ChainHaltInsolvency
+	ChainHaltOutboundLimit = types.ChainHaltOutboundLimit
+	ChainHaltHalted        = types.ChainHaltHalted
+	ChainHaltResumed       = types.ChainHaltResumed
+
 	// Admin config keys
-	MaxUnstakeBasisPoints = types.MaxUnstakeBasisPoints
+	MaxUnstakeBasisPoints     = types.MaxUnstakeBasisPoints
+	MaxMaintenanceBasisPoints = types.MaxMaintenanceBasisPoints
 
 	// Vaults
 	AsgardVault    = types.AsgardVault
@@ -44,6 +66,16 @@ const (
 	BondReturned = types.BondReturned
 	AsgardKeygen = types.AsgardKeygen
 
+	// slash reasons
+	SlashReasonObserve          = types.SlashReasonObserve
+	SlashReasonObserveRedeem    = types.SlashReasonObserveRedeem
+	SlashReasonLackOfObserving  = types.SlashReasonLackOfObserving
+	SlashReasonFailKeygen       = types.SlashReasonFailKeygen
+	SlashReasonFailKeysign      = types.SlashReasonFailKeysign
+	SlashReasonFailSendOutbound = types.SlashReasonFailSendOutbound
+	SlashReasonDoubleSign       = types.SlashReasonDoubleSign
+	SlashReasonLostFunds        = types.SlashReasonLostFunds
+
 	// Memos
 	TxSwap            = mem.TxSwap
 	TxStake           = mem.TxStake
@@ -53,6 +85,8 @@ const (
 	TxMigrate         = mem.TxMigrate
 	TxRagnarok        = mem.TxRagnarok
 	TxReserve         = mem.TxReserve
+	TxOutbound        = mem.TxOutbound
+	TxRefund          = mem.TxRefund
 )
 
 var (
@@ -75,6 +109,15 @@ var (
 	NewMsgStake                    = types.NewMsgStake
 	NewMsgUnStake                  = types.NewMsgUnStake
 	NewMsgSwap                     = types.NewMsgSwap
+	NewStreamingSwap               = types.NewStreamingSwap
+	NewLimitOrder                  = types.NewLimitOrder
+	NewPoolSnapshot                = types.NewPoolSnapshot
+	NewPoolPriceAccumulator        = types.NewPoolPriceAccumulator
+	NewPoolPriceCheckpoint         = types.NewPoolPriceCheckpoint
+	NewPoolSuspension              = types.NewPoolSuspension
+	NewEventPoolDelist             = types.NewEventPoolDelist
+	NewMsgLimitOrder               = types.NewMsgLimitOrder
+	NewMsgCancelLimitOrder         = types.NewMsgCancelLimitOrder
 	NewKeygen                      = types.NewKeygen
 	NewKeygenBlock                 = types.NewKeygenBlock
 	NewMsgSetNodeKeys              = types.NewMsgSetNodeKeys
@@ -83,6 +126,9 @@ var (
 	NewEventPool                   = types.NewEventPool
 	NewEventAdd                    = types.NewEventAdd
 	NewEventSwap                   = types.NewEventSwap
+	NewEventStreamingSwap          = types.NewEventStreamingSwap
+	NewEventLimitOrder             = types.NewEventLimitOrder
+	NewEventPendingRune            = types.NewEventPendingRune
 	NewEventStake                  = types.NewEventStake
 	NewEventUnstake                = types.NewEventUnstake
 	NewEventRefund                 = types.NewEventRefund
@@ -92,6 +138,7 @@ var (
 	NewEventReserve                = types.NewEventReserve
 	NewEventErrata                 = types.NewEventErrata
 	NewEventFee                    = types.NewEventFee
+	NewEventAffiliateFee           = types.NewEventAffiliateFee
 	NewEventOutbound               = types.NewEventOutbound
 	NewPoolMod                     = types.NewPoolMod
 	NewMsgRefundTx                 = types.NewMsgRefundTx
@@ -99,6 +146,7 @@ var (
 	NewMsgMigrate                  = types.NewMsgMigrate
 	NewMsgRagnarok                 = types.NewMsgRagnarok
 	NewQueryNodeAccount            = types.NewQueryNodeAccount
+	NewQuerySwapQuote              = types.NewQuerySwapQuote
 	ChooseSignerParty              = types.ChooseSignerParty
 	GetThreshold                   = types.GetThreshold
 	ModuleCdc                      = types.ModuleCdc
@@ -117,6 +165,19 @@ var (
 	NewMsgSetVersion               = types.NewMsgSetVersion
 	NewMsgSetIPAddress             = types.NewMsgSetIPAddress
 	NewMsgNetworkFee               = types.NewMsgNetworkFee
+	NewMsgSolvency                 = types.NewMsgSolvency
+	NewSolvencyVoter               = types.NewSolvencyVoter
+	NewVaultSolvency               = types.NewVaultSolvency
+	NewEventSolvency               = types.NewEventSolvency
+	NewChainHalt                   = types.NewChainHalt
+	NewOutboundValue               = types.NewOutboundValue
+	NewEventChainHalt              = types.NewEventChainHalt
+	NewSlashRecord                 = types.NewSlashRecord
+	NewBondProvider                = types.NewBondProvider
+	NewBondProviders               = types.NewBondProviders
+	NewNodeMaintenance             = types.NewNodeMaintenance
+	NewMsgMaintenance              = types.NewMsgMaintenance
+	NewChainObservation            = types.NewChainObservation
 	GetPoolStatus                  = types.GetPoolStatus
 	GetRandomVault                 = types.GetRandomVault
 	GetRandomTx                    = types.GetRandomTx
@@ -162,6 +223,17 @@ type (
 	MsgErrataTx                    = types.MsgErrataTx
 	MsgBan                         = types.MsgBan
 	MsgSwap                        = types.MsgSwap
+	StreamingSwap                  = types.StreamingSwap
+	StreamingSwaps                 = types.StreamingSwaps
+	LimitOrder                     = types.LimitOrder
+	LimitOrders                    = types.LimitOrders
+	LimitOrderPair                 = types.LimitOrderPair
+	PoolSnapshot                   = types.PoolSnapshot
+	PoolPriceAccumulator           = types.PoolPriceAccumulator
+	PoolPriceCheckpoint            = types.PoolPriceCheckpoint
+	PoolSuspension                 = types.PoolSuspension
+	MsgLimitOrder                  = types.MsgLimitOrder
+	MsgCancelLimitOrder            = types.MsgCancelLimitOrder
 	MsgSetVersion                  = types.MsgSetVersion
 	MsgSetIPAddress                = types.MsgSetIPAddress
 	MsgSetNodeKeys                 = types.MsgSetNodeKeys
@@ -173,14 +245,32 @@ type (
 	MsgTssPool                     = types.MsgTssPool
 	MsgTssKeysignFail              = types.MsgTssKeysignFail
 	MsgNetworkFee                  = types.MsgNetworkFee
+	MsgSolvency                    = types.MsgSolvency
 	QueryVersion                   = types.QueryVersion
 	QueryQueue                     = types.QueryQueue
 	QueryNodeAccountPreflightCheck = types.QueryNodeAccountPreflightCheck
+	QueryNodeSlashes               = types.QueryNodeSlashes
+	QueryBondProvider              = types.QueryBondProvider
+	QueryNodeObservation           = types.QueryNodeObservation
 	QueryKeygenBlock               = types.QueryKeygenBlock
 	QueryResHeights                = types.QueryResHeights
 	QueryKeysign                   = types.QueryKeysign
 	QueryYggdrasilVaults           = types.QueryYggdrasilVaults
 	QueryNodeAccount               = types.QueryNodeAccount
+	QuerySwapQuote                 = types.QuerySwapQuote
+	QuerySwapQueueItem             = types.QuerySwapQueueItem
+	QueryStakerPosition            = types.QueryStakerPosition
+	QueryPendingStake              = types.QueryPendingStake
+	QueryPoolTWAP                  = types.QueryPoolTWAP
+	QueryPoolSuspension            = types.QueryPoolSuspension
+	QueryBootstrapPool             = types.QueryBootstrapPool
+	QueryBootstrapPools            = types.QueryBootstrapPools
+	QuerySolvencyCoin              = types.QuerySolvencyCoin
+	QueryVaultSolvency             = types.QueryVaultSolvency
+	QueryChainHalt                 = types.QueryChainHalt
+	QueryChurnNode                 = types.QueryChurnNode
+	QueryChurnPreview              = types.QueryChurnPreview
+	QuerySwapQuoteLeg              = types.QuerySwapQuoteLeg
 	PoolStatus                     = types.PoolStatus
 	Pool                           = types.Pool
 	Pools                          = types.Pools
@@ -198,6 +288,10 @@ type (
 	Keygen                         = types.Keygen
 	KeygenBlock                    = types.KeygenBlock
 	EventSwap                      = types.EventSwap
+	EventStreamingSwap             = types.EventStreamingSwap
+	EventLimitOrder                = types.EventLimitOrder
+	EventPendingRune               = types.EventPendingRune
+	EventPoolDelist                = types.EventPoolDelist
 	EventStake                     = types.EventStake
 	EventUnstake                   = types.EventUnstake
 	EventAdd                       = types.EventAdd
@@ -224,11 +318,24 @@ type (
 	EventRefund                    = types.EventRefund
 	EventBond                      = types.EventBond
 	EventFee                       = types.EventFee
+	EventAffiliateFee              = types.EventAffiliateFee
 	EventSlash                     = types.EventSlash
 	EventOutbound                  = types.EventOutbound
 	NetworkFee                     = types.NetworkFee
 	ObservedNetworkFeeVoter        = types.ObservedNetworkFeeVoter
+	SolvencyVoter                  = types.SolvencyVoter
+	VaultSolvency                  = types.VaultSolvency
+	EventSolvency                  = types.EventSolvency
+	ChainHalt                      = types.ChainHalt
+	OutboundValue                  = types.OutboundValue
+	EventChainHalt                 = types.EventChainHalt
+	SlashRecord                    = types.SlashRecord
+	ChainObservation               = types.ChainObservation
 	Jail                           = types.Jail
+	BondProvider                   = types.BondProvider
+	BondProviders                  = types.BondProviders
+	NodeMaintenance                = types.NodeMaintenance
+	MsgMaintenance                 = types.MsgMaintenance
 	RagnarokUnstakePosition        = types.RagnarokUnstakePosition
 
 	// Memo
@@ -247,4 +354,6 @@ type (
 	YggdrasilReturnMemo = mem.YggdrasilReturnMemo
 	ReserveMemo         = mem.ReserveMemo
 	SwitchMemo          = mem.SwitchMemo
+	LimitMemo           = mem.LimitMemo
+	CancelMemo          = mem.CancelMemo
 )
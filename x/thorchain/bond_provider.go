@@ -0,0 +1,61 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// getBondProviders return the bond providers of the given node account , the node operator is always a bond provider
+// a node account that bonded before bond providers existed has no units yet , in which case the whole bond belong to the operator
+func getBondProviders(ctx cosmos.Context, keeper keeper.Keeper, na NodeAccount) (BondProviders, error) {
+	bp, err := keeper.GetBondProviders(ctx, na.NodeAddress)
+	if err != nil {
+		return bp, fmt.Errorf("fail to get bond providers: %w", err)
+	}
+	bp.NodeAddress = na.NodeAddress
+	if !bp.Has(na.BondAddress) {
+		bp.Providers = append([]BondProvider{NewBondProvider(na.BondAddress)}, bp.Providers...)
+	}
+	if bp.TotalUnits().IsZero() && !na.Bond.IsZero() {
+		bp.Bond(na.BondAddress, na.Bond, cosmos.ZeroUint())
+	}
+	return bp, nil
+}
+
+// payNodeOperatorFee give the node operator the units of its fee on the given bond reward , totalBond is the bond of the node account
+// after the reward is added , the rest of the reward is shared by all bond providers pro-rata as it is added to the bond
+func payNodeOperatorFee(bp *BondProviders, operator common.Address, reward, totalBond cosmos.Uint) {
+	if bp.NodeOperatorFee <= 0 || reward.IsZero() {
+		return
+	}
+	fee := reward.MulUint64(uint64(bp.NodeOperatorFee)).QuoUint64(MaxUnstakeBasisPoints)
+	bp.Bond(operator, fee, common.SafeSub(totalBond, fee))
+}
+
+// getBondRefundAddress return the address the bond of the given bond provider should be sent to
+func getBondRefundAddress(na NodeAccount, provider common.Address) common.Address {
+	if common.RuneAsset().Chain.Equals(common.THORChain) && provider.Equals(na.BondAddress) {
+		return common.Address(na.NodeAddress.String())
+	}
+	return provider
+}
+
+// addProviderBond add the given RUNE to the bond of the node account , on behalf of the given bond provider
+func addProviderBond(ctx cosmos.Context, keeper keeper.Keeper, na *NodeAccount, provider common.Address, amt cosmos.Uint) error {
+	if amt.IsZero() {
+		return nil
+	}
+	bp, err := getBondProviders(ctx, keeper, *na)
+	if err != nil {
+		return err
+	}
+	bp.Bond(provider, amt, na.Bond)
+	na.Bond = na.Bond.Add(amt)
+	if err := keeper.SetBondProviders(ctx, bp); err != nil {
+		return fmt.Errorf("fail to save bond providers: %w", err)
+	}
+	return nil
+}
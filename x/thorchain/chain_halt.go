@@ -0,0 +1,278 @@
+package thorchain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+const (
+	// mimirHaltTrading is the mimir key to halt trading on a chain , e.g. HaltBTCTrading , the value is the height trading halts at
+	mimirHaltTrading = `Halt%sTrading`
+	// mimirHaltChain is the mimir key to halt a chain , e.g. HaltETHChain , the value is the height the chain halts at
+	// a halted chain doesn't trade , its outbounds are held till it resume and bifrost doesn't sign for it
+	mimirHaltChain = `Halt%sChain`
+)
+
+// mimirResumeTrading is the mimir key that clears the automatic halt of a chain , the chain is appended to the key , e.g. ResumeTrading-BNB
+// the value is a block height , the halt is cleared when it was set at or before that height , and whatever happened up to that height
+// will not halt the chain again
+const mimirResumeTrading = `ResumeTrading-`
+
+// isChainHalted check whether trading on the given chain is halted automatically
+func isChainHalted(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain) bool {
+	halt, err := keeper.GetChainHalt(ctx, chain)
+	if err != nil {
+		ctx.Logger().Error("fail to get chain halt", "chain", chain, "error", err)
+		return false
+	}
+	return !halt.IsEmpty()
+}
+
+// getMimirHaltHeight return the height the given halt mimir key (mimirHaltTrading or mimirHaltChain) set for the given chain , 0 when not set
+func getMimirHaltHeight(ctx cosmos.Context, keeper keeper.Keeper, key string, chain common.Chain) int64 {
+	key = fmt.Sprintf(key, strings.ToUpper(chain.String()))
+	height, err := keeper.GetMimir(ctx, key)
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir", "key", key, "error", err)
+		return 0
+	}
+	if height < 0 {
+		return 0
+	}
+	return height
+}
+
+// isMimirHalted check whether the given halt mimir key had been set for the given chain , and the height it set had been reached
+func isMimirHalted(ctx cosmos.Context, keeper keeper.Keeper, key string, chain common.Chain) bool {
+	height := getMimirHaltHeight(ctx, keeper, key, chain)
+	return height > 0 && height <= common.BlockHeight(ctx)
+}
+
+// isTradingHalted check whether swaps and stakes on the given chain should be refunded , either because trading on the chain is
+// halted automatically , or by mimir , or the chain itself is halted
+func isTradingHalted(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain) bool {
+	return isChainHalted(ctx, keeper, chain) ||
+		isMimirHalted(ctx, keeper, mimirHaltTrading, chain) ||
+		isMimirHalted(ctx, keeper, mimirHaltChain, chain)
+}
+
+// isOutboundHalted check whether the given chain is halted by mimir , outbounds for it are held in the schedule till it resume
+func isOutboundHalted(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain) bool {
+	return isMimirHalted(ctx, keeper, mimirHaltChain, chain)
+}
+
+// getChainResumeHeight return the height mimir resumed trading on the given chain at , 0 when it never did
+func getChainResumeHeight(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain) int64 {
+	key := mimirResumeTrading + strings.ToUpper(chain.String())
+	height, err := keeper.GetMimir(ctx, key)
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir", "key", key, "error", err)
+		return 0
+	}
+	if height < 0 {
+		return 0
+	}
+	return height
+}
+
+// processChainHalts record the value sent out on every chain in this block , halt trading on a chain when one of its asgard vaults
+// turn out to be insolvent , or the outbound value in the window is over the limit , and resume the chains mimir cleared
+func processChainHalts(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, constAccessor constants.ConstantValues) error {
+	height := common.BlockHeight(ctx)
+	outbound, err := getBlockOutboundValues(ctx, keeper)
+	if err != nil {
+		return fmt.Errorf("fail to get outbound values: %w", err)
+	}
+	for _, value := range outbound {
+		keeper.SetOutboundValue(ctx, value)
+	}
+	window, err := keeper.GetMimir(ctx, constants.OutboundWindowBlocks.String())
+	if window <= 0 || err != nil {
+		window = constAccessor.GetInt64Value(constants.OutboundWindowBlocks)
+	}
+	maxOutbound, err := keeper.GetMimir(ctx, constants.MaxOutboundValueBasisPoints.String())
+	if maxOutbound < 0 || err != nil {
+		maxOutbound = constAccessor.GetInt64Value(constants.MaxOutboundValueBasisPoints)
+	}
+
+	vaults, err := getChainHaltVaults(ctx, keeper)
+	if err != nil {
+		return err
+	}
+	for _, chain := range getVaultChains(vaults) {
+		if chain.Equals(common.THORChain) {
+			continue
+		}
+		resumeHeight := getChainResumeHeight(ctx, keeper, chain)
+		halt, err := keeper.GetChainHalt(ctx, chain)
+		if err != nil {
+			return fmt.Errorf("fail to get chain halt: %w", err)
+		}
+		if !halt.IsEmpty() {
+			if resumeHeight < halt.Height {
+				continue
+			}
+			keeper.RemoveChainHalt(ctx, chain)
+			if err := mgr.EventMgr().EmitEvent(ctx, NewEventChainHalt(chain, ChainHaltResumed, halt.Reason)); err != nil {
+				return fmt.Errorf("fail to emit chain halt event: %w", err)
+			}
+			ctx.Logger().Info("chain trading resumed", "chain", chain)
+		}
+
+		reason := ""
+		insolvent, err := isChainInsolvent(ctx, keeper, chain, vaults, constAccessor)
+		if err != nil {
+			return err
+		}
+		if insolvent {
+			reason = ChainHaltInsolvency
+		} else if maxOutbound > 0 {
+			from := height - window + 1
+			if from <= resumeHeight {
+				from = resumeHeight + 1
+			}
+			exceeded, err := isChainOutboundExceeded(ctx, keeper, chain, from, maxOutbound)
+			if err != nil {
+				return err
+			}
+			if exceeded {
+				reason = ChainHaltOutboundLimit
+			}
+		}
+		keeper.PruneOutboundValues(ctx, chain, height-window+1)
+		if len(reason) == 0 {
+			continue
+		}
+		keeper.SetChainHalt(ctx, NewChainHalt(chain, height, reason))
+		if err := mgr.EventMgr().EmitEvent(ctx, NewEventChainHalt(chain, ChainHaltHalted, reason)); err != nil {
+			return fmt.Errorf("fail to emit chain halt event: %w", err)
+		}
+		ctx.Logger().Error("chain trading halted", "chain", chain, "reason", reason)
+	}
+	return nil
+}
+
+// getChainHaltVaults return the asgard vaults that hold funds
+func getChainHaltVaults(ctx cosmos.Context, keeper keeper.Keeper) (Vaults, error) {
+	active, err := keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get active asgard vaults: %w", err)
+	}
+	retiring, err := keeper.GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get retiring asgard vaults: %w", err)
+	}
+	return append(active, retiring...), nil
+}
+
+// getVaultChains return the chains of the given vaults , in the order they first appear
+func getVaultChains(vaults Vaults) common.Chains {
+	chains := make(common.Chains, 0)
+	for _, vault := range vaults {
+		for _, chain := range vault.Chains {
+			if !chains.Has(chain) {
+				chains = append(chains, chain)
+			}
+		}
+	}
+	return chains
+}
+
+// isChainInsolvent check the balances of the asgard vaults on the given chain that reached consensus in this block , the balances
+// observed earlier are stale as the vaults might have received or sent funds since. The outbounds in flight are deducted from
+// what THORChain expect the vaults to hold , as they are sent before they are observed
+func isChainInsolvent(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain, vaults Vaults, constAccessor constants.ConstantValues) (bool, error) {
+	threshold := getSolvencyThreshold(ctx, keeper, constAccessor)
+	for _, vault := range vaults {
+		if !vault.Chains.Has(chain) {
+			continue
+		}
+		solvency, err := keeper.GetVaultSolvency(ctx, vault.PubKey, chain)
+		if err != nil {
+			return false, fmt.Errorf("fail to get vault solvency: %w", err)
+		}
+		if solvency.IsEmpty() || solvency.BlockHeight != common.BlockHeight(ctx) {
+			continue
+		}
+		vault, err = deductPendingOutbounds(ctx, keeper, vault, chain, constAccessor)
+		if err != nil {
+			return false, err
+		}
+		for _, check := range getSolvencyChecks(vault, solvency) {
+			if check.isInsolvent(threshold) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// isChainOutboundExceeded check whether the value sent out on the given chain since the given height is more than the given basis points
+// of the RUNE pooled on the chain
+func isChainOutboundExceeded(ctx cosmos.Context, keeper keeper.Keeper, chain common.Chain, from int64, maxBasisPoints int64) (bool, error) {
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		return false, fmt.Errorf("fail to get pools: %w", err)
+	}
+	depth := cosmos.ZeroUint()
+	for _, pool := range pools {
+		if pool.Asset.Chain.Equals(chain) && pool.IsEnabled() {
+			depth = depth.Add(pool.BalanceRune)
+		}
+	}
+	if depth.IsZero() {
+		return false, nil
+	}
+	values, err := keeper.GetOutboundValues(ctx, chain, from, common.BlockHeight(ctx))
+	if err != nil {
+		return false, fmt.Errorf("fail to get outbound values: %w", err)
+	}
+	total := cosmos.ZeroUint()
+	for _, value := range values {
+		total = total.Add(value.Value)
+	}
+	return total.MulUint64(MaxUnstakeBasisPoints).GT(depth.MulUint64(uint64(maxBasisPoints))), nil
+}
+
+// getBlockOutboundValues sum up the RUNE value of the coins sent to users in this block , by chain
+// coins moved between vaults , e.g. yggdrasil funding and migration , are not counted
+func getBlockOutboundValues(ctx cosmos.Context, keeper keeper.Keeper) ([]OutboundValue, error) {
+	values := make([]OutboundValue, 0)
+	txOut, err := keeper.GetTxOut(ctx, common.BlockHeight(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("fail to get tx out: %w", err)
+	}
+	for _, item := range txOut.TxArray {
+		memo, err := ParseMemo(item.Memo)
+		if err != nil || !memo.IsOutbound() {
+			continue
+		}
+		value := item.Coin.Amount
+		if !item.Coin.Asset.IsRune() {
+			pool, err := keeper.GetPool(ctx, item.Coin.Asset)
+			if err != nil {
+				return nil, fmt.Errorf("fail to get pool: %w", err)
+			}
+			if pool.IsEmpty() {
+				continue
+			}
+			value = pool.AssetValueInRune(item.Coin.Amount)
+		}
+		found := false
+		for i := range values {
+			if values[i].Chain.Equals(item.Chain) {
+				values[i].Value = values[i].Value.Add(value)
+				found = true
+			}
+		}
+		if !found {
+			values = append(values, NewOutboundValue(item.Chain, common.BlockHeight(ctx), value))
+		}
+	}
+	return values, nil
+}
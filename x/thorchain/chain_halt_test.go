@@ -0,0 +1,326 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type ChainHaltSuite struct{}
+
+var _ = Suite(&ChainHaltSuite{})
+
+func hasChainHaltEvent(ctx cosmos.Context, action string) bool {
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type != "chain_halt" {
+			continue
+		}
+		for _, attr := range evt.Attributes {
+			if string(attr.Key) == "action" && string(attr.Value) == action {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *ChainHaltSuite) TestHaltOnInsolvency(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+
+	// nothing observed yet
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+
+	// solvent vault
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One))}
+	k.SetVaultSolvency(ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1024, common.BlockHeight(ctx)))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+
+	// insolvency observed in an earlier block is stale
+	coins = common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(50*common.One))}
+	k.SetVaultSolvency(ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1024, common.BlockHeight(ctx)-1))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+
+	// insolvent vault
+	k.SetVaultSolvency(ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1024, common.BlockHeight(ctx)))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, true)
+	c.Check(hasChainHaltEvent(ctx, ChainHaltHalted), Equals, true)
+	halt, err := k.GetChainHalt(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halt.Reason, Equals, ChainHaltInsolvency)
+	c.Check(halt.Height, Equals, common.BlockHeight(ctx))
+
+	// the halt can only be cleared by mimir
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 1)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, true)
+	k.SetMimir(ctx, "ResumeTrading-BNB", halt.Height-1)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, true)
+	k.SetMimir(ctx, "ResumeTrading-BNB", halt.Height)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+	c.Check(hasChainHaltEvent(ctx, ChainHaltResumed), Equals, true)
+
+	// new evidence halts the chain again
+	k.SetVaultSolvency(ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1025, common.BlockHeight(ctx)))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, true)
+}
+
+func (s *ChainHaltSuite) TestNoHaltOnOutboundInFlight(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+
+	// the outbound is sent , but not observed yet , THORChain still expect the vault to hold it
+	item := &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(40*common.One)),
+		Memo:        "OUT:" + GetRandomTxHash().String(),
+		InHash:      GetRandomTxHash(),
+		MaxGas:      common.Gas{common.NewCoin(common.BNBAsset, cosmos.NewUint(37500))},
+	}
+	c.Assert(k.AppendTxOut(ctx, common.BlockHeight(ctx)-1, item), IsNil)
+	// an outbound held in the schedule , which had been assigned to the vault
+	scheduled := &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(10*common.One)),
+		Memo:        "OUT:" + GetRandomTxHash().String(),
+		InHash:      GetRandomTxHash(),
+	}
+	c.Assert(k.AppendScheduledOutbound(ctx, common.BlockHeight(ctx)+10, scheduled), IsNil)
+	// another vault's outbound doesn't count
+	other := *item
+	other.VaultPubKey = GetRandomPubKey()
+	c.Assert(k.AppendTxOut(ctx, common.BlockHeight(ctx), &other), IsNil)
+
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(50*common.One))}
+	k.SetVaultSolvency(ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1024, common.BlockHeight(ctx)))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+	c.Assert(checkVaultSolvency(ctx, k, mgr, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1024, common.BlockHeight(ctx)), constAccessor), IsNil)
+	c.Check(hasSolvencyEvent(ctx), Equals, false)
+	// the expected coins of the vault are left alone
+	vault, err := k.GetVault(ctx, vault.PubKey)
+	c.Assert(err, IsNil)
+	c.Check(vault.GetCoin(common.BNBAsset).Amount.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+
+	// more is missing than what is in flight
+	coins = common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(45*common.One))}
+	k.SetVaultSolvency(ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, coins, 1025, common.BlockHeight(ctx)))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, true)
+}
+
+func (s *ChainHaltSuite) TestHaltOnOutboundLimit(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	k.SetMimir(ctx, constants.MaxOutboundValueBasisPoints.String(), 2000)
+	k.SetMimir(ctx, constants.OutboundWindowBlocks.String(), 10)
+
+	vault := GetRandomVault()
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	pool.PoolUnits = cosmos.NewUint(1000 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	addOutbound := func(ctx cosmos.Context, memo string, amount uint64) {
+		txOut := NewTxOut(common.BlockHeight(ctx))
+		txOut.TxArray = append(txOut.TxArray, &TxOutItem{
+			Chain:       common.BNBChain,
+			ToAddress:   GetRandomBNBAddress(),
+			VaultPubKey: vault.PubKey,
+			Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(amount*common.One)),
+			Memo:        memo,
+		})
+		c.Assert(k.SetTxOut(ctx, txOut), IsNil)
+	}
+
+	// coins moved between vaults are not counted
+	addOutbound(ctx, NewYggdrasilFund(common.BlockHeight(ctx)).String(), 500)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+
+	// 150 RUNE worth sent out , under the limit of 200 RUNE
+	addOutbound(ctx, NewOutboundMemo(GetRandomTxHash()).String(), 150)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+	values, err := k.GetOutboundValues(ctx, common.BNBChain, 0, common.BlockHeight(ctx))
+	c.Assert(err, IsNil)
+	c.Assert(values, HasLen, 1)
+	c.Check(values[0].Value.Equal(cosmos.NewUint(150*common.One)), Equals, true)
+
+	// another 100 RUNE worth sent out within the window
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 5)
+	addOutbound(ctx, NewRefundMemo(GetRandomTxHash()).String(), 100)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, true)
+	halt, err := k.GetChainHalt(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halt.Reason, Equals, ChainHaltOutboundLimit)
+
+	// the outbound values up to the resume height no longer count
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 1)
+	k.SetMimir(ctx, "ResumeTrading-BNB", common.BlockHeight(ctx))
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 1)
+	addOutbound(ctx, NewOutboundMemo(GetRandomTxHash()).String(), 100)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+
+	// values outside of the window are pruned
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 20)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	values, err = k.GetOutboundValues(ctx, common.BNBChain, 0, common.BlockHeight(ctx))
+	c.Assert(err, IsNil)
+	c.Check(values, HasLen, 0)
+
+	// disabled when the limit is zero
+	k.SetMimir(ctx, constants.MaxOutboundValueBasisPoints.String(), 0)
+	addOutbound(ctx, NewOutboundMemo(GetRandomTxHash()).String(), 900)
+	c.Assert(processChainHalts(ctx, k, mgr, constAccessor), IsNil)
+	c.Check(isChainHalted(ctx, k, common.BNBChain), Equals, false)
+}
+
+func (s *ChainHaltSuite) TestSwapAndStakeRefundWhenHalted(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	k.SetChainHalt(ctx, NewChainHalt(common.BNBChain, common.BlockHeight(ctx), ChainHaltInsolvency))
+
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		GetRandomBNBAddress(),
+		GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(common.One))},
+		BNBGasFeeSingleton,
+		"",
+	)
+	_, _, err := swap(ctx, k, tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.NewUint(1000_000))
+	c.Check(err, Equals, errTradingHalted)
+
+	msg := NewMsgStake(tx, common.BNBAsset, cosmos.NewUint(common.One), cosmos.NewUint(common.One), GetRandomRUNEAddress(), GetRandomBNBAddress(), GetRandomBech32Addr())
+	c.Check(NewStakeHandler(k, mgr).validateV1(ctx, msg, constAccessor), Equals, errTradingHalted)
+}
+
+func (s *ChainHaltSuite) TestMimirHalts(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+
+	c.Check(isTradingHalted(ctx, k, common.BTCChain), Equals, false)
+	c.Check(isOutboundHalted(ctx, k, common.BTCChain), Equals, false)
+
+	// halt height not reached yet
+	k.SetMimir(ctx, "HaltBTCTrading", common.BlockHeight(ctx)+1)
+	c.Check(isTradingHalted(ctx, k, common.BTCChain), Equals, false)
+	k.SetMimir(ctx, "HaltBTCTrading", common.BlockHeight(ctx))
+	c.Check(isTradingHalted(ctx, k, common.BTCChain), Equals, true)
+	c.Check(isOutboundHalted(ctx, k, common.BTCChain), Equals, false)
+	c.Check(isTradingHalted(ctx, k, common.ETHChain), Equals, false)
+
+	// a halted chain doesn't trade either
+	k.SetMimir(ctx, "HaltETHChain", 1)
+	c.Check(isTradingHalted(ctx, k, common.ETHChain), Equals, true)
+	c.Check(isOutboundHalted(ctx, k, common.ETHChain), Equals, true)
+
+	// outbounds for a halted chain are accepted and held till it resume
+	vault := GetRandomVault()
+	vault.Chains = common.Chains{common.ETHChain}
+	vault.Coins = common.Coins{common.NewCoin(common.ETHAsset, cosmos.NewUint(100*common.One))}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	pool := NewPool()
+	pool.Asset = common.ETHAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	txOutStore := NewTxOutStorageV1(k, constants.GetConstantValues(constants.SWVersion), mgr.EventMgr())
+	toi := &TxOutItem{
+		Chain:     common.ETHChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.ETHAsset, cosmos.NewUint(10*common.One)),
+	}
+	ok, err := txOutStore.TryAddTxOutItem(ctx, mgr, toi)
+	c.Assert(err, IsNil)
+	c.Check(ok, Equals, true)
+	migrate := &TxOutItem{
+		Chain:       common.ETHChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		InHash:      common.BlankTxID,
+		Coin:        common.NewCoin(common.ETHAsset, cosmos.NewUint(common.One)),
+		Memo:        NewMigrateMemo(common.BlockHeight(ctx)).String(),
+	}
+	c.Assert(txOutStore.UnSafeAddTxOutItem(ctx, mgr, migrate), IsNil)
+	items, err := txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+	scheduled, err := k.GetScheduledOutbound(ctx, common.BlockHeight(ctx)+1)
+	c.Assert(err, IsNil)
+	c.Assert(scheduled.TxArray, HasLen, 2)
+	c.Check(scheduled.TxArray[0].VaultPubKey.Equals(vault.PubKey), Equals, true)
+
+	// still held while the chain is halted
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 1)
+	c.Assert(txOutStore.EndBlock(ctx, mgr), IsNil)
+	items, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	// unset
+	k.SetMimir(ctx, "HaltETHChain", 0)
+	c.Check(isTradingHalted(ctx, k, common.ETHChain), Equals, false)
+	c.Check(isOutboundHalted(ctx, k, common.ETHChain), Equals, false)
+
+	// released once the chain resume
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 1)
+	c.Assert(txOutStore.EndBlock(ctx, mgr), IsNil)
+	items, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 2)
+}
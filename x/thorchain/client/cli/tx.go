@@ -33,6 +33,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdSetNodeKeys(cdc),
 		GetCmdSetVersion(cdc),
 		GetCmdSetIPAddress(cdc),
+		GetCmdMaintenance(cdc),
 		GetCmdBan(cdc),
 		GetCmdMimir(cdc),
 	)...)
@@ -110,6 +111,31 @@ func GetCmdSetIPAddress(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdMaintenance command to put a node account in maintenance
+func GetCmdMaintenance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "maintenance [blocks]",
+		Short: "put the node account in maintenance for the given number of blocks, 0 to end the maintenance (caution: costs 1 RUNE of bond)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			blocks, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number of blocks (must be an integer): %w", err)
+			}
+
+			msg := types.NewMsgMaintenance(blocks, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []cosmos.Msg{msg})
+		},
+	}
+}
+
 // GetCmdSetVersion command to set an admin config
 func GetCmdSetVersion(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
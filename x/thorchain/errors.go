@@ -17,13 +17,17 @@ const (
 	CodeConstantsNotAvailable uint32 = 103
 	CodeInvalidVault          uint32 = 104
 	CodeInvalidMemo           uint32 = 105
+	CodeInvalidAffiliateFee   uint32 = 106
 	CodeInvalidPoolStatus     uint32 = 107
 
-	CodeSwapFail                 uint32 = 108
-	CodeSwapFailNotEnoughFee     uint32 = 110
-	CodeSwapFailInvalidAmount    uint32 = 113
-	CodeSwapFailInvalidBalance   uint32 = 114
-	CodeSwapFailNotEnoughBalance uint32 = 115
+	CodeSwapFail                  uint32 = 108
+	CodeSwapFailNotEnoughFee      uint32 = 110
+	CodeSwapFailInvalidAmount     uint32 = 113
+	CodeSwapFailInvalidBalance    uint32 = 114
+	CodeSwapFailNotEnoughBalance  uint32 = 115
+	CodeSwapFailSlipLimit         uint32 = 116
+	CodeSwapFailLiquidityFeeLimit uint32 = 117
+	CodeSwapFailInvalidStream     uint32 = 118
 
 	CodeStakeFailValidation    uint32 = 120
 	CodeFailGetStaker          uint32 = 122
@@ -39,33 +43,47 @@ const (
 	CodeUnstakeWithin24Hours  uint32 = 136
 	CodeUnstakeFail           uint32 = 137
 	CodeEmptyChain            uint32 = 138
+
+	CodeLimitOrderFailValidation uint32 = 140
+	CodeLimitOrderNotFound       uint32 = 141
+	CodeLimitOrderExpired        uint32 = 142
+	CodeLimitOrderCancelled      uint32 = 143
+
+	CodeTradingHalted uint32 = 150
 )
 
 var (
-	notAuthorized               = fmt.Errorf("not authorized")
-	errInvalidVersion           = fmt.Errorf("bad version")
-	errBadVersion               = se.Register(DefaultCodespace, CodeBadVersion, errInvalidVersion.Error())
-	errInvalidMessage           = se.Register(DefaultCodespace, CodeInvalidMessage, "invalid message")
-	errConstNotAvailable        = se.Register(DefaultCodespace, CodeConstantsNotAvailable, "constant values not available")
-	errInvalidMemo              = se.Register(DefaultCodespace, CodeInvalidMemo, "invalid memo")
-	errFailSaveEvent            = se.Register(DefaultCodespace, CodeFailSaveEvent, "fail to save add events")
-	errStakeFailValidation      = se.Register(DefaultCodespace, CodeStakeFailValidation, "fail to validate stake")
-	errStakeRUNEOverLimit       = se.Register(DefaultCodespace, CodeStakeRUNEOverLimit, "stake rune is over limit")
-	errStakeRUNEMoreThanBond    = se.Register(DefaultCodespace, CodeStakeRUNEMoreThanBond, "stake rune is more than bond")
-	errInvalidPoolStatus        = se.Register(DefaultCodespace, CodeInvalidPoolStatus, "invalid pool status")
-	errFailAddOutboundTx        = se.Register(DefaultCodespace, CodeFailAddOutboundTx, "prepare outbound tx not successful")
-	errUnstakeFailValidation    = se.Register(DefaultCodespace, CodeUnstakeFailValidation, "fail to validate unstake")
-	errFailGetStaker            = se.Register(DefaultCodespace, CodeFailGetStaker, "fail to get staker")
-	errStakeMismatchAssetAddr   = se.Register(DefaultCodespace, CodeStakeMismatchAssetAddr, "mismatch of asset address")
-	errSwapFailNotEnoughFee     = se.Register(DefaultCodespace, CodeSwapFailNotEnoughFee, "fail swap, not enough fee")
-	errSwapFail                 = se.Register(DefaultCodespace, CodeSwapFail, "fail swap")
-	errSwapFailInvalidAmount    = se.Register(DefaultCodespace, CodeSwapFailInvalidAmount, "fail swap, invalid amount")
-	errSwapFailInvalidBalance   = se.Register(DefaultCodespace, CodeSwapFailInvalidBalance, "fail swap, invalid balance")
-	errSwapFailNotEnoughBalance = se.Register(DefaultCodespace, CodeSwapFailNotEnoughBalance, "fail swap, not enough balance")
-	errNoStakeUnitLeft          = se.Register(DefaultCodespace, CodeNoStakeUnitLeft, "nothing to withdraw")
-	errUnstakeWithin24Hours     = se.Register(DefaultCodespace, CodeUnstakeWithin24Hours, "you cannot unstake for 24 hours after staking for this blockchain")
-	errUnstakeFail              = se.Register(DefaultCodespace, CodeUnstakeFail, "fail to unstake")
-	errInternal                 = se.Register(DefaultCodespace, CodeInternalError, "internal error")
+	notAuthorized                = fmt.Errorf("not authorized")
+	errInvalidVersion            = fmt.Errorf("bad version")
+	errBadVersion                = se.Register(DefaultCodespace, CodeBadVersion, errInvalidVersion.Error())
+	errInvalidMessage            = se.Register(DefaultCodespace, CodeInvalidMessage, "invalid message")
+	errConstNotAvailable         = se.Register(DefaultCodespace, CodeConstantsNotAvailable, "constant values not available")
+	errInvalidMemo               = se.Register(DefaultCodespace, CodeInvalidMemo, "invalid memo")
+	errInvalidAffiliateFee       = se.Register(DefaultCodespace, CodeInvalidAffiliateFee, "invalid affiliate fee")
+	errFailSaveEvent             = se.Register(DefaultCodespace, CodeFailSaveEvent, "fail to save add events")
+	errStakeFailValidation       = se.Register(DefaultCodespace, CodeStakeFailValidation, "fail to validate stake")
+	errStakeRUNEOverLimit        = se.Register(DefaultCodespace, CodeStakeRUNEOverLimit, "stake rune is over limit")
+	errStakeRUNEMoreThanBond     = se.Register(DefaultCodespace, CodeStakeRUNEMoreThanBond, "stake rune is more than bond")
+	errInvalidPoolStatus         = se.Register(DefaultCodespace, CodeInvalidPoolStatus, "invalid pool status")
+	errFailAddOutboundTx         = se.Register(DefaultCodespace, CodeFailAddOutboundTx, "prepare outbound tx not successful")
+	errUnstakeFailValidation     = se.Register(DefaultCodespace, CodeUnstakeFailValidation, "fail to validate unstake")
+	errFailGetStaker             = se.Register(DefaultCodespace, CodeFailGetStaker, "fail to get staker")
+	errStakeMismatchAssetAddr    = se.Register(DefaultCodespace, CodeStakeMismatchAssetAddr, "mismatch of asset address")
+	errSwapFailNotEnoughFee      = se.Register(DefaultCodespace, CodeSwapFailNotEnoughFee, "fail swap, not enough fee")
+	errSwapFail                  = se.Register(DefaultCodespace, CodeSwapFail, "fail swap")
+	errSwapFailInvalidAmount     = se.Register(DefaultCodespace, CodeSwapFailInvalidAmount, "fail swap, invalid amount")
+	errSwapFailInvalidBalance    = se.Register(DefaultCodespace, CodeSwapFailInvalidBalance, "fail swap, invalid balance")
+	errSwapFailNotEnoughBalance  = se.Register(DefaultCodespace, CodeSwapFailNotEnoughBalance, "fail swap, not enough balance")
+	errSwapFailSlipLimit         = se.Register(DefaultCodespace, CodeSwapFailSlipLimit, "fail swap, trade slip over limit")
+	errSwapFailLiquidityFeeLimit = se.Register(DefaultCodespace, CodeSwapFailLiquidityFeeLimit, "fail swap, liquidity fee over limit")
+	errSwapFailInvalidStream     = se.Register(DefaultCodespace, CodeSwapFailInvalidStream, "fail swap, invalid streaming swap")
+	errNoStakeUnitLeft           = se.Register(DefaultCodespace, CodeNoStakeUnitLeft, "nothing to withdraw")
+	errUnstakeWithin24Hours      = se.Register(DefaultCodespace, CodeUnstakeWithin24Hours, "you cannot unstake for 24 hours after staking for this blockchain")
+	errUnstakeFail               = se.Register(DefaultCodespace, CodeUnstakeFail, "fail to unstake")
+	errInternal                  = se.Register(DefaultCodespace, CodeInternalError, "internal error")
+	errLimitOrderFailValidation  = se.Register(DefaultCodespace, CodeLimitOrderFailValidation, "fail to validate limit order")
+	errLimitOrderNotFound        = se.Register(DefaultCodespace, CodeLimitOrderNotFound, "limit order not found")
+	errTradingHalted             = se.Register(DefaultCodespace, CodeTradingHalted, "trading is halted")
 )
 
 // ErrInternal return an error  of errInternal with additional message
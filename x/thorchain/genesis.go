@@ -495,7 +495,7 @@ func ExportGenesis(ctx cosmos.Context, k keeper.Keeper) GenesisState {
 	for ; iterMsgSwap.Valid(); iterMsgSwap.Next() {
 		var m MsgSwap
 		k.Cdc().MustUnmarshalBinaryBare(iterMsgSwap.Value(), &m)
-		swapMsgs = append(swapMsgs, m)
+		swapMsgs = append(swapMsgs, m.WithDefaults())
 	}
 
 	networkFees := make([]NetworkFee, 0)
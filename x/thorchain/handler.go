@@ -58,11 +58,13 @@ func getHandlerMapping(keeper keeper.Keeper, mgr Manager) map[string]MsgHandler
 	m[MsgMimir{}.Type()] = NewMimirHandler(keeper, mgr)
 	m[MsgBan{}.Type()] = NewBanHandler(keeper, mgr)
 	m[MsgNetworkFee{}.Type()] = NewNetworkFeeHandler(keeper, mgr)
+	m[MsgSolvency{}.Type()] = NewSolvencyHandler(keeper, mgr)
 
 	// cli handlers (non-consensus)
 	m[MsgSetNodeKeys{}.Type()] = NewSetNodeKeysHandler(keeper, mgr)
 	m[MsgSetVersion{}.Type()] = NewVersionHandler(keeper, mgr)
 	m[MsgSetIPAddress{}.Type()] = NewIPAddressHandler(keeper, mgr)
+	m[MsgMaintenance{}.Type()] = NewMaintenanceHandler(keeper, mgr)
 
 	// native handlers (non-consensus)
 	m[MsgSend{}.Type()] = NewSendHandler(keeper, mgr)
@@ -105,6 +107,8 @@ func getInternalHandlerMapping(keeper keeper.Keeper, mgr Manager) map[string]Msg
 	m[MsgMigrate{}.Type()] = NewMigrateHandler(keeper, mgr)
 	m[MsgRagnarok{}.Type()] = NewRagnarokHandler(keeper, mgr)
 	m[MsgSwitch{}.Type()] = NewSwitchHandler(keeper, mgr)
+	m[MsgLimitOrder{}.Type()] = NewLimitOrderHandler(keeper, mgr)
+	m[MsgCancelLimitOrder{}.Type()] = NewCancelLimitOrderHandler(keeper, mgr)
 	return m
 }
 
@@ -185,6 +189,10 @@ func processOneTxIn(ctx cosmos.Context, keeper keeper.Keeper, tx ObservedTx, sig
 		newMsg = NewMsgReserveContributor(tx.Tx, res, signer)
 	case SwitchMemo:
 		newMsg = NewMsgSwitch(tx.Tx, memo.GetDestination(), signer)
+	case LimitMemo:
+		newMsg, err = getMsgLimitOrderFromMemo(m, tx, signer)
+	case CancelMemo:
+		newMsg = NewMsgCancelLimitOrder(tx.Tx, m.GetTxID(), signer)
 	default:
 		return nil, errInvalidMemo
 	}
@@ -199,7 +207,21 @@ func getMsgSwapFromMemo(memo SwapMemo, tx ObservedTx, signer cosmos.AccAddress)
 	if memo.Destination.IsEmpty() {
 		memo.Destination = tx.Tx.FromAddress
 	}
-	return NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, signer), nil
+	msg := NewMsgSwap(tx.Tx, memo.GetAsset(), memo.Destination, memo.SlipLimit, signer)
+	msg.MaxSlip = memo.MaxSlip
+	msg.MaxLiquidityFee = memo.MaxLiquidityFee
+	msg.StreamInterval = memo.StreamInterval
+	msg.StreamQuantity = memo.StreamQuantity
+	msg.AffiliateAddress = memo.AffiliateAddress
+	msg.AffiliateBasisPoints = memo.AffiliateBasisPoints
+	return msg, nil
+}
+
+func getMsgLimitOrderFromMemo(memo LimitMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
+	if memo.Destination.IsEmpty() {
+		memo.Destination = tx.Tx.FromAddress
+	}
+	return NewMsgLimitOrder(tx.Tx, memo.GetAsset(), memo.Destination, memo.MinOut, memo.ExpiryHeight, signer), nil
 }
 
 func getMsgUnstakeFromMemo(memo UnstakeMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -207,7 +229,9 @@ func getMsgUnstakeFromMemo(memo UnstakeMemo, tx ObservedTx, signer cosmos.AccAdd
 	if !memo.GetAmount().IsZero() {
 		withdrawAmount = memo.GetAmount()
 	}
-	return NewMsgUnStake(tx.Tx, tx.Tx.FromAddress, withdrawAmount, memo.GetAsset(), signer), nil
+	msg := NewMsgUnStake(tx.Tx, tx.Tx.FromAddress, withdrawAmount, memo.GetAsset(), signer)
+	msg.TargetAsset = memo.GetTargetAsset()
+	return msg, nil
 }
 
 func getMsgStakeFromMemo(ctx cosmos.Context, memo StakeMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -235,7 +259,10 @@ func getMsgStakeFromMemo(ctx cosmos.Context, memo StakeMemo, tx ObservedTx, sign
 		}
 	}
 
-	return NewMsgStake(tx.Tx, memo.GetAsset(), runeCoin.Amount, assetCoin.Amount, runeAddr, assetAddr, signer), nil
+	msg := NewMsgStake(tx.Tx, memo.GetAsset(), runeCoin.Amount, assetCoin.Amount, runeAddr, assetAddr, signer)
+	msg.AffiliateAddress = memo.AffiliateAddress
+	msg.AffiliateBasisPoints = memo.AffiliateBasisPoints
+	return msg, nil
 }
 
 func getMsgAddFromMemo(memo AddMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
@@ -266,9 +293,14 @@ func getMsgLeaveFromMemo(memo LeaveMemo, tx ObservedTx, signer cosmos.AccAddress
 
 func getMsgBondFromMemo(memo BondMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
 	coin := tx.Tx.Coins.GetCoin(common.RuneAsset())
-	return NewMsgBond(tx.Tx, memo.GetAccAddress(), coin.Amount, tx.Tx.FromAddress, signer), nil
+	msg := NewMsgBond(tx.Tx, memo.GetAccAddress(), coin.Amount, tx.Tx.FromAddress, signer)
+	msg.BondProviderAddress = memo.BondProviderAddress
+	msg.NodeOperatorFee = memo.NodeOperatorFee
+	return msg, nil
 }
 
 func getMsgUnbondFromMemo(memo UnbondMemo, tx ObservedTx, signer cosmos.AccAddress) (cosmos.Msg, error) {
-	return NewMsgUnBond(tx.Tx, memo.GetAccAddress(), memo.GetAmount(), tx.Tx.FromAddress, signer), nil
+	msg := NewMsgUnBond(tx.Tx, memo.GetAccAddress(), memo.GetAmount(), tx.Tx.FromAddress, signer)
+	msg.BondProviderAddress = memo.BondProviderAddress
+	return msg, nil
 }
@@ -51,8 +51,26 @@ func (h BondHandler) validateV1(ctx cosmos.Context, version semver.Version, msg
 		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
 	}
 
+	// the first bond of a node account has to reach the minimum bond on its own , after that a node account with bond providers
+	// reach the minimum bond together , which is checked before it can churn in
+	hasBondProviders := false
+	if nodeAccount.Status != NodeUnknown {
+		bp, err := getBondProviders(ctx, h.keeper, nodeAccount)
+		if err != nil {
+			return ErrInternal(err, fmt.Sprintf("fail to get bond providers of node account(%s)", msg.NodeAddress))
+		}
+		isOperator := nodeAccount.BondAddress.Equals(msg.BondAddress)
+		if !isOperator && !bp.Has(msg.BondAddress) {
+			return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not a bond provider of %s", msg.BondAddress, msg.NodeAddress))
+		}
+		if !isOperator && (!msg.BondProviderAddress.IsEmpty() || msg.NodeOperatorFee >= 0) {
+			return cosmos.ErrUnauthorized("only node operator can whitelist bond provider or set node operator fee")
+		}
+		hasBondProviders = len(bp.Providers) > 1 || (!msg.BondProviderAddress.IsEmpty() && !msg.BondProviderAddress.Equals(msg.BondAddress))
+	}
+
 	bond := msg.Bond.Add(nodeAccount.Bond)
-	if bond.LT(minValidatorBond) {
+	if !hasBondProviders && bond.LT(minValidatorBond) {
 		return cosmos.ErrUnknownRequest(fmt.Sprintf("not enough rune to be whitelisted , minimum validator bond (%s) , bond(%s)", minValidatorBond.String(), bond))
 	}
 
@@ -115,11 +133,27 @@ func (h BondHandler) handle(ctx cosmos.Context, msg MsgBond, version semver.Vers
 			))
 	}
 
+	bp, err := getBondProviders(ctx, h.keeper, nodeAccount)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers of node account(%s)", msg.NodeAddress))
+	}
+	if nodeAccount.BondAddress.Equals(msg.BondAddress) {
+		if !msg.BondProviderAddress.IsEmpty() {
+			bp.Add(msg.BondProviderAddress)
+		}
+		if msg.NodeOperatorFee >= 0 {
+			bp.NodeOperatorFee = msg.NodeOperatorFee
+		}
+	}
+	bp.Bond(msg.BondAddress, msg.Bond, nodeAccount.Bond)
 	nodeAccount.Bond = nodeAccount.Bond.Add(msg.Bond)
 
 	if err := h.keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
 		return ErrInternal(err, fmt.Sprintf("fail to save node account(%s)", nodeAccount))
 	}
+	if err := h.keeper.SetBondProviders(ctx, bp); err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to save bond providers of node account(%s)", msg.NodeAddress))
+	}
 	return h.mintGasAsset(ctx, msg, constAccessor)
 }
 
@@ -151,3 +151,68 @@ func (HandlerBondSuite) TestBondHandlerFailValidation(c *C) {
 		c.Check(errors.Is(err, item.expectedErr), Equals, true, Commentf("name: %s, %s != %s", item.name, item.expectedErr, err))
 	}
 }
+
+func (HandlerBondSuite) TestBondProviders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, activeNodeAccount), IsNil)
+	handler := NewBondHandler(k, NewDummyMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+
+	nodeAddr := GetRandomBech32Addr()
+	operator := GetRandomBNBAddress()
+	provider := GetRandomBNBAddress()
+	bondTx := func(from common.Address, amt uint64) common.Tx {
+		return common.NewTx(
+			GetRandomTxHash(),
+			from,
+			GetRandomBNBAddress(),
+			common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(amt))},
+			BNBGasFeeSingleton,
+			"bond",
+		)
+	}
+
+	k.SetMimir(ctx, constants.MinimumBondInRune.String(), 200*common.One)
+
+	// the first bond has to reach the minimum bond , even when it whitelist a bond provider
+	msg := NewMsgBond(bondTx(operator, 100*common.One), nodeAddr, cosmos.NewUint(100*common.One), operator, activeNodeAccount.NodeAddress)
+	msg.BondProviderAddress = provider
+	msg.NodeOperatorFee = 2000
+	_, err := handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, NotNil)
+
+	// operator whitelist a bond provider , and set the fee
+	msg = NewMsgBond(bondTx(operator, 200*common.One), nodeAddr, cosmos.NewUint(200*common.One), operator, activeNodeAccount.NodeAddress)
+	msg.BondProviderAddress = provider
+	msg.NodeOperatorFee = 2000
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+
+	// address not whitelisted can't bond
+	stranger := GetRandomBNBAddress()
+	msg = NewMsgBond(bondTx(stranger, 100*common.One), nodeAddr, cosmos.NewUint(100*common.One), stranger, activeNodeAccount.NodeAddress)
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, NotNil)
+
+	// bond provider can't set the fee
+	msg = NewMsgBond(bondTx(provider, 300*common.One), nodeAddr, cosmos.NewUint(300*common.One), provider, activeNodeAccount.NodeAddress)
+	msg.NodeOperatorFee = 0
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, NotNil)
+
+	msg.NodeOperatorFee = -1
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+
+	na, err := k.GetNodeAccount(ctx, nodeAddr)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(cosmos.NewUint(500*common.One)), Equals, true)
+	c.Check(na.BondAddress.Equals(operator), Equals, true)
+	bp, err := k.GetBondProviders(ctx, nodeAddr)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee, Equals, int64(2000))
+	c.Check(bp.GetBond(operator, na.Bond).Equal(cosmos.NewUint(200*common.One)), Equals, true)
+	c.Check(bp.GetBond(provider, na.Bond).Equal(cosmos.NewUint(300*common.One)), Equals, true)
+}
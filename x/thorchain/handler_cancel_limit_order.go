@@ -0,0 +1,87 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// CancelLimitOrderHandler is the handler to cancel a limit order, only the sender of the limit order can cancel it
+// the limit order as well as the coins sent along with the cancel request are refunded
+type CancelLimitOrderHandler struct {
+	keeper keeper.Keeper
+	mgr    Manager
+}
+
+// NewCancelLimitOrderHandler create a new instance of CancelLimitOrderHandler
+func NewCancelLimitOrderHandler(keeper keeper.Keeper, mgr Manager) CancelLimitOrderHandler {
+	return CancelLimitOrderHandler{
+		keeper: keeper,
+		mgr:    mgr,
+	}
+}
+
+// Run is the main entry point of cancel limit order message
+func (h CancelLimitOrderHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
+	msg, ok := m.(MsgCancelLimitOrder)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, msg, version); err != nil {
+		ctx.Logger().Error("MsgCancelLimitOrder failed validation", "error", err)
+		return nil, err
+	}
+	if err := h.handle(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("fail to handle MsgCancelLimitOrder", "error", err)
+		return nil, err
+	}
+	return &cosmos.Result{}, nil
+}
+
+func (h CancelLimitOrderHandler) validate(ctx cosmos.Context, msg MsgCancelLimitOrder, version semver.Version) error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg)
+	}
+	return errInvalidVersion
+}
+
+func (h CancelLimitOrderHandler) validateV1(ctx cosmos.Context, msg MsgCancelLimitOrder) error {
+	return msg.ValidateBasic()
+}
+
+func (h CancelLimitOrderHandler) handle(ctx cosmos.Context, msg MsgCancelLimitOrder, version semver.Version, constAccessor constants.ConstantValues) error {
+	ctx.Logger().Info("receive MsgCancelLimitOrder", "request tx hash", msg.Tx.ID, "limit order tx hash", msg.OrderTxID)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	}
+	return errBadVersion
+}
+
+func (h CancelLimitOrderHandler) handleV1(ctx cosmos.Context, msg MsgCancelLimitOrder, constAccessor constants.ConstantValues) error {
+	order, err := h.keeper.GetLimitOrder(ctx, msg.OrderTxID)
+	if err != nil {
+		return ErrInternal(err, "fail to get limit order")
+	}
+	if order.IsEmpty() {
+		return se.Wrapf(errLimitOrderNotFound, "limit order %s", msg.OrderTxID)
+	}
+	if !order.Tx.FromAddress.Equals(msg.Tx.FromAddress) {
+		return se.Wrap(se.ErrUnauthorized, "only the sender of the limit order can cancel it")
+	}
+
+	h.keeper.RemoveLimitOrder(ctx, order.Tx.ID)
+	if err := refundTx(ctx, ObservedTx{Tx: order.Tx}, h.mgr, h.keeper, constAccessor, CodeLimitOrderCancelled, "limit order cancelled", ""); err != nil {
+		return ErrInternal(err, "fail to refund limit order")
+	}
+	if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventLimitOrder(order, LimitOrderCancelled, cosmos.ZeroUint())); err != nil {
+		ctx.Logger().Error("fail to emit limit order event", "error", err)
+	}
+	// the cancel request itself carry coins , send them back as well
+	if err := refundTx(ctx, ObservedTx{Tx: msg.Tx}, h.mgr, h.keeper, constAccessor, CodeLimitOrderCancelled, "limit order cancelled", ""); err != nil {
+		ctx.Logger().Error("fail to refund cancel limit order request", "error", err)
+	}
+	return nil
+}
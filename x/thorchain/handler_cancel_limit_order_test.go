@@ -0,0 +1,65 @@
+package thorchain
+
+import (
+	"errors"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerCancelLimitOrderSuite struct{}
+
+var _ = Suite(&HandlerCancelLimitOrderSuite{})
+
+func (s *HandlerCancelLimitOrderSuite) TestHandle(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewCancelLimitOrderHandler(k, mgr)
+
+	sender := GetRandomBNBAddress()
+	tx := common.NewTx(GetRandomTxHash(), sender, GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(10*common.One))},
+		BNBGasFeeSingleton, "")
+	order := NewLimitOrder(tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(20*common.One), 5, 20)
+	k.SetLimitOrder(ctx, order)
+
+	cancelTx := common.NewTx(GetRandomTxHash(), sender, GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(common.One))},
+		BNBGasFeeSingleton, "")
+
+	// order doesn't exist
+	msg := NewMsgCancelLimitOrder(cancelTx, GetRandomTxHash(), GetRandomBech32Addr())
+	result, err := handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, errLimitOrderNotFound), Equals, true)
+	c.Check(result, IsNil)
+
+	// only the sender of the order can cancel it
+	otherTx := cancelTx
+	otherTx.FromAddress = GetRandomBNBAddress()
+	msg = NewMsgCancelLimitOrder(otherTx, tx.ID, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, se.ErrUnauthorized), Equals, true)
+	c.Check(result, IsNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, true)
+
+	msg = NewMsgCancelLimitOrder(cancelTx, tx.ID, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(result, NotNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, false)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].InHash.Equals(tx.ID), Equals, true)
+	c.Check(items[0].ToAddress.Equals(sender), Equals, true)
+	c.Check(items[1].InHash.Equals(cancelTx.ID), Equals, true)
+}
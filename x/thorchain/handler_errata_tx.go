@@ -79,7 +79,7 @@ func (h ErrataTxHandler) handleV1(ctx cosmos.Context, msg MsgErrataTx, version s
 	}
 	observeSlashPoints := constAccessor.GetInt64Value(constants.ObserveSlashPoints)
 	observeFlex := constAccessor.GetInt64Value(constants.ObserveFlex)
-	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, msg.Signer)
+	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, msg.TxID, msg.Signer)
 	if !voter.Sign(msg.Signer) {
 		ctx.Logger().Info("signer already signed MsgErrataTx", "signer", msg.Signer.String(), "txid", msg.TxID)
 		return &cosmos.Result{}, nil
@@ -93,7 +93,7 @@ func (h ErrataTxHandler) handleV1(ctx cosmos.Context, msg MsgErrataTx, version s
 
 	if voter.BlockHeight > 0 {
 		if (voter.BlockHeight + observeFlex) >= common.BlockHeight(ctx) {
-			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, msg.Signer)
+			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, msg.TxID, msg.Signer)
 		}
 		// errata tx already processed
 		return &cosmos.Result{}, nil
@@ -102,7 +102,7 @@ func (h ErrataTxHandler) handleV1(ctx cosmos.Context, msg MsgErrataTx, version s
 	voter.BlockHeight = common.BlockHeight(ctx)
 	h.keeper.SetErrataTxVoter(ctx, voter)
 	// decrease the slash points
-	h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, voter.Signers...)
+	h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, msg.TxID, voter.Signers...)
 	observedVoter, err := h.keeper.GetObservedTxInVoter(ctx, msg.TxID)
 	if err != nil {
 		return nil, err
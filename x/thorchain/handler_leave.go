@@ -89,7 +89,9 @@ func (h LeaveHandler) handle(ctx cosmos.Context, msg MsgLeave, version semver.Ve
 
 	coin := msg.Tx.Coins.GetCoin(common.RuneAsset())
 	if !coin.IsEmpty() {
-		nodeAcc.Bond = nodeAcc.Bond.Add(coin.Amount)
+		if err := addProviderBond(ctx, h.keeper, &nodeAcc, nodeAcc.BondAddress, coin.Amount); err != nil {
+			return ErrInternal(err, "fail to add bond")
+		}
 	}
 
 	if nodeAcc.Status == NodeActive {
@@ -114,7 +116,7 @@ func (h LeaveHandler) handle(ctx cosmos.Context, msg MsgLeave, version semver.Ve
 			// vault (it was destroyed when we successfully migrated funds from
 			// their address to a new TSS vault
 			if !h.keeper.VaultExists(ctx, nodeAcc.PubKeySet.Secp256k1) {
-				if err := refundBond(ctx, msg.Tx, cosmos.ZeroUint(), &nodeAcc, h.keeper, h.mgr); err != nil {
+				if err := refundBond(ctx, msg.Tx, common.NoAddress, cosmos.ZeroUint(), &nodeAcc, h.keeper, h.mgr); err != nil {
 					return ErrInternal(err, "fail to refund bond")
 				}
 				nodeAcc.UpdateStatus(NodeDisabled, common.BlockHeight(ctx))
@@ -128,7 +130,7 @@ func (h LeaveHandler) handle(ctx cosmos.Context, msg MsgLeave, version semver.Ve
 				if vault.IsYggdrasil() {
 					if !vault.HasFunds() {
 						// node is not active , they are free to leave , refund them
-						if err := refundBond(ctx, msg.Tx, cosmos.ZeroUint(), &nodeAcc, h.keeper, h.mgr); err != nil {
+						if err := refundBond(ctx, msg.Tx, common.NoAddress, cosmos.ZeroUint(), &nodeAcc, h.keeper, h.mgr); err != nil {
 							return ErrInternal(err, "fail to refund bond")
 						}
 						nodeAcc.UpdateStatus(NodeDisabled, common.BlockHeight(ctx))
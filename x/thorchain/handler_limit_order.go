@@ -0,0 +1,104 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// LimitOrderHandler is the handler to place a limit order, the order is kept in the key value store and evaluated
+// every block by the swap queue until it is filled or expired
+type LimitOrderHandler struct {
+	keeper keeper.Keeper
+	mgr    Manager
+}
+
+// NewLimitOrderHandler create a new instance of LimitOrderHandler
+func NewLimitOrderHandler(keeper keeper.Keeper, mgr Manager) LimitOrderHandler {
+	return LimitOrderHandler{
+		keeper: keeper,
+		mgr:    mgr,
+	}
+}
+
+// Run is the main entry point of limit order message
+func (h LimitOrderHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
+	msg, ok := m.(MsgLimitOrder)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	if err := h.validate(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("MsgLimitOrder failed validation", "error", err)
+		return nil, err
+	}
+	if err := h.handle(ctx, msg, version); err != nil {
+		ctx.Logger().Error("fail to handle MsgLimitOrder", "error", err)
+		return nil, err
+	}
+	return &cosmos.Result{}, nil
+}
+
+func (h LimitOrderHandler) validate(ctx cosmos.Context, msg MsgLimitOrder, version semver.Version, constAccessor constants.ConstantValues) error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg, constAccessor)
+	}
+	return errInvalidVersion
+}
+
+func (h LimitOrderHandler) validateV1(ctx cosmos.Context, msg MsgLimitOrder, constAccessor constants.ConstantValues) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.ExpiryHeight <= common.BlockHeight(ctx) {
+		return se.Wrapf(errLimitOrderFailValidation, "expiry height %d is not after current block height %d", msg.ExpiryHeight, common.BlockHeight(ctx))
+	}
+	maxExpiry, err := h.keeper.GetMimir(ctx, constants.MaxLimitOrderExpiryBlocks.String())
+	if maxExpiry < 0 || err != nil {
+		maxExpiry = constAccessor.GetInt64Value(constants.MaxLimitOrderExpiryBlocks)
+	}
+	if msg.ExpiryHeight-common.BlockHeight(ctx) > maxExpiry {
+		return se.Wrapf(errLimitOrderFailValidation, "expiry height %d is more than %d blocks away", msg.ExpiryHeight, maxExpiry)
+	}
+	if h.keeper.LimitOrderExists(ctx, msg.Tx.ID) {
+		return se.Wrapf(errLimitOrderFailValidation, "limit order %s already exist", msg.Tx.ID)
+	}
+	if err := validatePools(ctx, h.keeper, msg.Tx.Coins[0].Asset, msg.TargetAsset); err != nil {
+		return se.Wrap(errLimitOrderFailValidation, err.Error())
+	}
+	minValue, err := h.keeper.GetMimir(ctx, constants.MinLimitOrderValue.String())
+	if minValue < 0 || err != nil {
+		minValue = constAccessor.GetInt64Value(constants.MinLimitOrderValue)
+	}
+	value, err := getSpotSwapOut(ctx, h.keeper, msg.Tx.Coins[0], common.RuneAsset())
+	if err != nil {
+		return err
+	}
+	if value.LT(cosmos.NewUint(uint64(minValue))) {
+		return se.Wrapf(errLimitOrderFailValidation, "limit order worth %s RUNE , less than the minimum %d", value, minValue)
+	}
+	return nil
+}
+
+func (h LimitOrderHandler) handle(ctx cosmos.Context, msg MsgLimitOrder, version semver.Version) error {
+	ctx.Logger().Info("receive MsgLimitOrder", "request tx hash", msg.Tx.ID, "source asset", msg.Tx.Coins[0].Asset, "target asset", msg.TargetAsset, "min out", msg.MinOut, "expiry height", msg.ExpiryHeight)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg)
+	}
+	return errBadVersion
+}
+
+func (h LimitOrderHandler) handleV1(ctx cosmos.Context, msg MsgLimitOrder) error {
+	order := NewLimitOrder(msg.Tx, msg.TargetAsset, msg.Destination, msg.MinOut, common.BlockHeight(ctx), msg.ExpiryHeight)
+	if err := order.Valid(); err != nil {
+		return se.Wrap(errLimitOrderFailValidation, err.Error())
+	}
+	h.keeper.SetLimitOrder(ctx, order)
+	if err := h.mgr.EventMgr().EmitEvent(ctx, NewEventLimitOrder(order, LimitOrderPlaced, cosmos.ZeroUint())); err != nil {
+		ctx.Logger().Error("fail to emit limit order event", "error", err)
+	}
+	return nil
+}
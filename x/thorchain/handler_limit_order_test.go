@@ -0,0 +1,85 @@
+package thorchain
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerLimitOrderSuite struct{}
+
+var _ = Suite(&HandlerLimitOrderSuite{})
+
+func (s *HandlerLimitOrderSuite) TestHandle(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewDummyMgr()
+	handler := NewLimitOrderHandler(k, mgr)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	tx := common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(10*common.One))},
+		BNBGasFeeSingleton, "")
+	destination := GetRandomBNBAddress()
+
+	// wrong msg type
+	result, err := handler.Run(ctx, NewMsgMimir("what", 1, GetRandomBech32Addr()), ver, constAccessor)
+	c.Check(err, NotNil)
+	c.Check(result, IsNil)
+
+	// expiry height already passed
+	msg := NewMsgLimitOrder(tx, common.BNBAsset, destination, cosmos.NewUint(20*common.One), 10, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, errLimitOrderFailValidation), Equals, true)
+	c.Check(result, IsNil)
+
+	// pool doesn't exist
+	msg = NewMsgLimitOrder(tx, common.BTCAsset, destination, cosmos.NewUint(20*common.One), 20, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, errLimitOrderFailValidation), Equals, true)
+	c.Check(result, IsNil)
+
+	// expiry height too far away
+	maxExpiry := constAccessor.GetInt64Value(constants.MaxLimitOrderExpiryBlocks)
+	msg = NewMsgLimitOrder(tx, common.BNBAsset, destination, cosmos.NewUint(20*common.One), 11+maxExpiry, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, errLimitOrderFailValidation), Equals, true)
+	c.Check(result, IsNil)
+
+	// order worth less than the minimum value
+	smallTx := tx
+	smallTx.ID = GetRandomTxHash()
+	smallTx.Coins = common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))}
+	msg = NewMsgLimitOrder(smallTx, common.RuneAsset(), destination, cosmos.NewUint(common.One), 20, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, errLimitOrderFailValidation), Equals, true)
+	c.Check(result, IsNil)
+
+	msg = NewMsgLimitOrder(tx, common.BNBAsset, destination, cosmos.NewUint(20*common.One), 20, GetRandomBech32Addr())
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(result, NotNil)
+	order, err := k.GetLimitOrder(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(order.Height, Equals, int64(10))
+	c.Check(order.ExpiryHeight, Equals, int64(20))
+	c.Check(order.MinOut.Equal(cosmos.NewUint(20*common.One)), Equals, true)
+	c.Check(order.Destination.Equals(destination), Equals, true)
+
+	// the same order can't be placed twice
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(errors.Is(err, errLimitOrderFailValidation), Equals, true)
+	c.Check(result, IsNil)
+}
@@ -0,0 +1,199 @@
+package thorchain
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// MaintenanceHandler is to handle maintenance message , a node operator put its node account in maintenance before
+// upgrading bifrost or resyncing a chain daemon , so it doesn't get slashed for lack of observing while it is down
+type MaintenanceHandler struct {
+	keeper keeper.Keeper
+	mgr    Manager
+}
+
+// NewMaintenanceHandler create new instance of MaintenanceHandler
+func NewMaintenanceHandler(keeper keeper.Keeper, mgr Manager) MaintenanceHandler {
+	return MaintenanceHandler{
+		keeper: keeper,
+		mgr:    mgr,
+	}
+}
+
+// Run it the main entry point to execute maintenance logic
+func (h MaintenanceHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
+	msg, ok := m.(MsgMaintenance)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	ctx.Logger().Info("receive maintenance", "node address", msg.Signer.String(), "blocks", msg.Blocks)
+	if err := h.validate(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("msg maintenance failed validation", "error", err)
+		return nil, err
+	}
+	if err := h.handle(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("fail to process msg maintenance", "error", err)
+		return nil, err
+	}
+
+	return &cosmos.Result{}, nil
+}
+
+func (h MaintenanceHandler) validate(ctx cosmos.Context, msg MsgMaintenance, version semver.Version, constAccessor constants.ConstantValues) error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg, constAccessor)
+	}
+	return errBadVersion
+}
+
+func (h MaintenanceHandler) validateV1(ctx cosmos.Context, msg MsgMaintenance, constAccessor constants.ConstantValues) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	nodeAccount, err := h.keeper.GetNodeAccount(ctx, msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("fail to get node account", "error", err, "address", msg.Signer.String())
+		return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not authorizaed", msg.Signer))
+	}
+	if nodeAccount.IsEmpty() || nodeAccount.Status == NodeDisabled {
+		ctx.Logger().Error("unauthorized account", "address", msg.Signer.String())
+		return cosmos.ErrUnauthorized(fmt.Sprintf("%s is not authorizaed", msg.Signer))
+	}
+
+	cost := constAccessor.GetInt64Value(constants.CliTxCost)
+	if nodeAccount.Bond.LT(cosmos.NewUint(uint64(cost))) {
+		return cosmos.ErrUnauthorized("not enough bond")
+	}
+
+	maintenance, err := h.keeper.GetNodeMaintenance(ctx, msg.Signer)
+	if err != nil {
+		return fmt.Errorf("fail to get node maintenance: %w", err)
+	}
+	height := common.BlockHeight(ctx)
+	inMaintenance := maintenance.InMaintenance(height)
+	if msg.Blocks == 0 {
+		if !inMaintenance {
+			return cosmos.ErrUnknownRequest("node account is not in maintenance")
+		}
+		return nil
+	}
+	if inMaintenance {
+		return cosmos.ErrUnknownRequest("node account is already in maintenance")
+	}
+
+	maxBlocks, err := h.keeper.GetMimir(ctx, constants.MaxMaintenanceBlocks.String())
+	if maxBlocks < 0 || err != nil {
+		maxBlocks = constAccessor.GetInt64Value(constants.MaxMaintenanceBlocks)
+	}
+	if msg.Blocks > maxBlocks {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("maintenance cannot be longer than %d blocks", maxBlocks))
+	}
+
+	cooldown, err := h.keeper.GetMimir(ctx, constants.MaintenanceCooldownBlocks.String())
+	if cooldown < 0 || err != nil {
+		cooldown = constAccessor.GetInt64Value(constants.MaintenanceCooldownBlocks)
+	}
+	if !maintenance.IsEmpty() && height < maintenance.StartHeight+cooldown {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("node account cannot request maintenance again until block %d", maintenance.StartHeight+cooldown))
+	}
+
+	if nodeAccount.Status != NodeActive {
+		return nil
+	}
+	active, err := h.keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get active node accounts: %w", err)
+	}
+	nodes, err := getNodesInMaintenance(ctx, h.keeper)
+	if err != nil {
+		return fmt.Errorf("fail to get node accounts in maintenance: %w", err)
+	}
+	activeInMaintenance := int64(0)
+	for _, node := range nodes {
+		for _, na := range active {
+			if na.NodeAddress.Equals(node.NodeAddress) {
+				activeInMaintenance++
+				break
+			}
+		}
+	}
+	if activeInMaintenance >= getMaxMaintenanceNodes(ctx, h.keeper, constAccessor, int64(len(active))) {
+		return cosmos.ErrUnknownRequest("too many active node accounts are in maintenance")
+	}
+
+	return nil
+}
+
+func (h MaintenanceHandler) handle(ctx cosmos.Context, msg MsgMaintenance, version semver.Version, constAccessor constants.ConstantValues) error {
+	ctx.Logger().Info("handleMsgMaintenance request", "node address", msg.Signer.String(), "blocks", msg.Blocks)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	}
+	ctx.Logger().Error(errInvalidVersion.Error())
+	return errBadVersion
+}
+
+func (h MaintenanceHandler) handleV1(ctx cosmos.Context, msg MsgMaintenance, constAccessor constants.ConstantValues) error {
+	nodeAccount, err := h.keeper.GetNodeAccount(ctx, msg.Signer)
+	if err != nil {
+		ctx.Logger().Error("fail to get node account", "error", err, "address", msg.Signer.String())
+		return cosmos.ErrUnauthorized(fmt.Sprintf("unable to find account: %s", msg.Signer))
+	}
+
+	height := common.BlockHeight(ctx)
+	maintenance := NewNodeMaintenance(msg.Signer, height, height+msg.Blocks)
+	if msg.Blocks == 0 {
+		// end the maintenance early , the start height is kept so the node account can't request again before the cooldown
+		maintenance, err = h.keeper.GetNodeMaintenance(ctx, msg.Signer)
+		if err != nil {
+			return fmt.Errorf("fail to get node maintenance: %w", err)
+		}
+		maintenance.EndHeight = height
+	}
+	if err := h.keeper.SetNodeMaintenance(ctx, maintenance); err != nil {
+		return fmt.Errorf("fail to save node maintenance: %w", err)
+	}
+
+	cost := cosmos.NewUint(uint64(constAccessor.GetInt64Value(constants.CliTxCost)))
+	if cost.GT(nodeAccount.Bond) {
+		cost = nodeAccount.Bond
+	}
+	nodeAccount.Bond = common.SafeSub(nodeAccount.Bond, cost) // take bond
+	if err := h.keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
+		return fmt.Errorf("fail to save node account: %w", err)
+	}
+
+	// add cost to reserve
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		coin := common.NewCoin(common.RuneNative, cost)
+		if err := h.keeper.SendFromAccountToModule(ctx, msg.Signer, ReserveName, coin); err != nil {
+			ctx.Logger().Error("fail to transfer funds from bond to reserve", "error", err)
+			return err
+		}
+	} else {
+		vaultData, err := h.keeper.GetVaultData(ctx)
+		if err != nil {
+			return fmt.Errorf("fail to get vault data: %w", err)
+		}
+		vaultData.TotalReserve = vaultData.TotalReserve.Add(cost)
+		if err := h.keeper.SetVaultData(ctx, vaultData); err != nil {
+			return fmt.Errorf("fail to save vault data: %w", err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		cosmos.NewEvent("maintenance",
+			cosmos.NewAttribute("thor_address", msg.Signer.String()),
+			cosmos.NewAttribute("start_height", strconv.FormatInt(maintenance.StartHeight, 10)),
+			cosmos.NewAttribute("end_height", strconv.FormatInt(maintenance.EndHeight, 10))))
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package thorchain
+
+import (
+	"github.com/blang/semver"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type HandlerMaintenanceSuite struct{}
+
+var _ = Suite(&HandlerMaintenanceSuite{})
+
+func (s *HandlerMaintenanceSuite) TestMaintenance(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	cost := cosmos.NewUint(uint64(constAccessor.GetInt64Value(constants.CliTxCost)))
+
+	// with 10 active node accounts , only one of them can be in maintenance at once
+	nas := make(NodeAccounts, 10)
+	for i := range nas {
+		nas[i] = GetRandomNodeAccount(NodeActive)
+		c.Assert(k.SetNodeAccount(ctx, nas[i]), IsNil)
+		if common.RuneAsset().Chain.Equals(common.THORChain) {
+			FundAccount(c, ctx, k, nas[i].NodeAddress, 10*common.One)
+		}
+	}
+	c.Check(getMaxMaintenanceNodes(ctx, k, constAccessor, int64(len(nas))), Equals, int64(1))
+	handler := NewMaintenanceHandler(k, NewDummyMgr())
+	height := common.BlockHeight(ctx)
+
+	// invalid version
+	msg := NewMsgMaintenance(100, nas[0].NodeAddress)
+	c.Check(handler.validate(ctx, msg, semver.Version{}, constAccessor), Equals, errBadVersion)
+	// not a node account
+	_, err := handler.Run(ctx, NewMsgMaintenance(100, GetRandomBech32Addr()), ver, constAccessor)
+	c.Check(err, NotNil)
+	// too long
+	_, err = handler.Run(ctx, NewMsgMaintenance(constAccessor.GetInt64Value(constants.MaxMaintenanceBlocks)+1, nas[0].NodeAddress), ver, constAccessor)
+	c.Check(err, NotNil)
+	// not in maintenance , nothing to end
+	_, err = handler.Run(ctx, NewMsgMaintenance(0, nas[0].NodeAddress), ver, constAccessor)
+	c.Check(err, NotNil)
+
+	// happy path
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(isNodeInMaintenance(ctx, k, nas[0].NodeAddress), Equals, true)
+	na, err := k.GetNodeAccount(ctx, nas[0].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(common.SafeSub(nas[0].Bond, cost)), Equals, true)
+	maintenance, err := k.GetNodeMaintenance(ctx, nas[0].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(maintenance.StartHeight, Equals, height)
+	c.Check(maintenance.EndHeight, Equals, height+100)
+
+	// the node account in maintenance is left out of the signing party , unless the rest can't sign
+	members := make(common.PubKeys, len(nas))
+	for i := range nas {
+		members[i] = nas[i].PubKeySet.Secp256k1
+	}
+	candidates := getSigningCandidates(ctx, k, members, 7)
+	c.Check(candidates, HasLen, 9)
+	c.Check(candidates.Contains(nas[0].PubKeySet.Secp256k1), Equals, false)
+	c.Check(getSigningCandidates(ctx, k, members, 10), HasLen, 10)
+
+	// already in maintenance
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(err, NotNil)
+	// too many active node accounts in maintenance
+	_, err = handler.Run(ctx, NewMsgMaintenance(100, nas[1].NodeAddress), ver, constAccessor)
+	c.Check(err, NotNil)
+	// standby node accounts don't count toward the cap
+	standby := GetRandomNodeAccount(NodeStandby)
+	c.Assert(k.SetNodeAccount(ctx, standby), IsNil)
+	if common.RuneAsset().Chain.Equals(common.THORChain) {
+		FundAccount(c, ctx, k, standby.NodeAddress, 10*common.One)
+	}
+	_, err = handler.Run(ctx, NewMsgMaintenance(100, standby.NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+	nodes, err := getNodesInMaintenance(ctx, k)
+	c.Assert(err, IsNil)
+	c.Check(nodes, HasLen, 2)
+
+	// end the maintenance early
+	ctx = ctx.WithBlockHeight(height + 10)
+	_, err = handler.Run(ctx, NewMsgMaintenance(0, nas[0].NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(isNodeInMaintenance(ctx, k, nas[0].NodeAddress), Equals, false)
+	// the node account has to wait for the cooldown before it can request maintenance again
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Check(err, NotNil)
+	_, err = handler.Run(ctx, NewMsgMaintenance(100, nas[1].NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+
+	ctx = ctx.WithBlockHeight(height + constAccessor.GetInt64Value(constants.MaintenanceCooldownBlocks))
+	c.Check(isNodeInMaintenance(ctx, k, nas[1].NodeAddress), Equals, false)
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+}
@@ -143,8 +143,9 @@ func (h NativeTxHandler) handleV1(ctx cosmos.Context, msg MsgNativeTx, version s
 	// check if we've halted trading
 	_, isSwap := m.(MsgSwap)
 	_, isStake := m.(MsgStake)
+	_, isLimitOrder := m.(MsgLimitOrder)
 	haltTrading, err := h.keeper.GetMimir(ctx, "HaltTrading")
-	if isSwap || isStake {
+	if isSwap || isStake || isLimitOrder {
 		if (haltTrading > 0 && haltTrading < common.BlockHeight(ctx) && err == nil) || h.keeper.RagnarokInProgress(ctx) {
 			ctx.Logger().Info("trading is halted!!")
 			if newErr := refundTx(ctx, txIn, h.mgr, h.keeper, constAccessor, se.ErrUnauthorized.ABCICode(), "trading halted", targetModule); nil != newErr {
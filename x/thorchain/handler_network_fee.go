@@ -78,7 +78,7 @@ func (h NetworkFeeHandler) handleV1(ctx cosmos.Context, msg MsgNetworkFee, versi
 	}
 	observeSlashPoints := constAccessor.GetInt64Value(constants.ObserveSlashPoints)
 	observeFlex := constAccessor.GetInt64Value(constants.ObserveFlex)
-	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, msg.Signer)
+	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, common.BlankTxID, msg.Signer)
 	if !voter.Sign(msg.Signer) {
 		ctx.Logger().Info("signer already signed MsgNetworkFee", "signer", msg.Signer.String(), "block height", msg.BlockHeight, "chain", msg.Chain.String())
 		return &cosmos.Result{}, nil
@@ -92,7 +92,7 @@ func (h NetworkFeeHandler) handleV1(ctx cosmos.Context, msg MsgNetworkFee, versi
 
 	if voter.BlockHeight > 0 {
 		if (voter.BlockHeight + observeFlex) >= common.BlockHeight(ctx) {
-			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, msg.Signer)
+			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, common.BlankTxID, msg.Signer)
 		}
 		// MsgNetworkFee tx already processed
 		return &cosmos.Result{}, nil
@@ -101,7 +101,7 @@ func (h NetworkFeeHandler) handleV1(ctx cosmos.Context, msg MsgNetworkFee, versi
 	voter.BlockHeight = common.BlockHeight(ctx)
 	h.keeper.SetObservedNetworkFeeVoter(ctx, voter)
 	// decrease the slash points
-	h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, voter.Signers...)
+	h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, common.BlankTxID, voter.Signers...)
 	ctx.Logger().Info("update network fee", "chain", msg.Chain.String(), "transaction-size", msg.TransactionSize, "fee-rate", msg.TransactionFeeRate.String())
 	if err := h.keeper.SaveNetworkFee(ctx, msg.Chain, NetworkFee{
 		Chain:              msg.Chain,
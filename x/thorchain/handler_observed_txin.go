@@ -74,7 +74,7 @@ func (h ObservedTxInHandler) handle(ctx cosmos.Context, msg MsgObservedTxIn, ver
 func (h ObservedTxInHandler) preflight(ctx cosmos.Context, voter ObservedTxVoter, nas NodeAccounts, tx ObservedTx, signer cosmos.AccAddress, version semver.Version, constAccessor constants.ConstantValues) (ObservedTxVoter, bool) {
 	observeSlashPoints := constAccessor.GetInt64Value(constants.ObserveSlashPoints)
 	observeFlex := constAccessor.GetInt64Value(constants.ObserveFlex)
-	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, signer)
+	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, tx.Tx.ID, signer)
 	ok := false
 	if !voter.Add(tx, signer) {
 		return voter, ok
@@ -87,12 +87,12 @@ func (h ObservedTxInHandler) preflight(ctx cosmos.Context, voter ObservedTxVoter
 			voter.Tx = voter.GetTx(nas)
 
 			// tx has consensus now, so decrease the slashing points for all the signers whom had voted for it
-			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, voter.Tx.Signers...)
+			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, tx.Tx.ID, voter.Tx.Signers...)
 		} else {
 			// event the tx had been processed , given the signer just a bit late , so still take away their slash points
 			// but only when the tx signer are voting is the tx that already reached consensus
 			if common.BlockHeight(ctx) <= (voter.Height+observeFlex) && voter.Tx.Equals(tx) {
-				h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, signer)
+				h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, tx.Tx.ID, signer)
 			}
 		}
 	}
@@ -201,8 +201,9 @@ func (h ObservedTxInHandler) handleV1(ctx cosmos.Context, version semver.Version
 		// check if we've halted trading
 		_, isSwap := m.(MsgSwap)
 		_, isStake := m.(MsgStake)
+		_, isLimitOrder := m.(MsgLimitOrder)
 		haltTrading, err := h.keeper.GetMimir(ctx, "HaltTrading")
-		if isSwap || isStake {
+		if isSwap || isStake || isLimitOrder {
 			if (haltTrading > 0 && haltTrading < common.BlockHeight(ctx) && err == nil) || h.keeper.RagnarokInProgress(ctx) {
 				ctx.Logger().Info("trading is halted!!")
 				if newErr := refundTx(ctx, tx, h.mgr, h.keeper, constAccessor, se.ErrUnauthorized.ABCICode(), "trading halted", ""); nil != newErr {
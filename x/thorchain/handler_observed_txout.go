@@ -73,7 +73,7 @@ func (h ObservedTxOutHandler) preflight(ctx cosmos.Context, voter ObservedTxVote
 	observeSlashPoints := constAccessor.GetInt64Value(constants.ObserveSlashPoints)
 	observeFlex := constAccessor.GetInt64Value(constants.ObserveFlex)
 	ok := false
-	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, signer)
+	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, tx.Tx.ID, signer)
 	if !voter.Add(tx, signer) {
 		// when the signer already sign it
 		return voter, ok
@@ -84,12 +84,12 @@ func (h ObservedTxOutHandler) preflight(ctx cosmos.Context, voter ObservedTxVote
 			voter.Height = common.BlockHeight(ctx)
 			voter.Tx = voter.GetTx(nas)
 			// tx has consensus now, so decrease the slashing point for all the signers whom voted for it
-			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, voter.Tx.Signers...)
+			h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, tx.Tx.ID, voter.Tx.Signers...)
 
 		} else {
 			// event the tx had been processed , given the signer just a bit late , so we still take away their slash points
 			if common.BlockHeight(ctx) <= (voter.Height+observeFlex) && voter.Tx.Equals(tx) {
-				h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, signer)
+				h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, tx.Tx.ID, signer)
 			}
 		}
 	}
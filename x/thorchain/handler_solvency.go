@@ -0,0 +1,130 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// SolvencyHandler a handler to process MsgSolvency messages
+type SolvencyHandler struct {
+	keeper keeper.Keeper
+	mgr    Manager
+}
+
+// NewSolvencyHandler create a new instance of solvency handler
+func NewSolvencyHandler(keeper keeper.Keeper, mgr Manager) SolvencyHandler {
+	return SolvencyHandler{keeper: keeper, mgr: mgr}
+}
+
+// Run is the main entry point for solvency logic
+func (h SolvencyHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
+	msg, ok := m.(MsgSolvency)
+	if !ok {
+		return nil, errInvalidMessage
+	}
+	ctx.Logger().Info("receive msg solvency")
+	if err := h.validate(ctx, msg, version, constAccessor); err != nil {
+		ctx.Logger().Error("MsgSolvency failed validation", "error", err)
+		return nil, err
+	}
+	result, err := h.handle(ctx, msg, version, constAccessor)
+	if err != nil {
+		ctx.Logger().Error("fail to process MsgSolvency", "error", err)
+	}
+	return result, err
+}
+
+func (h SolvencyHandler) validate(ctx cosmos.Context, msg MsgSolvency, version semver.Version, constAccessor constants.ConstantValues) error {
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.validateV1(ctx, msg, constAccessor)
+	}
+	return errBadVersion
+}
+
+func (h SolvencyHandler) validateV1(ctx cosmos.Context, msg MsgSolvency, constAccessor constants.ConstantValues) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	if !isSignedByActiveNodeAccounts(ctx, h.keeper, msg.GetSigners()) {
+		return cosmos.ErrUnauthorized(notAuthorized.Error())
+	}
+	if !h.keeper.VaultExists(ctx, msg.PubKey) {
+		return cosmos.ErrUnknownRequest("vault doesn't exist")
+	}
+	// a report can't run too far ahead of the chain height THORChain know of , once it reached consensus the reports at a
+	// lower height are ignored , the last balance that reached consensus move the known height forward on a quiet chain
+	lastHeight, err := h.keeper.GetLastChainHeight(ctx, msg.Chain)
+	if err != nil {
+		return ErrInternal(err, "fail to get last chain height")
+	}
+	solvency, err := h.keeper.GetVaultSolvency(ctx, msg.PubKey, msg.Chain)
+	if err != nil {
+		return ErrInternal(err, "fail to get vault solvency")
+	}
+	if solvency.Height > lastHeight {
+		lastHeight = solvency.Height
+	}
+	drift := constAccessor.GetInt64Value(constants.MaxSolvencyHeightDrift)
+	if lastHeight > 0 && msg.Height > lastHeight+drift {
+		return cosmos.ErrUnknownRequest(fmt.Sprintf("block height %d is too far ahead of the last known height %d", msg.Height, lastHeight))
+	}
+	return nil
+}
+
+// handle process MsgSolvency
+func (h SolvencyHandler) handle(ctx cosmos.Context, msg MsgSolvency, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
+	ctx.Logger().Info("handle MsgSolvency request", "chain", msg.Chain, "pubkey", msg.PubKey, "block height", msg.Height)
+	if version.GTE(semver.MustParse("0.1.0")) {
+		return h.handleV1(ctx, msg, constAccessor)
+	}
+	return nil, errBadVersion
+}
+
+// handleV1 process MsgSolvency , once the nodes reach consensus on the balance of the vault it is saved and compared with
+// what THORChain expect the vault to hold
+func (h SolvencyHandler) handleV1(ctx cosmos.Context, msg MsgSolvency, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
+	active, err := h.keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		err = wrapError(ctx, err, "fail to get list of active node accounts")
+		return nil, err
+	}
+
+	solvency, err := h.keeper.GetVaultSolvency(ctx, msg.PubKey, msg.Chain)
+	if err != nil {
+		return nil, ErrInternal(err, "fail to get vault solvency")
+	}
+	// the balance at this height or a newer one already reached consensus , its voters are pruned
+	if solvency.Height >= msg.Height {
+		return &cosmos.Result{}, nil
+	}
+
+	voter, err := h.keeper.GetSolvencyVoter(ctx, msg.Chain, msg.PubKey, msg.Height)
+	if err != nil {
+		return nil, err
+	}
+	if !voter.Sign(msg.Signer, msg.Coins) {
+		ctx.Logger().Info("signer already signed MsgSolvency", "signer", msg.Signer.String(), "id", voter.ID.String())
+		return &cosmos.Result{}, nil
+	}
+	// doesn't have consensus yet
+	if !voter.HasConsensus(active) {
+		h.keeper.SetSolvencyVoter(ctx, voter)
+		ctx.Logger().Info("not having consensus yet, return")
+		return &cosmos.Result{}, nil
+	}
+	// the voters of this height and the older ones are no longer needed once the balance reached consensus
+	h.keeper.PruneSolvencyVoters(ctx, msg.Chain, msg.PubKey, msg.Height)
+
+	solvency = NewVaultSolvency(msg.PubKey, msg.Chain, voter.GetConsensusCoins(active), msg.Height, common.BlockHeight(ctx))
+	h.keeper.SetVaultSolvency(ctx, solvency)
+	if err := checkVaultSolvency(ctx, h.keeper, h.mgr, solvency, constAccessor); err != nil {
+		return nil, ErrInternal(err, "fail to check vault solvency")
+	}
+	return &cosmos.Result{}, nil
+}
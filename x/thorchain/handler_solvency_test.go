@@ -0,0 +1,229 @@
+package thorchain
+
+import (
+	"errors"
+
+	"github.com/blang/semver"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+type HandlerSolvencySuite struct{}
+
+var _ = Suite(&HandlerSolvencySuite{})
+
+type KeeperSolvencyTest struct {
+	keeper.Keeper
+	errFailListActiveNodeAccount bool
+	errFailGetSolvencyVoter      bool
+}
+
+func (k KeeperSolvencyTest) ListActiveNodeAccounts(ctx cosmos.Context) (NodeAccounts, error) {
+	if k.errFailListActiveNodeAccount {
+		return NodeAccounts{}, kaboom
+	}
+	return k.Keeper.ListActiveNodeAccounts(ctx)
+}
+
+func (k KeeperSolvencyTest) GetSolvencyVoter(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) (SolvencyVoter, error) {
+	if k.errFailGetSolvencyVoter {
+		return SolvencyVoter{}, kaboom
+	}
+	return k.Keeper.GetSolvencyVoter(ctx, chain, pubKey, height)
+}
+
+func (*HandlerSolvencySuite) TestHandlerSolvency(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	handler := NewSolvencyHandler(k, mgr)
+
+	// invalid message should return an error
+	result, err := handler.Run(ctx, NewMsgMimir("foo", 1, GetRandomBech32Addr()), ver, constAccessor)
+	c.Check(result, IsNil)
+	c.Check(errors.Is(err, errInvalidMessage), Equals, true)
+
+	// invalid version should return bad version
+	coins := common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One)),
+	}
+	msg := NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1024, na.NodeAddress)
+	result, err = handler.Run(ctx, msg, semver.MustParse("0.0.1"), constAccessor)
+	c.Check(result, IsNil)
+	c.Check(errors.Is(err, errBadVersion), Equals, true)
+
+	// not signed by an active node
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1024, GetRandomBech32Addr()), ver, constAccessor)
+	c.Check(result, IsNil)
+	c.Check(err, NotNil)
+
+	// vault doesn't exist
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, GetRandomPubKey(), coins, 1024, na.NodeAddress), ver, constAccessor)
+	c.Check(result, IsNil)
+	c.Check(err, NotNil)
+
+	// solvent vault
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	solvency, err := k.GetVaultSolvency(ctx, vault.PubKey, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(solvency.Height, Equals, int64(1024))
+	c.Check(solvency.Coins.Equals(coins), Equals, true)
+	// the voter is pruned once consensus is reached
+	voter, err := k.GetSolvencyVoter(ctx, common.BNBChain, vault.PubKey, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+	c.Check(hasSolvencyEvent(ctx), Equals, false)
+
+	// already signed
+	result, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+
+	// an older report is ignored
+	older := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(10*common.One))}
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, older, 1000, na.NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	solvency, err = k.GetVaultSolvency(ctx, vault.PubKey, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(solvency.Height, Equals, int64(1024))
+	c.Check(hasSolvencyEvent(ctx), Equals, false)
+
+	// vault is short of BNB
+	short := common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(90*common.One)),
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One)),
+	}
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, short, 1030, na.NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	c.Check(hasSolvencyEvent(ctx), Equals, true)
+
+	// too far ahead of the last balance that reached consensus
+	drift := constAccessor.GetInt64Value(constants.MaxSolvencyHeightDrift)
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1030+drift+1, na.NodeAddress), ver, constAccessor)
+	c.Check(result, IsNil)
+	c.Check(err, NotNil)
+	voter, err = k.GetSolvencyVoter(ctx, common.BNBChain, vault.PubKey, 1030+drift+1)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+	// the height observed on chain move the bound forward
+	c.Assert(k.SetLastChainHeight(ctx, common.BNBChain, 1030+drift), IsNil)
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1030+drift+1, na.NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+
+	// fail to list active node accounts
+	handler = NewSolvencyHandler(KeeperSolvencyTest{Keeper: k, errFailListActiveNodeAccount: true}, mgr)
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1040+drift, na.NodeAddress), ver, constAccessor)
+	c.Check(result, IsNil)
+	c.Check(errors.Is(err, errInternal), Equals, true)
+
+	// fail to get solvency voter
+	handler = NewSolvencyHandler(KeeperSolvencyTest{Keeper: k, errFailGetSolvencyVoter: true}, mgr)
+	result, err = handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1040+drift, na.NodeAddress), ver, constAccessor)
+	c.Check(result, IsNil)
+	c.Check(err, NotNil)
+}
+
+func (*HandlerSolvencySuite) TestHandlerSolvencyAggregate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	na1 := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na1), IsNil)
+	na2 := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na2), IsNil)
+	na3 := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na3), IsNil)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+	handler := NewSolvencyHandler(k, mgr)
+
+	// nodes that observed the balance at slightly different times still vote for the same height
+	reports := []cosmos.Uint{
+		cosmos.NewUint(100 * common.One),
+		cosmos.NewUint(101 * common.One),
+	}
+	for i, na := range []NodeAccount{na1, na2} {
+		coins := common.Coins{common.NewCoin(common.BNBAsset, reports[i])}
+		result, err := handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1024, na.NodeAddress), ver, constAccessor)
+		c.Assert(err, IsNil)
+		c.Assert(result, NotNil)
+		solvency, err := k.GetVaultSolvency(ctx, vault.PubKey, common.BNBChain)
+		c.Assert(err, IsNil)
+		c.Check(solvency.IsEmpty(), Equals, i == 0)
+	}
+	solvency, err := k.GetVaultSolvency(ctx, vault.PubKey, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(solvency.Height, Equals, int64(1024))
+	c.Check(solvency.Coins.GetCoin(common.BNBAsset).Amount.Equal(cosmos.NewUint(101*common.One)), Equals, true)
+	c.Check(hasSolvencyEvent(ctx), Equals, false)
+
+	// a late vote for a height that already reached consensus is ignored
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))}
+	result, err := handler.Run(ctx, NewMsgSolvency(common.BNBChain, vault.PubKey, coins, 1024, na3.NodeAddress), ver, constAccessor)
+	c.Assert(err, IsNil)
+	c.Assert(result, NotNil)
+	voter, err := k.GetSolvencyVoter(ctx, common.BNBChain, vault.PubKey, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+	c.Check(hasSolvencyEvent(ctx), Equals, false)
+}
+
+func hasSolvencyEvent(ctx cosmos.Context) bool {
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == "solvency" {
+			return true
+		}
+	}
+	return false
+}
+
+func (*HandlerSolvencySuite) TestSolvencyChecks(c *C) {
+	ethAsset, err := common.NewAsset("BNB.ETH-1C9")
+	c.Assert(err, IsNil)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(100*common.One)),
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(100*common.One)),
+	}
+	solvency := NewVaultSolvency(vault.PubKey, common.BNBChain, common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(99*common.One)),
+		common.NewCoin(ethAsset, cosmos.NewUint(common.One)),
+	}, 1024, 1)
+	checks := getSolvencyChecks(vault, solvency)
+	c.Assert(checks, HasLen, 3)
+	c.Check(checks[0].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(checks[0].isInsolvent(100), Equals, false)
+	c.Check(checks[0].isInsolvent(50), Equals, true)
+	c.Check(checks[1].Asset.Equals(common.RuneAsset()), Equals, true)
+	c.Check(checks[1].Observed.IsZero(), Equals, true)
+	c.Check(checks[1].isInsolvent(100), Equals, true)
+	c.Check(checks[2].Asset.Equals(ethAsset), Equals, true)
+	c.Check(checks[2].Expected.IsZero(), Equals, true)
+	c.Check(checks[2].isInsolvent(0), Equals, false)
+}
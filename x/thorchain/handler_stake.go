@@ -38,6 +38,14 @@ func (h StakeHandler) validateV1(ctx cosmos.Context, msg MsgStake, constAccessor
 		ctx.Logger().Error(err.Error())
 		return errStakeFailValidation
 	}
+	if isTradingHalted(ctx, h.keeper, msg.Asset.Chain) {
+		return errTradingHalted
+	}
+	if msg.HasAffiliateFee() {
+		if err := validateAffiliateFee(ctx, h.keeper, msg.AffiliateBasisPoints, constAccessor); err != nil {
+			return err
+		}
+	}
 
 	ensureStakeNoLargerThanBond := constAccessor.GetBoolValue(constants.StrictBondStakeRatio)
 	// the following  only applicable for chaosnet
@@ -119,15 +127,33 @@ func (h StakeHandler) handleV1(ctx cosmos.Context, msg MsgStake, version semver.
 		ctx.Logger().Error("fail to check pool status", "error", err)
 		return errInvalidPoolStatus
 	}
-	return h.stake(
+	runeAmount := msg.RuneAmount
+	assetAmount := msg.AssetAmount
+	affiliateFees := common.Coins{}
+	if msg.HasAffiliateFee() {
+		affiliateFees = append(affiliateFees, getAffiliateFee(common.NewCoin(common.RuneAsset(), runeAmount), msg.AffiliateBasisPoints))
+		// asset can only be swapped to RUNE when the pool is enabled , otherwise only RUNE is skimmed
+		if pool.Status == PoolEnabled {
+			affiliateFees = append(affiliateFees, getAffiliateFee(common.NewCoin(msg.Asset, assetAmount), msg.AffiliateBasisPoints))
+		}
+		runeAmount = common.SafeSub(runeAmount, affiliateFees.GetCoin(common.RuneAsset()).Amount)
+		assetAmount = common.SafeSub(assetAmount, affiliateFees.GetCoin(msg.Asset).Amount)
+	}
+	if err := h.stake(
 		ctx,
 		msg.Asset,
-		msg.RuneAmount,
-		msg.AssetAmount,
+		runeAmount,
+		assetAmount,
 		msg.RuneAddress,
 		msg.AssetAddress,
 		msg.Tx.ID,
-		constAccessor)
+		constAccessor); err != nil {
+		return err
+	}
+	for _, coin := range affiliateFees {
+		payAffiliateFee(ctx, h.mgr, h.keeper, msg.Tx, coin, msg.AffiliateAddress, msg.AffiliateBasisPoints, constAccessor)
+	}
+	return nil
 }
 
 // validateStakeMessage is to do some validation, and make sure it is legit
@@ -243,6 +269,8 @@ func (h StakeHandler) stake(ctx cosmos.Context,
 	fex := su.Units
 	totalStakerUnits := fex.Add(stakerUnits)
 	su.Units = totalStakerUnits
+	su.RuneDeposit = su.RuneDeposit.Add(stakeRuneAmount)
+	su.AssetDeposit = su.AssetDeposit.Add(stakeAssetAmount)
 	h.keeper.SetStaker(ctx, su)
 	runeTxID := requestTxHash
 	assetTxID := requestTxHash
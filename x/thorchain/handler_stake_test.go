@@ -62,6 +62,8 @@ func (m *MockStakeKeeper) GetStaker(_ cosmos.Context, asset common.Asset, addr c
 		AssetAddress: addr,
 		Units:        cosmos.ZeroUint(),
 		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}, nil
 }
 
@@ -116,6 +118,63 @@ func (HandlerStakeSuite) TestStakeHandler(c *C) {
 	c.Assert(postStakePool.BalanceRune.String(), Equals, preStakePool.BalanceRune.Add(msgSetStake.RuneAmount).String())
 }
 
+func (HandlerStakeSuite) TestStakeHandlerWithAffiliateFee(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	k := &MockStakeKeeper{
+		activeNodeAccount: activeNodeAccount,
+		currentPool: Pool{
+			BalanceRune:  cosmos.NewUint(100 * common.One),
+			BalanceAsset: cosmos.NewUint(100 * common.One),
+			Asset:        common.BNBAsset,
+			PoolUnits:    cosmos.NewUint(100 * common.One),
+			Status:       PoolEnabled,
+		},
+	}
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	stakeHandler := NewStakeHandler(k, mgr)
+	bnbAddr := GetRandomBNBAddress()
+	affiliateAddr := GetRandomRUNEAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		bnbAddr,
+		GetRandomBNBAddress(),
+		common.Coins{
+			common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One*10)),
+			common.NewCoin(common.RuneAsset(), cosmos.NewUint(common.One*10)),
+		},
+		BNBGasFeeSingleton,
+		"stake:BNB.BNB::"+affiliateAddr.String()+":100",
+	)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	msg := NewMsgStake(tx, common.BNBAsset, cosmos.NewUint(10*common.One), cosmos.NewUint(10*common.One), bnbAddr, bnbAddr, activeNodeAccount.NodeAddress)
+	msg.AffiliateAddress = affiliateAddr
+	msg.AffiliateBasisPoints = cosmos.NewUint(100)
+	_, err := stakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+
+	// 1% of both RUNE and BNB.BNB go to the affiliate, BNB.BNB get swapped to RUNE
+	pool, err := k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceAsset.Equal(cosmos.NewUint(110*common.One)), Equals, true, Commentf("%s", pool.BalanceAsset))
+	c.Check(pool.BalanceRune.LT(cosmos.NewUint(1099*common.One/10)), Equals, true, Commentf("%s", pool.BalanceRune))
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(affiliateAddr), Equals, true)
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.RuneAsset(), cosmos.NewUint(common.One/10))), Equals, true)
+	c.Check(items[1].ToAddress.Equals(affiliateAddr), Equals, true)
+	c.Check(items[1].Coin.Asset.Equals(common.RuneAsset()), Equals, true)
+
+	// affiliate fee over the limit
+	msg.AffiliateBasisPoints = cosmos.NewUint(uint64(constAccessor.GetInt64Value(constants.MaxAffiliateFeeBasisPoints) + 1))
+	_, err = stakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(errors.Is(err, errInvalidAffiliateFee), Equals, true)
+}
+
 func (HandlerStakeSuite) TestStakeHandler_NoPool_ShouldCreateNewPool(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 	activeNodeAccount := GetRandomNodeAccount(NodeActive)
@@ -333,10 +392,12 @@ func (p *StakeTestKeeper) GetStaker(ctx cosmos.Context, asset common.Asset, addr
 		return Staker{}, errors.New("simulate error for test")
 	}
 	staker := Staker{
-		Asset:       asset,
-		RuneAddress: addr,
-		Units:       cosmos.ZeroUint(),
-		PendingRune: cosmos.ZeroUint(),
+		Asset:        asset,
+		RuneAddress:  addr,
+		Units:        cosmos.ZeroUint(),
+		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}
 	key := p.GetKey(ctx, "staker/", staker.Key())
 	if res, ok := p.store[key]; ok {
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/blang/semver"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
@@ -31,7 +32,7 @@ func (h SwapHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Versio
 	if !ok {
 		return nil, errInvalidMessage
 	}
-	if err := h.validate(ctx, msg, version); err != nil {
+	if err := h.validate(ctx, msg, version, constAccessor); err != nil {
 		ctx.Logger().Error("MsgSwap failed validation", "error", err)
 		return nil, err
 	}
@@ -43,15 +44,40 @@ func (h SwapHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Versio
 	return result, err
 }
 
-func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap, version semver.Version) error {
+func (h SwapHandler) validate(ctx cosmos.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) error {
 	if version.GTE(semver.MustParse("0.1.0")) {
-		return h.validateV1(ctx, msg)
+		return h.validateV1(ctx, msg, constAccessor)
 	}
 	return errInvalidVersion
 }
 
-func (h SwapHandler) validateV1(ctx cosmos.Context, msg MsgSwap) error {
-	return msg.ValidateBasic()
+func (h SwapHandler) validateV1(ctx cosmos.Context, msg MsgSwap, constAccessor constants.ConstantValues) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	return validateStreamingSwap(ctx, h.keeper, msg, constAccessor)
+}
+
+// validateStreamingSwap make sure a streaming swap is not split into more sub-swaps , nor spread over longer intervals than mimir allow
+func validateStreamingSwap(ctx cosmos.Context, keeper keeper.Keeper, msg MsgSwap, constAccessor constants.ConstantValues) error {
+	if !msg.IsStreaming() {
+		return nil
+	}
+	maxQuantity, err := keeper.GetMimir(ctx, constants.MaxStreamingSwapQuantity.String())
+	if maxQuantity <= 0 || err != nil {
+		maxQuantity = constAccessor.GetInt64Value(constants.MaxStreamingSwapQuantity)
+	}
+	if msg.StreamQuantity > maxQuantity {
+		return se.Wrapf(errSwapFailInvalidStream, "stream quantity %d more than %d", msg.StreamQuantity, maxQuantity)
+	}
+	maxInterval, err := keeper.GetMimir(ctx, constants.MaxStreamingSwapInterval.String())
+	if maxInterval < 0 || err != nil {
+		maxInterval = constAccessor.GetInt64Value(constants.MaxStreamingSwapInterval)
+	}
+	if msg.StreamInterval > maxInterval {
+		return se.Wrapf(errSwapFailInvalidStream, "stream interval %d more than %d", msg.StreamInterval, maxInterval)
+	}
+	return nil
 }
 
 func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
@@ -64,13 +90,25 @@ func (h SwapHandler) handle(ctx cosmos.Context, msg MsgSwap, version semver.Vers
 
 func (h SwapHandler) handleV1(ctx cosmos.Context, msg MsgSwap, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
 	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
+	tx := msg.Tx
+	var affiliateFee common.Coin
+	if msg.HasAffiliateFee() {
+		if err := validateAffiliateFee(ctx, h.keeper, msg.AffiliateBasisPoints, constAccessor); err != nil {
+			return nil, err
+		}
+		// affiliate fee is skimmed off the inbound amount before the swap
+		affiliateFee = getAffiliateFee(msg.Tx.Coins[0], msg.AffiliateBasisPoints)
+		tx.Coins = common.Coins{common.NewCoin(affiliateFee.Asset, common.SafeSub(msg.Tx.Coins[0].Amount, affiliateFee.Amount))}
+	}
 	amount, events, swapErr := swap(
 		ctx,
 		h.keeper,
-		msg.Tx,
+		tx,
 		msg.TargetAsset,
 		msg.Destination,
 		msg.TradeTarget,
+		msg.MaxSlip,
+		msg.MaxLiquidityFee,
 		cosmos.NewUint(uint64(transactionFee)))
 	if swapErr != nil {
 		return nil, swapErr
@@ -101,6 +139,9 @@ func (h SwapHandler) handleV1(ctx cosmos.Context, msg MsgSwap, version semver.Ve
 	if !ok {
 		return nil, errFailAddOutboundTx
 	}
+	if msg.HasAffiliateFee() {
+		payAffiliateFee(ctx, h.mgr, h.keeper, msg.Tx, affiliateFee, msg.AffiliateAddress, msg.AffiliateBasisPoints, constAccessor)
+	}
 
 	return &cosmos.Result{}, nil
 }
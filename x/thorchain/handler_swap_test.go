@@ -42,16 +42,16 @@ func (s *HandlerSwapSuite) TestValidate(c *C) {
 		"",
 	)
 	msg := NewMsgSwap(tx, common.BNBAsset, signerBNBAddr, cosmos.ZeroUint(), observerAddr)
-	err := handler.validate(ctx, msg, ver)
+	err := handler.validate(ctx, msg, ver, constants.GetConstantValues(ver))
 	c.Assert(err, IsNil)
 
 	// invalid version
-	err = handler.validate(ctx, msg, semver.Version{})
+	err = handler.validate(ctx, msg, semver.Version{}, constants.GetConstantValues(ver))
 	c.Assert(err, Equals, errInvalidVersion)
 
 	// invalid msg
 	msg = MsgSwap{}
-	err = handler.validate(ctx, msg, ver)
+	err = handler.validate(ctx, msg, ver, constants.GetConstantValues(ver))
 	c.Assert(err, NotNil)
 }
 
@@ -271,3 +271,71 @@ func (s *HandlerSwapSuite) TestDoubleSwap(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(items, HasLen, 0)
 }
+
+func (s *HandlerSwapSuite) TestSwapWithAffiliateFee(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	keeper := &TestSwapHandleKeeper{
+		pools:             make(map[common.Asset]Pool),
+		activeNodeAccount: GetRandomNodeAccount(NodeActive),
+	}
+	ver := constants.SWVersion
+	mgr := NewManagers(keeper)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	handler := NewSwapHandler(keeper, mgr)
+	constAccessor := constants.GetConstantValues(ver)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	c.Assert(keeper.SetPool(ctx, pool), IsNil)
+
+	signerBNBAddr := GetRandomBNBAddress()
+	observerAddr := keeper.activeNodeAccount.NodeAddress
+	affiliateAddr := GetRandomRUNEAddress()
+	tx := common.NewTx(
+		GetRandomTxHash(),
+		signerBNBAddr,
+		signerBNBAddr,
+		common.Coins{
+			common.NewCoin(common.BNBAsset, cosmos.NewUint(10*common.One)),
+		},
+		BNBGasFeeSingleton,
+		"",
+	)
+	msg := NewMsgSwap(tx, common.RuneAsset(), signerBNBAddr, cosmos.ZeroUint(), observerAddr)
+	msg.AffiliateAddress = affiliateAddr
+	msg.AffiliateBasisPoints = cosmos.NewUint(100)
+	_, err := handler.handle(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+
+	// BNB.BNB 9.9 is swapped for the user , and BNB.BNB 0.1 is swapped to RUNE for the affiliate
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 2)
+	c.Check(items[0].ToAddress.Equals(signerBNBAddr), Equals, true)
+	c.Check(items[1].ToAddress.Equals(affiliateAddr), Equals, true)
+	c.Check(items[1].Coin.Asset.Equals(common.RuneAsset()), Equals, true)
+	c.Check(items[1].Coin.Amount.IsZero(), Equals, false)
+	pool, err = keeper.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceAsset.Equal(cosmos.NewUint(1010*common.One)), Equals, true)
+	found := false
+	for _, evt := range ctx.EventManager().Events() {
+		if evt.Type == "affiliate_fee" {
+			found = true
+		}
+	}
+	c.Check(found, Equals, true)
+
+	// affiliate fee over the limit
+	mgr.TxOutStore().ClearOutboundItems(ctx)
+	msg.Tx.ID = GetRandomTxHash()
+	msg.AffiliateBasisPoints = cosmos.NewUint(uint64(constAccessor.GetInt64Value(constants.MaxAffiliateFeeBasisPoints) + 1))
+	_, err = handler.handle(ctx, msg, ver, constAccessor)
+	c.Assert(errors.Is(err, errInvalidAffiliateFee), Equals, true)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+}
@@ -203,6 +203,8 @@ func (HandlerSuite) TestHandleTxInUnstakeMemo(c *C) {
 		AssetAddress: GetRandomBNBAddress(),
 		PendingRune:  cosmos.ZeroUint(),
 		Units:        cosmos.NewUint(100),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}
 	w.keeper.SetStaker(w.ctx, staker)
 
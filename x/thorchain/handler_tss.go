@@ -101,7 +101,7 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 	}
 	observeSlashPoints := constAccessor.GetInt64Value(constants.ObserveSlashPoints)
 	observeFlex := constAccessor.GetInt64Value(constants.ObserveFlex)
-	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, msg.Signer)
+	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, common.BlankTxID, msg.Signer)
 	if !voter.Sign(msg.Signer, msg.Chains) {
 		ctx.Logger().Info("signer already signed MsgTssPool", "signer", msg.Signer.String(), "txid", msg.ID)
 		return &cosmos.Result{}, nil
@@ -117,7 +117,7 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 	if voter.BlockHeight == 0 {
 		voter.BlockHeight = common.BlockHeight(ctx)
 		h.keeper.SetTssVoter(ctx, voter)
-		h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, voter.Signers...)
+		h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, common.BlankTxID, voter.Signers...)
 		if msg.IsSuccess() {
 			vaultType := YggdrasilVault
 			if msg.KeygenType == AsgardKeygen {
@@ -126,7 +126,13 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 			vault := NewVault(common.BlockHeight(ctx), ActiveVault, vaultType, voter.PoolPubKey, voter.ConsensusChains())
 			vault.Membership = voter.PubKeys
 
-			signingParty, err := ChooseSignerParty(voter.PubKeys, common.BlockHeight(ctx), len(voter.PubKeys))
+			threshold, err := GetThreshold(len(voter.PubKeys))
+			if err != nil {
+				return nil, fmt.Errorf("fail to get threshold: %w", err)
+			}
+			// node accounts in maintenance might not be able to sign , leave them out of the signing party
+			candidates := getSigningCandidates(ctx, h.keeper, voter.PubKeys, threshold)
+			signingParty, err := ChooseSignerParty(candidates, common.BlockHeight(ctx), len(voter.PubKeys))
 			if err != nil {
 				return nil, fmt.Errorf("fail to choose signing party: %w", err)
 			}
@@ -151,8 +157,10 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 				if err != nil {
 					return nil, fmt.Errorf("fail to get node from it's pub key: %w", err)
 				}
+				slashBond := cosmos.ZeroUint()
 				if na.Status == NodeActive {
-					if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
+					record := NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), slashPoints, SlashReasonFailKeygen, common.BlankTxID, msg.PoolPubKey)
+					if err := addSlashPoints(ctx, h.keeper, record); err != nil {
 						ctx.Logger().Error("fail to inc slash points", "error", err)
 					}
 				} else {
@@ -171,7 +179,7 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 						return nil, fmt.Errorf("fail to get reserve vault: %w", err)
 					}
 
-					slashBond := reserveVault.CalcNodeRewards(cosmos.NewUint(uint64(slashPoints)))
+					slashBond = reserveVault.CalcNodeRewards(cosmos.NewUint(uint64(slashPoints)))
 					if slashBond.GT(na.Bond) {
 						slashBond = na.Bond
 					}
@@ -192,6 +200,7 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 				if err := h.keeper.SetNodeAccount(ctx, na); err != nil {
 					return nil, fmt.Errorf("fail to save node account: %w", err)
 				}
+				addBondSlashRecord(ctx, h.keeper, na.NodeAddress, slashBond, SlashReasonFailKeygen, msg.PoolPubKey, common.EmptyChain)
 			}
 
 		}
@@ -199,7 +208,7 @@ func (h TssHandler) handleV1(ctx cosmos.Context, msg MsgTssPool, version semver.
 	}
 
 	if (voter.BlockHeight + observeFlex) >= common.BlockHeight(ctx) {
-		h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, msg.Signer)
+		h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, common.BlankTxID, msg.Signer)
 	}
 
 	return &cosmos.Result{}, nil
@@ -92,7 +92,7 @@ func (h TssKeysignHandler) handleV1(ctx cosmos.Context, msg MsgTssKeysignFail, v
 		return nil, err
 	}
 	observeSlashPoints := constAccessor.GetInt64Value(constants.ObserveSlashPoints)
-	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, msg.Signer)
+	h.mgr.Slasher().IncSlashPoints(ctx, observeSlashPoints, common.BlankTxID, msg.Signer)
 	if !voter.Sign(msg.Signer) {
 		ctx.Logger().Info("signer already signed MsgTssKeysignFail", "signer", msg.Signer.String(), "txid", msg.ID)
 		return &cosmos.Result{}, nil
@@ -105,7 +105,7 @@ func (h TssKeysignHandler) handleV1(ctx cosmos.Context, msg MsgTssKeysignFail, v
 	}
 	ctx.Logger().Info("has tss keysign consensus!!")
 
-	h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, voter.Signers...)
+	h.mgr.Slasher().DecSlashPoints(ctx, observeSlashPoints, common.BlankTxID, voter.Signers...)
 	voter.Signers = nil
 	h.keeper.SetTssKeysignFailVoter(ctx, voter)
 
@@ -121,7 +121,8 @@ func (h TssKeysignHandler) handleV1(ctx cosmos.Context, msg MsgTssKeysignFail, v
 		if err != nil {
 			return nil, ErrInternal(err, fmt.Sprintf("fail to get node account,pub key: %s", nodePubKey.String()))
 		}
-		if err := h.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, slashPoints); err != nil {
+		record := NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), slashPoints, SlashReasonFailKeysign, common.BlankTxID, msg.PubKey)
+		if err := addSlashPoints(ctx, h.keeper, record); err != nil {
 			ctx.Logger().Error("fail to inc slash points", "error", err)
 		}
 
@@ -163,7 +164,7 @@ func (h TssKeysignHandler) updateVaultKeySign(ctx cosmos.Context, vaultPubKey co
 		}
 	}
 
-	// build signer list, exclude any node accounts in jail
+	// build signer list, exclude any node accounts in jail or in maintenance
 	signers := make(common.PubKeys, 0)
 	signers = h.getSignerCandidates(ctx, signers, members, NodeActive)
 
@@ -217,6 +218,9 @@ func (h TssKeysignHandler) getSignerCandidates(ctx cosmos.Context, signers, cand
 		if jail.IsJailed(ctx) {
 			continue
 		}
+		if isNodeInMaintenance(ctx, h.keeper, na.NodeAddress) {
+			continue
+		}
 		signers = append(signers, mem)
 	}
 	return signers
@@ -401,7 +401,7 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 					}
 					voter.Signers = append(voter.Signers, addr)
 				}
-				helper.mgr.Slasher().IncSlashPoints(helper.ctx, observeSlashPoints, voter.Signers...)
+				helper.mgr.Slasher().IncSlashPoints(helper.ctx, observeSlashPoints, common.BlankTxID, voter.Signers...)
 				helper.keeper.SetTssVoter(helper.ctx, voter)
 				return tssMsg
 			},
@@ -460,6 +460,13 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 		{
 			name: "fail to keygen retry and none active account should be slashed with bond",
 			messageCreator: func(helper tssHandlerTestHelper) cosmos.Msg {
+				na := GetRandomNodeAccount(NodeStandby)
+				na.PubKeySet.Secp256k1 = helper.members[3]
+				addr, err := helper.members[3].GetThorAddress()
+				c.Assert(err, IsNil)
+				na.NodeAddress = addr
+				na.Bond = cosmos.NewUint(100 * common.One)
+				c.Assert(helper.keeper.SetNodeAccount(helper.ctx, na), IsNil)
 				b := blame.Blame{
 					FailReason: "who knows",
 					BlameNodes: []blame.Node{
@@ -497,6 +504,12 @@ func (s *HandlerTssSuite) TestTssHandler(c *C) {
 				jail, err := helper.keeper.GetNodeAccountJail(helper.ctx, na.NodeAddress)
 				c.Assert(err, IsNil)
 				c.Check(jail.ReleaseHeight > 0, Equals, true)
+				// the bond taken is in the slash ledger
+				records, err := helper.keeper.GetSlashRecords(helper.ctx, na.NodeAddress, 0)
+				c.Assert(err, IsNil)
+				c.Assert(records, HasLen, 1)
+				c.Check(records[0].Reason, Equals, SlashReasonFailKeygen)
+				c.Check(records[0].Bond.IsZero(), Equals, false)
 			},
 			expectedResult: nil,
 		},
@@ -43,9 +43,22 @@ func (h UnBondHandler) validateV1(ctx cosmos.Context, version semver.Version, ms
 		return ErrInternal(err, fmt.Sprintf("fail to get node account(%s)", msg.NodeAddress))
 	}
 
-	if !na.BondAddress.Equals(msg.TxIn.FromAddress) {
+	bp, err := getBondProviders(ctx, h.keeper, na)
+	if err != nil {
+		return ErrInternal(err, fmt.Sprintf("fail to get bond providers of node account(%s)", msg.NodeAddress))
+	}
+	isOperator := na.BondAddress.Equals(msg.TxIn.FromAddress)
+	if !isOperator && !bp.Has(msg.TxIn.FromAddress) {
 		return cosmos.ErrUnauthorized(fmt.Sprintf("%s are not authorized to manage %s", msg.TxIn.FromAddress, msg.NodeAddress))
 	}
+	if !msg.BondProviderAddress.IsEmpty() {
+		if !isOperator {
+			return cosmos.ErrUnauthorized("only node operator can unbond on behalf of bond provider")
+		}
+		if !bp.Has(msg.BondProviderAddress) {
+			return cosmos.ErrUnknownRequest(fmt.Sprintf("%s is not a bond provider of %s", msg.BondProviderAddress, msg.NodeAddress))
+		}
+	}
 	if na.Status == NodeActive {
 		return cosmos.ErrUnknownRequest("cannot unbond while node is in active status")
 	}
@@ -110,15 +123,35 @@ func (h UnBondHandler) handle(ctx cosmos.Context, msg MsgUnBond, version semver.
 
 	coin := msg.TxIn.Coins.GetCoin(common.RuneAsset())
 	if !coin.IsEmpty() {
-		na.Bond = na.Bond.Add(coin.Amount)
+		if err := addProviderBond(ctx, h.keeper, &na, msg.TxIn.FromAddress, coin.Amount); err != nil {
+			return ErrInternal(err, "fail to add bond")
+		}
 		if err := h.keeper.SetNodeAccount(ctx, na); err != nil {
 			return ErrInternal(err, "fail to save node account to key value store")
 		}
 	}
 
-	if err := refundBond(ctx, msg.TxIn, msg.Amount, &na, h.keeper, h.mgr); err != nil {
+	provider := msg.TxIn.FromAddress
+	if !msg.BondProviderAddress.IsEmpty() {
+		provider = msg.BondProviderAddress
+	}
+	if err := refundBond(ctx, msg.TxIn, provider, msg.Amount, &na, h.keeper, h.mgr); err != nil {
 		return ErrInternal(err, "fail to unbond")
 	}
 
+	// once the node operator unbond all of a bond provider , the bond provider is removed from the whitelist
+	if !msg.BondProviderAddress.IsEmpty() && !provider.Equals(na.BondAddress) {
+		bp, err := getBondProviders(ctx, h.keeper, na)
+		if err != nil {
+			return ErrInternal(err, "fail to get bond providers")
+		}
+		if bp.Get(provider).Units.IsZero() {
+			bp.Remove(provider)
+			if err := h.keeper.SetBondProviders(ctx, bp); err != nil {
+				return ErrInternal(err, "fail to save bond providers")
+			}
+		}
+	}
+
 	return nil
 }
@@ -66,6 +66,10 @@ func (k *TestUnBondKeeper) GetNodeAccountJail(ctx cosmos.Context, addr cosmos.Ac
 	return Jail{}, nil
 }
 
+func (k *TestUnBondKeeper) GetBondProviders(_ cosmos.Context, addr cosmos.AccAddress) (BondProviders, error) {
+	return NewBondProviders(addr), nil
+}
+
 var _ = Suite(&HandlerUnBondSuite{})
 
 func (HandlerUnBondSuite) TestUnBondHandler_Run(c *C) {
@@ -231,3 +235,76 @@ func (HandlerUnBondSuite) TestUnBondHandlerFailValidation(c *C) {
 		c.Check(errors.Is(err, item.expectedErr), Equals, true, Commentf("name: %s, %s", item.name, err))
 	}
 }
+
+func (HandlerUnBondSuite) TestUnBondHandlerBondProviders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	activeNodeAccount := GetRandomNodeAccount(NodeActive)
+	standbyNodeAccount := GetRandomNodeAccount(NodeStandby)
+	c.Assert(k.SetNodeAccount(ctx, activeNodeAccount), IsNil)
+	vault := NewVault(12, ActiveVault, AsgardVault, GetRandomPubKey(), nil)
+	vault.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+
+	operator := standbyNodeAccount.BondAddress
+	provider1 := GetRandomBNBAddress()
+	provider2 := GetRandomBNBAddress()
+	bp, err := getBondProviders(ctx, k, standbyNodeAccount)
+	c.Assert(err, IsNil)
+	bp.Bond(provider1, cosmos.NewUint(100*common.One), standbyNodeAccount.Bond)
+	bp.Bond(provider2, cosmos.NewUint(100*common.One), standbyNodeAccount.Bond.Add(cosmos.NewUint(100*common.One)))
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	standbyNodeAccount.Bond = standbyNodeAccount.Bond.Add(cosmos.NewUint(200 * common.One))
+	c.Assert(k.SetNodeAccount(ctx, standbyNodeAccount), IsNil)
+
+	handler := NewUnBondHandler(k, NewDummyMgr())
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	unbondTx := func(from common.Address) common.Tx {
+		return common.NewTx(
+			GetRandomTxHash(),
+			from,
+			GetRandomBNBAddress(),
+			common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(1))},
+			BNBGasFeeSingleton,
+			"unbond",
+		)
+	}
+
+	// bond provider can only unbond its own share
+	msg := NewMsgUnBond(unbondTx(provider1), standbyNodeAccount.NodeAddress, cosmos.NewUint(1000*common.One), provider1, activeNodeAccount.NodeAddress)
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	na, err := k.GetNodeAccount(ctx, standbyNodeAccount.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(cosmos.NewUint(200*common.One)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+	bp, err = getBondProviders(ctx, k, na)
+	c.Assert(err, IsNil)
+	c.Check(bp.Has(provider1), Equals, true)
+	c.Check(bp.GetBond(operator, na.Bond).Equal(cosmos.NewUint(100*common.One)), Equals, true)
+
+	// bond provider can't unbond on behalf of others
+	msg = NewMsgUnBond(unbondTx(provider1), standbyNodeAccount.NodeAddress, cosmos.NewUint(1000*common.One), provider1, activeNodeAccount.NodeAddress)
+	msg.BondProviderAddress = provider2
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, NotNil)
+
+	// operator unbond a bond provider , which remove it from the whitelist
+	msg = NewMsgUnBond(unbondTx(operator), standbyNodeAccount.NodeAddress, cosmos.NewUint(1000*common.One), operator, activeNodeAccount.NodeAddress)
+	msg.BondProviderAddress = provider2
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	na, err = k.GetNodeAccount(ctx, standbyNodeAccount.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(cosmos.NewUint(100*common.One+1)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+	bp, err = getBondProviders(ctx, k, na)
+	c.Assert(err, IsNil)
+	c.Check(bp.Has(provider2), Equals, false)
+
+	// address not whitelisted can't unbond
+	stranger := GetRandomBNBAddress()
+	msg = NewMsgUnBond(unbondTx(stranger), standbyNodeAccount.NodeAddress, cosmos.NewUint(1000*common.One), stranger, activeNodeAccount.NodeAddress)
+	_, err = handler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, NotNil)
+}
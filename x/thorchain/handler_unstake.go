@@ -28,7 +28,7 @@ func NewUnstakeHandler(keeper keeper.Keeper, mgr Manager) UnstakeHandler {
 }
 
 // Run is the main entry point of unstake
-func (h UnstakeHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Version, _ constants.ConstantValues) (*cosmos.Result, error) {
+func (h UnstakeHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
 	msg, ok := m.(MsgUnStake)
 	if !ok {
 		return nil, errInvalidMessage
@@ -39,7 +39,7 @@ func (h UnstakeHandler) Run(ctx cosmos.Context, m cosmos.Msg, version semver.Ver
 		ctx.Logger().Error("MsgUnStake failed validation", "error", err)
 		return nil, err
 	}
-	result, err := h.handle(ctx, msg, version)
+	result, err := h.handle(ctx, msg, version, constAccessor)
 	if err != nil {
 		ctx.Logger().Error("failed to process MsgUnStake", "error", err)
 	}
@@ -64,17 +64,24 @@ func (h UnstakeHandler) validateV1(ctx cosmos.Context, msg MsgUnStake) error {
 	}
 
 	if err := pool.EnsureValidPoolStatus(msg); err != nil {
-		return multierror.Append(errInvalidPoolStatus, err)
+		// stakers of a suspended pool are unstaked by the protocol once its delist started
+		delisting := pool.Status == PoolSuspended && msg.Tx.ID.Equals(common.BlankTxID) && isPoolDelisting(ctx, h.keeper, msg.Asset)
+		if !delisting {
+			return multierror.Append(errInvalidPoolStatus, err)
+		}
 	}
 
 	return nil
 }
 
-func (h UnstakeHandler) handle(ctx cosmos.Context, msg MsgUnStake, version semver.Version) (*cosmos.Result, error) {
+func (h UnstakeHandler) handle(ctx cosmos.Context, msg MsgUnStake, version semver.Version, constAccessor constants.ConstantValues) (*cosmos.Result, error) {
 	staker, err := h.keeper.GetStaker(ctx, msg.Asset, msg.RuneAddress)
 	if err != nil {
 		return nil, multierror.Append(errFailGetStaker, err)
 	}
+	if msg.TargetAsset.Equals(msg.Asset) && staker.AssetAddress.IsEmpty() {
+		return nil, fmt.Errorf("staker doesn't have %s address to withdraw to", msg.Asset.Chain)
+	}
 	runeAmt, assetAmount, units, gasAsset, err := unstake(ctx, version, h.keeper, msg, h.mgr.EventMgr())
 	if err != nil {
 		return nil, ErrInternal(err, "fail to process UnStake request")
@@ -86,6 +93,27 @@ func (h UnstakeHandler) handle(ctx cosmos.Context, msg MsgUnStake, version semve
 		cosmos.ZeroDec(),
 		msg.Tx,
 	)
+
+	// withdraw to a single asset , swap the other side through the same pool
+	targetAsset := msg.TargetAsset
+	if !targetAsset.IsEmpty() {
+		transactionFee := cosmos.NewUint(uint64(constAccessor.GetInt64Value(constants.TransactionFee)))
+		newRuneAmt, newAssetAmount, swapEvt, err := swapUnstakeToTarget(ctx, h.keeper, msg, runeAmt, assetAmount, transactionFee)
+		if err != nil {
+			// the swap can't happen , withdraw both RUNE and asset instead
+			ctx.Logger().Error("fail to swap unstake to target asset", "target", targetAsset, "error", err)
+			targetAsset = common.EmptyAsset
+		} else {
+			runeAmt, assetAmount = newRuneAmt, newAssetAmount
+			unstakeEvt.Slip = swapEvt.TradeSlip
+			if err := h.mgr.EventMgr().EmitSwapEvent(ctx, swapEvt); err != nil {
+				ctx.Logger().Error("fail to emit swap event", "error", err)
+			}
+			if err := h.keeper.AddToLiquidityFees(ctx, swapEvt.Pool, swapEvt.LiquidityFeeInRune); err != nil {
+				ctx.Logger().Error("fail to add liquidity fees", "error", err)
+			}
+		}
+	}
 	if err := h.mgr.EventMgr().EmitEvent(ctx, unstakeEvt); err != nil {
 		return nil, multierror.Append(errFailSaveEvent, err)
 	}
@@ -95,65 +123,70 @@ func (h UnstakeHandler) handle(ctx cosmos.Context, msg MsgUnStake, version semve
 		// tx id is blank, must be triggered by the ragnarok protocol
 		memo = NewRagnarokMemo(common.BlockHeight(ctx)).String()
 	}
-	toi := &TxOutItem{
-		Chain:     msg.Asset.Chain,
-		InHash:    msg.Tx.ID,
-		ToAddress: staker.AssetAddress,
-		Coin:      common.NewCoin(msg.Asset, assetAmount),
-		Memo:      memo,
-	}
-	if !gasAsset.IsZero() {
-		// TODO: chain specific logic should be in a single location
-		if msg.Asset.IsBNB() {
-			toi.MaxGas = common.Gas{
-				common.NewCoin(common.RuneAsset().Chain.GetGasAsset(), gasAsset.QuoUint64(2)),
-			}
-		} else if msg.Asset.Chain.GetGasAsset().Equals(msg.Asset) {
-			toi.MaxGas = common.Gas{
-				common.NewCoin(msg.Asset.Chain.GetGasAsset(), gasAsset),
-			}
-		}
-	}
-
-	okAsset, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi)
-	if err != nil {
-		if !errors.Is(err, ErrNotEnoughToPayFee) {
-			// the emit asset not enough to pay fee,continue
-			return nil, multierror.Append(errFailAddOutboundTx, err)
+	// when withdraw to a single asset , only the target asset is sent out
+	if !targetAsset.IsRune() {
+		toi := &TxOutItem{
+			Chain:     msg.Asset.Chain,
+			InHash:    msg.Tx.ID,
+			ToAddress: staker.AssetAddress,
+			Coin:      common.NewCoin(msg.Asset, assetAmount),
+			Memo:      memo,
 		}
-		okAsset = true
-	}
-	if !okAsset {
-		return nil, errFailAddOutboundTx
-	}
-
-	toi = &TxOutItem{
-		Chain:     common.RuneAsset().Chain,
-		InHash:    msg.Tx.ID,
-		ToAddress: staker.RuneAddress,
-		Coin:      common.NewCoin(common.RuneAsset(), runeAmt),
-		Memo:      memo,
-	}
-	if !common.RuneAsset().Chain.Equals(common.THORChain) {
 		if !gasAsset.IsZero() {
+			// TODO: chain specific logic should be in a single location
 			if msg.Asset.IsBNB() {
 				toi.MaxGas = common.Gas{
 					common.NewCoin(common.RuneAsset().Chain.GetGasAsset(), gasAsset.QuoUint64(2)),
 				}
+			} else if msg.Asset.Chain.GetGasAsset().Equals(msg.Asset) {
+				toi.MaxGas = common.Gas{
+					common.NewCoin(msg.Asset.Chain.GetGasAsset(), gasAsset),
+				}
 			}
 		}
-	}
-	okRune, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi)
-	if err != nil {
-		// emitted asset doesn't enough to cover fee, continue
-		if !errors.Is(err, ErrNotEnoughToPayFee) {
-			return nil, multierror.Append(errFailAddOutboundTx, err)
+
+		okAsset, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi)
+		if err != nil {
+			if !errors.Is(err, ErrNotEnoughToPayFee) {
+				// the emit asset not enough to pay fee,continue
+				return nil, multierror.Append(errFailAddOutboundTx, err)
+			}
+			okAsset = true
+		}
+		if !okAsset {
+			return nil, errFailAddOutboundTx
 		}
-		okRune = true
 	}
 
-	if !okRune {
-		return nil, errFailAddOutboundTx
+	if !targetAsset.Equals(msg.Asset) {
+		toi := &TxOutItem{
+			Chain:     common.RuneAsset().Chain,
+			InHash:    msg.Tx.ID,
+			ToAddress: staker.RuneAddress,
+			Coin:      common.NewCoin(common.RuneAsset(), runeAmt),
+			Memo:      memo,
+		}
+		if !common.RuneAsset().Chain.Equals(common.THORChain) {
+			if !gasAsset.IsZero() {
+				if msg.Asset.IsBNB() {
+					toi.MaxGas = common.Gas{
+						common.NewCoin(common.RuneAsset().Chain.GetGasAsset(), gasAsset.QuoUint64(2)),
+					}
+				}
+			}
+		}
+		okRune, err := h.mgr.TxOutStore().TryAddTxOutItem(ctx, h.mgr, toi)
+		if err != nil {
+			// emitted asset doesn't enough to cover fee, continue
+			if !errors.Is(err, ErrNotEnoughToPayFee) {
+				return nil, multierror.Append(errFailAddOutboundTx, err)
+			}
+			okRune = true
+		}
+
+		if !okRune {
+			return nil, errFailAddOutboundTx
+		}
 	}
 
 	// Get rune (if any) and donate it to the reserve
@@ -95,8 +95,10 @@ func (HandlerUnstakeSuite) TestUnstakeHandler(c *C) {
 			Status:       PoolEnabled,
 		},
 		staker: Staker{
-			Units:       cosmos.ZeroUint(),
-			PendingRune: cosmos.ZeroUint(),
+			Units:        cosmos.ZeroUint(),
+			PendingRune:  cosmos.ZeroUint(),
+			RuneDeposit:  cosmos.ZeroUint(),
+			AssetDeposit: cosmos.ZeroUint(),
 		},
 	}
 	ver := constants.SWVersion
@@ -125,6 +127,83 @@ func (HandlerUnstakeSuite) TestUnstakeHandler(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (HandlerUnstakeSuite) TestUnstakeHandlerToSingleAsset(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	runeAddr := GetRandomRUNEAddress()
+	stakeHandler := NewStakeHandler(k, mgr)
+	c.Assert(stakeHandler.stake(ctx,
+		common.BNBAsset,
+		cosmos.NewUint(common.One*100),
+		cosmos.NewUint(common.One*100),
+		runeAddr,
+		GetRandomBNBAddress(),
+		GetRandomTxHash(),
+		constAccessor), IsNil)
+	staker, err := k.GetStaker(ctx, common.BNBAsset, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(staker.RuneDeposit.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+	c.Check(staker.AssetDeposit.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + constAccessor.GetInt64Value(constants.StakeLockUpBlocks))
+
+	// withdraw half to RUNE , the BNB side is swapped through the pool
+	unstakeHandler := NewUnstakeHandler(k, mgr)
+	msg := NewMsgUnStake(GetRandomTx(), runeAddr, cosmos.NewUint(5000), common.BNBAsset, GetRandomBech32Addr())
+	msg.TargetAsset = common.RuneAsset()
+	_, err = unstakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.IsRune(), Equals, true)
+	// 50 RUNE withdrawn , plus 50 BNB swapped into 12.5 RUNE
+	c.Check(items[0].Coin.Amount.Equal(cosmos.NewUint(6250000000)), Equals, true, Commentf("%d", items[0].Coin.Amount.Uint64()))
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(3750000000)), Equals, true, Commentf("%d", pool.BalanceRune.Uint64()))
+	c.Check(pool.BalanceAsset.Equal(cosmos.NewUint(100*common.One)), Equals, true, Commentf("%d", pool.BalanceAsset.Uint64()))
+	// deposits are reduced in the same proportion as the units
+	staker, err = k.GetStaker(ctx, common.BNBAsset, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(staker.RuneDeposit.Equal(cosmos.NewUint(50*common.One)), Equals, true)
+	c.Check(staker.AssetDeposit.Equal(cosmos.NewUint(50*common.One)), Equals, true)
+
+	// trading on the chain is halted , the withdraw is not swapped , both sides are sent out
+	mgr.txOutStore = NewTxStoreDummy()
+	k.SetMimir(ctx, "HaltBNBTrading", 1)
+	msg = NewMsgUnStake(GetRandomTx(), runeAddr, cosmos.NewUint(2000), common.BNBAsset, GetRandomBech32Addr())
+	msg.TargetAsset = common.RuneAsset()
+	_, err = unstakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 2)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(3000000000)), Equals, true, Commentf("%d", pool.BalanceRune.Uint64()))
+	c.Check(pool.BalanceAsset.Equal(cosmos.NewUint(80*common.One)), Equals, true, Commentf("%d", pool.BalanceAsset.Uint64()))
+	k.SetMimir(ctx, "HaltBNBTrading", 0)
+
+	// withdraw everything left , the pool is drained so nothing to swap against , both sides are sent out
+	mgr.txOutStore = NewTxStoreDummy()
+	msg = NewMsgUnStake(GetRandomTx(), runeAddr, cosmos.NewUint(MaxUnstakeBasisPoints), common.BNBAsset, GetRandomBech32Addr())
+	msg.TargetAsset = common.BNBAsset
+	_, err = unstakeHandler.Run(ctx, msg, ver, constAccessor)
+	c.Assert(err, IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 2)
+}
+
 func (HandlerUnstakeSuite) TestUnstakeHandler_Validation(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	testCases := []struct {
@@ -177,8 +256,10 @@ func (HandlerUnstakeSuite) TestUnstakeHandler_mockFailScenarios(c *C) {
 		Status:       PoolEnabled,
 	}
 	staker := Staker{
-		Units:       cosmos.ZeroUint(),
-		PendingRune: cosmos.ZeroUint(),
+		Units:        cosmos.ZeroUint(),
+		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}
 	testCases := []struct {
 		name           string
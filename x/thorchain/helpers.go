@@ -202,7 +202,9 @@ func getTotalYggValueInRune(ctx cosmos.Context, keeper keeper.Keeper, ygg Vault)
 	return yggRune, nil
 }
 
-func refundBond(ctx cosmos.Context, tx common.Tx, amt cosmos.Uint, nodeAcc *NodeAccount, keeper keeper.Keeper, mgr Manager) error {
+// refundBond send the bond of the given bond provider back , up to the given amount , zero amount means all of it
+// when the bond provider is empty , the bond of all bond providers will be sent back
+func refundBond(ctx cosmos.Context, tx common.Tx, provider common.Address, amt cosmos.Uint, nodeAcc *NodeAccount, keeper keeper.Keeper, mgr Manager) error {
 	if nodeAcc.Status == NodeActive {
 		ctx.Logger().Info("node still active, cannot refund bond", "node address", nodeAcc.NodeAddress, "node pub key", nodeAcc.PubKeySet.Secp256k1)
 		return nil
@@ -215,8 +217,9 @@ func refundBond(ctx cosmos.Context, tx common.Tx, amt cosmos.Uint, nodeAcc *Node
 		return nil
 	}
 
-	if amt.IsZero() || amt.GT(nodeAcc.Bond) {
-		amt = nodeAcc.Bond
+	bp, err := getBondProviders(ctx, keeper, *nodeAcc)
+	if err != nil {
+		return err
 	}
 
 	ygg := Vault{}
@@ -260,28 +263,38 @@ func refundBond(ctx cosmos.Context, tx common.Tx, amt cosmos.Uint, nodeAcc *Node
 			return fmt.Errorf("unable to determine asgard vault to send funds")
 		}
 
-		bondEvent := NewEventBond(amt, BondReturned, tx)
-		if err := mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
-			return fmt.Errorf("fail to emit bond event: %w", err)
-		}
+		for _, p := range bp.Providers {
+			if !provider.IsEmpty() && !p.BondAddress.Equals(provider) {
+				continue
+			}
+			refundAmt := bp.GetBond(p.BondAddress, nodeAcc.Bond)
+			if !provider.IsEmpty() && !amt.IsZero() && amt.LT(refundAmt) {
+				refundAmt = amt
+			}
+			refundAmt = bp.Unbond(p.BondAddress, refundAmt, nodeAcc.Bond)
+			if refundAmt.IsZero() {
+				continue
+			}
 
-		refundAddress := nodeAcc.BondAddress
-		if common.RuneAsset().Chain.Equals(common.THORChain) {
-			refundAddress = common.Address(nodeAcc.NodeAddress.String())
-		}
+			bondEvent := NewEventBond(refundAmt, BondReturned, tx)
+			if err := mgr.EventMgr().EmitEvent(ctx, bondEvent); err != nil {
+				return fmt.Errorf("fail to emit bond event: %w", err)
+			}
 
-		// refund bond
-		txOutItem := &TxOutItem{
-			Chain:       common.RuneAsset().Chain,
-			ToAddress:   refundAddress,
-			VaultPubKey: vault.PubKey,
-			InHash:      tx.ID,
-			Coin:        common.NewCoin(common.RuneAsset(), amt),
-			ModuleName:  BondName,
-		}
-		_, err = mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, txOutItem)
-		if err != nil {
-			return fmt.Errorf("fail to add outbound tx: %w", err)
+			// refund bond
+			txOutItem := &TxOutItem{
+				Chain:       common.RuneAsset().Chain,
+				ToAddress:   getBondRefundAddress(*nodeAcc, p.BondAddress),
+				VaultPubKey: vault.PubKey,
+				InHash:      tx.ID,
+				Coin:        common.NewCoin(common.RuneAsset(), refundAmt),
+				ModuleName:  BondName,
+			}
+			_, err = mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, txOutItem)
+			if err != nil {
+				return fmt.Errorf("fail to add outbound tx: %w", err)
+			}
+			nodeAcc.Bond = common.SafeSub(nodeAcc.Bond, refundAmt)
 		}
 	} else {
 		// if it get into here that means the node account doesn't have any bond left after slash.
@@ -289,11 +302,14 @@ func refundBond(ctx cosmos.Context, tx common.Tx, amt cosmos.Uint, nodeAcc *Node
 		slashRune = bondBeforeSlash
 	}
 
-	nodeAcc.Bond = common.SafeSub(nodeAcc.Bond, amt)
 	if err := keeper.SetNodeAccount(ctx, *nodeAcc); err != nil {
 		ctx.Logger().Error(fmt.Sprintf("fail to save node account(%s)", nodeAcc), "error", err)
 		return err
 	}
+	if err := keeper.SetBondProviders(ctx, bp); err != nil {
+		ctx.Logger().Error("fail to save bond providers", "error", err)
+		return err
+	}
 	if err := subsidizePoolWithSlashBond(ctx, keeper, ygg, yggRune, slashRune); err != nil {
 		ctx.Logger().Error("fail to subsidize pool with slashed bond", "error", err)
 		return err
@@ -331,33 +347,24 @@ func isSignedByActiveNodeAccounts(ctx cosmos.Context, keeper keeper.Keeper, sign
 	return true
 }
 
-func enableNextPool(ctx cosmos.Context, keeper keeper.Keeper, eventManager EventManager) error {
-	var pools []Pool
-	iterator := keeper.GetPoolIterator(ctx)
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var pool Pool
-		if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &pool); err != nil {
-			return err
-		}
+// enableNextPool enable the first eligible bootstrap pool ranked by the given policy
+func enableNextPool(ctx cosmos.Context, keeper keeper.Keeper, eventManager EventManager, policy PoolEnablePolicy) error {
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		return err
+	}
 
-		if pool.Status == PoolBootstrap && !pool.BalanceAsset.IsZero() && !pool.BalanceRune.IsZero() {
-			pools = append(pools, pool)
+	var pool Pool
+	for _, candidate := range policy.Rank(pools) {
+		if candidate.Eligible {
+			pool = candidate.Pool
+			break
 		}
 	}
-
-	if len(pools) == 0 {
+	if pool.IsEmpty() {
 		return nil
 	}
 
-	pool := pools[0]
-	for _, p := range pools {
-		// find the pool that has most RUNE, also exclude those pool that doesn't have asset
-		if pool.BalanceRune.LT(p.BalanceRune) {
-			pool = p
-		}
-	}
-
 	poolEvt := NewEventPool(pool.Asset, PoolEnabled)
 	if err := eventManager.EmitEvent(ctx, poolEvt); err != nil {
 		return fmt.Errorf("fail to emit pool event: %w", err)
@@ -368,6 +375,214 @@ func enableNextPool(ctx cosmos.Context, keeper keeper.Keeper, eventManager Event
 	return keeper.SetPool(ctx, pool)
 }
 
+// expirePendingRune looks for stakers whose pending RUNE has been waiting for
+// the asset side longer than PendingRuneExpiryBlocks. When the pool is enabled
+// the RUNE is staked asymmetrically, otherwise it is refunded to the staker's
+// RUNE address.
+func expirePendingRune(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, constAccessor constants.ConstantValues) error {
+	expiry := getPendingRuneExpiryBlocks(ctx, keeper, constAccessor)
+	if expiry <= 0 {
+		return nil
+	}
+	stakers, err := keeper.GetStakersWithPendingRuneBefore(ctx, common.BlockHeight(ctx)-expiry)
+	if err != nil {
+		return fmt.Errorf("fail to get stakers with pending rune: %w", err)
+	}
+	for _, staker := range stakers {
+		pool, err := keeper.GetPool(ctx, staker.Asset)
+		if err != nil {
+			return fmt.Errorf("fail to get pool(%s): %w", staker.Asset, err)
+		}
+		// the pending RUNE of a suspended pool is refunded by the pool suspension , and no RUNE is staked while trading
+		// on the chain is halted , the pending RUNE wait till the chain resume
+		if pool.Status == PoolSuspended || isTradingHalted(ctx, keeper, staker.Asset.Chain) {
+			continue
+		}
+		if pool.IsEnabled() && !pool.BalanceRune.IsZero() && !pool.BalanceAsset.IsZero() {
+			err = stakePendingRune(ctx, keeper, mgr, pool, staker)
+		} else {
+			err = refundPendingRune(ctx, keeper, mgr, staker)
+		}
+		if err != nil {
+			ctx.Logger().Error("fail to expire pending rune", "staker", staker.RuneAddress, "pool", staker.Asset, "error", err)
+		}
+	}
+	return nil
+}
+
+// getPendingRuneExpiryBlocks return the number of blocks pending RUNE can wait for the asset side , mimir takes precedence over the constant
+func getPendingRuneExpiryBlocks(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) int64 {
+	expiry, err := keeper.GetMimir(ctx, constants.PendingRuneExpiryBlocks.String())
+	if expiry < 0 || err != nil {
+		expiry = constAccessor.GetInt64Value(constants.PendingRuneExpiryBlocks)
+	}
+	return expiry
+}
+
+// stakePendingRune adds the staker's pending RUNE to the pool as an asymmetric stake
+func stakePendingRune(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, pool Pool, staker Staker) error {
+	pendingRune := staker.PendingRune
+	newPoolUnits, stakerUnits, err := calculatePoolUnits(pool.PoolUnits, pool.BalanceRune, pool.BalanceAsset, pendingRune, cosmos.ZeroUint())
+	if err != nil {
+		return fmt.Errorf("fail to calculate pool unit: %w", err)
+	}
+	pool.PoolUnits = newPoolUnits
+	pool.BalanceRune = pool.BalanceRune.Add(pendingRune)
+	if err := keeper.SetPool(ctx, pool); err != nil {
+		return fmt.Errorf("fail to save pool: %w", err)
+	}
+
+	evt := NewEventPendingRune(staker, PendingRuneStaked)
+	staker.Units = staker.Units.Add(stakerUnits)
+	staker.RuneDeposit = staker.RuneDeposit.Add(pendingRune)
+	staker.PendingRune = cosmos.ZeroUint()
+	staker.PendingTxID = common.BlankTxID
+	keeper.SetStaker(ctx, staker)
+
+	stakeEvt := NewEventStake(pool.Asset, stakerUnits, staker.RuneAddress, pendingRune, cosmos.ZeroUint(), evt.TxID, common.BlankTxID)
+	if err := mgr.EventMgr().EmitEvent(ctx, stakeEvt); err != nil {
+		return fmt.Errorf("fail to emit stake event: %w", err)
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		return fmt.Errorf("fail to emit pending rune event: %w", err)
+	}
+	return nil
+}
+
+// refundPendingRune sends the staker's pending RUNE back to its RUNE address, the pending RUNE is kept when the refund
+// can't be queued , so it can be tried again
+func refundPendingRune(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, staker Staker) error {
+	toi := &TxOutItem{
+		Chain:     common.RuneAsset().Chain,
+		InHash:    staker.PendingTxID,
+		ToAddress: staker.RuneAddress,
+		Coin:      common.NewCoin(common.RuneAsset(), staker.PendingRune),
+		Memo:      NewRefundMemo(staker.PendingTxID).String(),
+	}
+	status := PendingRuneRefunded
+	ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi)
+	if err != nil {
+		if !errors.Is(err, ErrNotEnoughToPayFee) {
+			return fmt.Errorf("fail to add outbound tx: %w", err)
+		}
+		// the pending RUNE is not more than the transaction fee , all of it is already taken into the reserve as fee
+		status = PendingRuneReserved
+		ok = true
+	}
+	if !ok {
+		return errFailAddOutboundTx
+	}
+
+	evt := NewEventPendingRune(staker, status)
+	staker.PendingRune = cosmos.ZeroUint()
+	staker.PendingTxID = common.BlankTxID
+	if staker.Units.IsZero() {
+		keeper.RemoveStaker(ctx, staker)
+	} else {
+		keeper.SetStaker(ctx, staker)
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		return fmt.Errorf("fail to emit pending rune event: %w", err)
+	}
+	return nil
+}
+
+// updatePoolPriceAccumulators add the end of block price of every enabled pool to its price accumulator ,
+// and checkpoint the accumulators every PriceCheckpointInterval blocks
+func updatePoolPriceAccumulators(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) error {
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get pools: %w", err)
+	}
+	height := common.BlockHeight(ctx)
+	interval := constAccessor.GetInt64Value(constants.PriceCheckpointInterval)
+	retention := constAccessor.GetInt64Value(constants.PriceCheckpointRetentionBlocks)
+	for _, pool := range pools {
+		if !pool.IsEnabled() || pool.BalanceRune.IsZero() || pool.BalanceAsset.IsZero() {
+			continue
+		}
+		acc, err := keeper.GetPoolPriceAccumulator(ctx, pool.Asset)
+		if err != nil {
+			return fmt.Errorf("fail to get pool price accumulator: %w", err)
+		}
+		first := acc.IsEmpty()
+		acc.Update(pool.AssetValueInRune(cosmos.NewUint(common.One)), height)
+		keeper.SetPoolPriceAccumulator(ctx, acc)
+		// checkpoint the first price of the pool , so the TWAP is available as soon as the pool is old enough
+		if first || (interval > 0 && height%interval == 0) {
+			keeper.SetPoolPriceCheckpoint(ctx, NewPoolPriceCheckpoint(acc))
+			if retention > 0 && height > retention {
+				keeper.PrunePoolPriceCheckpoints(ctx, pool.Asset, height-retention)
+			}
+		}
+	}
+	return nil
+}
+
+// recordPoolSnapshots save the depths of every pool every PoolSnapshotInterval blocks ,
+// and prune the snapshots older than PoolSnapshotRetentionBlocks
+func recordPoolSnapshots(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) error {
+	interval := constAccessor.GetInt64Value(constants.PoolSnapshotInterval)
+	height := common.BlockHeight(ctx)
+	if interval <= 0 || height%interval != 0 {
+		return nil
+	}
+	retention := constAccessor.GetInt64Value(constants.PoolSnapshotRetentionBlocks)
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get pools: %w", err)
+	}
+	for _, pool := range pools {
+		if pool.BalanceRune.IsZero() && pool.BalanceAsset.IsZero() {
+			continue
+		}
+		// carry the liquidity fees forward from the latest snapshot , which is older than one interval when the pool was empty
+		// for a while , and add the fees collected in the last interval
+		last, err := keeper.GetLatestPoolSnapshot(ctx, pool.Asset, height)
+		if err != nil {
+			return fmt.Errorf("fail to get pool snapshot: %w", err)
+		}
+		fees := last.LiquidityFees
+		from := height - interval + 1
+		if last.Height >= from {
+			from = last.Height + 1
+		}
+		for h := from; h <= height; h++ {
+			if h <= 0 {
+				continue
+			}
+			fee, err := keeper.GetPoolLiquidityFees(ctx, uint64(h), pool.Asset)
+			if err != nil {
+				return fmt.Errorf("fail to get pool liquidity fees: %w", err)
+			}
+			fees = fees.Add(fee)
+		}
+		keeper.SetPoolSnapshot(ctx, NewPoolSnapshot(pool, height, fees))
+		if retention > 0 && height > retention {
+			keeper.PrunePoolSnapshots(ctx, pool.Asset, height-retention)
+		}
+	}
+	return nil
+}
+
+// hasScheduledOutbound return true when any outbound held in the schedule is going to be sent from the given vault
+func hasScheduledOutbound(ctx cosmos.Context, keeper keeper.Keeper, pubKey common.PubKey) (bool, error) {
+	iterator := keeper.GetScheduledOutboundIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var txOut TxOut
+		if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &txOut); err != nil {
+			return false, fmt.Errorf("fail to unmarshal scheduled outbound: %w", err)
+		}
+		for _, item := range txOut.TxArray {
+			if item.VaultPubKey.Equals(pubKey) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 func wrapError(ctx cosmos.Context, err error, wrap string) error {
 	err = fmt.Errorf("%s: %w", wrap, err)
 	ctx.Logger().Error(err.Error())
@@ -5,6 +5,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 	keeper "gitlab.com/thorchain/thornode/x/thorchain/keeper"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
 )
@@ -43,6 +44,14 @@ func (k *TestRefundBondKeeper) GetPool(_ cosmos.Context, asset common.Asset) (Po
 	return NewPool(), kaboom
 }
 
+func (k *TestRefundBondKeeper) GetBondProviders(_ cosmos.Context, addr cosmos.AccAddress) (BondProviders, error) {
+	return NewBondProviders(addr), nil
+}
+
+func (k *TestRefundBondKeeper) SetBondProviders(_ cosmos.Context, _ BondProviders) error {
+	return nil
+}
+
 func (k *TestRefundBondKeeper) SetNodeAccount(_ cosmos.Context, na NodeAccount) error {
 	k.na = na
 	return nil
@@ -160,18 +169,18 @@ func (s *HelperSuite) TestRefundBondError(c *C) {
 	mgr := NewDummyMgr()
 	tx := GetRandomTx()
 	keeper1 := &TestRefundBondKeeper{}
-	c.Assert(refundBond(ctx, tx, cosmos.ZeroUint(), &na, keeper1, mgr), IsNil)
+	c.Assert(refundBond(ctx, tx, common.NoAddress, cosmos.ZeroUint(), &na, keeper1, mgr), IsNil)
 
 	// fail to get vault should return an error
 	na.UpdateStatus(NodeStandby, common.BlockHeight(ctx))
 	keeper1.na = na
-	c.Assert(refundBond(ctx, tx, cosmos.ZeroUint(), &na, keeper1, mgr), NotNil)
+	c.Assert(refundBond(ctx, tx, common.NoAddress, cosmos.ZeroUint(), &na, keeper1, mgr), NotNil)
 
 	// if the vault is not a yggdrasil pool , it should return an error
 	ygg := NewVault(common.BlockHeight(ctx), ActiveVault, AsgardVault, pk, common.Chains{common.BNBChain})
 	ygg.Coins = common.Coins{}
 	keeper1.ygg = ygg
-	c.Assert(refundBond(ctx, tx, cosmos.ZeroUint(), &na, keeper1, mgr), NotNil)
+	c.Assert(refundBond(ctx, tx, common.NoAddress, cosmos.ZeroUint(), &na, keeper1, mgr), NotNil)
 
 	// fail to get pool should fail
 	ygg = NewVault(common.BlockHeight(ctx), ActiveVault, YggdrasilVault, pk, common.Chains{common.BNBChain})
@@ -180,7 +189,7 @@ func (s *HelperSuite) TestRefundBondError(c *C) {
 		common.NewCoin(common.BNBAsset, cosmos.NewUint(27*common.One)),
 	}
 	keeper1.ygg = ygg
-	c.Assert(refundBond(ctx, tx, cosmos.ZeroUint(), &na, keeper1, mgr), NotNil)
+	c.Assert(refundBond(ctx, tx, common.NoAddress, cosmos.ZeroUint(), &na, keeper1, mgr), NotNil)
 
 	// when ygg asset in RUNE is more then bond , thorchain should slash the node account with all their bond
 	keeper1.pool = Pool{
@@ -188,7 +197,7 @@ func (s *HelperSuite) TestRefundBondError(c *C) {
 		BalanceRune:  cosmos.NewUint(1024 * common.One),
 		BalanceAsset: cosmos.NewUint(167 * common.One),
 	}
-	c.Assert(refundBond(ctx, tx, cosmos.ZeroUint(), &na, keeper1, mgr), IsNil)
+	c.Assert(refundBond(ctx, tx, common.NoAddress, cosmos.ZeroUint(), &na, keeper1, mgr), IsNil)
 	// make sure no tx has been generated for refund
 	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
 	c.Assert(err, IsNil)
@@ -221,7 +230,7 @@ func (s *HelperSuite) TestRefundBondHappyPath(c *C) {
 	tx := GetRandomTx()
 	yggAssetInRune, err := getTotalYggValueInRune(ctx, keeper, ygg)
 	c.Assert(err, IsNil)
-	err = refundBond(ctx, tx, cosmos.ZeroUint(), &na, keeper, mgr)
+	err = refundBond(ctx, tx, common.NoAddress, cosmos.ZeroUint(), &na, keeper, mgr)
 	slashAmt := yggAssetInRune.MulUint64(3).QuoUint64(2)
 	c.Assert(err, IsNil)
 	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
@@ -231,7 +240,9 @@ func (s *HelperSuite) TestRefundBondHappyPath(c *C) {
 	} else {
 		c.Check(items, HasLen, 1)
 		outCoin := items[0].Coin
-		c.Check(outCoin.Amount.Equal(cosmos.NewUint(12098*common.One)), Equals, true, Commentf("%d", outCoin.Amount.Uint64()))
+		// the bond left after the yggdrasil slash is returned
+		expectedRefund := cosmos.NewUint(12098 * common.One).Sub(slashAmt)
+		c.Check(outCoin.Amount.Equal(expectedRefund), Equals, true, Commentf("%d", outCoin.Amount.Uint64()))
 	}
 	p, err := keeper.GetPool(ctx, common.BNBAsset)
 	c.Assert(err, IsNil)
@@ -245,6 +256,7 @@ func (s *HelperSuite) TestEnableNextPool(c *C) {
 	var err error
 	ctx, k := setupKeeperForTest(c)
 	eventMgr := NewDummyEventMgr()
+	policy := newDepthPoolEnablePolicy(cosmos.ZeroUint())
 	c.Assert(err, IsNil)
 	pool := NewPool()
 	pool.Asset = common.BNBAsset
@@ -288,17 +300,17 @@ func (s *HelperSuite) TestEnableNextPool(c *C) {
 	pool.BalanceAsset = cosmos.NewUint(0 * common.One)
 	c.Assert(k.SetPool(ctx, pool), IsNil)
 	// should enable BTC
-	c.Assert(enableNextPool(ctx, k, eventMgr), IsNil)
+	c.Assert(enableNextPool(ctx, k, eventMgr, policy), IsNil)
 	pool, err = k.GetPool(ctx, common.BTCAsset)
 	c.Check(pool.Status, Equals, PoolEnabled)
 
 	// should enable ETH
-	c.Assert(enableNextPool(ctx, k, eventMgr), IsNil)
+	c.Assert(enableNextPool(ctx, k, eventMgr, policy), IsNil)
 	pool, err = k.GetPool(ctx, ethAsset)
 	c.Check(pool.Status, Equals, PoolEnabled)
 
 	// should NOT enable XMR, since it has no assets
-	c.Assert(enableNextPool(ctx, k, eventMgr), IsNil)
+	c.Assert(enableNextPool(ctx, k, eventMgr, policy), IsNil)
 	pool, err = k.GetPool(ctx, xmrAsset)
 	c.Assert(pool.IsEmpty(), Equals, false)
 	c.Check(pool.Status, Equals, PoolBootstrap)
@@ -383,6 +395,263 @@ func newAddGasFeeTestHelper(c *C) addGasFeeTestHelper {
 	}
 }
 
+func (s *HelperSuite) TestExpirePendingRune(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	k.SetMimir(ctx, constants.PendingRuneExpiryBlocks.String(), 100)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.Status = PoolBootstrap
+	c.Assert(k.SetPool(ctx, btcPool), IsNil)
+
+	newPendingStaker := func(asset common.Asset) Staker {
+		return Staker{
+			Asset:           asset,
+			RuneAddress:     GetRandomRUNEAddress(),
+			LastStakeHeight: 10,
+			Units:           cosmos.ZeroUint(),
+			PendingRune:     cosmos.NewUint(10 * common.One),
+			PendingTxID:     GetRandomTxHash(),
+			RuneDeposit:     cosmos.ZeroUint(),
+			AssetDeposit:    cosmos.ZeroUint(),
+		}
+	}
+	bnbStaker := newPendingStaker(common.BNBAsset)
+	btcStaker := newPendingStaker(common.BTCAsset)
+	k.SetStaker(ctx, bnbStaker)
+	k.SetStaker(ctx, btcStaker)
+
+	// not expired yet
+	c.Assert(expirePendingRune(ctx.WithBlockHeight(109), k, mgr, constAccessor), IsNil)
+	stakers, err := k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 2)
+
+	// no RUNE is staked while trading on the chain is halted
+	ctx = ctx.WithBlockHeight(110)
+	k.SetMimir(ctx, "HaltBNBTrading", 1)
+	c.Assert(expirePendingRune(ctx, k, mgr, constAccessor), IsNil)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].Asset.Equals(common.BNBAsset), Equals, true)
+
+	k.SetMimir(ctx, "HaltBNBTrading", 0)
+	c.Assert(expirePendingRune(ctx, k, mgr, constAccessor), IsNil)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+
+	// enabled pool , pending rune is staked asymmetrically
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(110*common.One)), Equals, true)
+	staker, err := k.GetStaker(ctx, common.BNBAsset, bnbStaker.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(staker.Units.IsZero(), Equals, false)
+	c.Check(pool.PoolUnits.Equal(cosmos.NewUint(100*common.One).Add(staker.Units)), Equals, true)
+	c.Check(staker.PendingRune.IsZero(), Equals, true)
+	c.Check(staker.RuneDeposit.Equal(cosmos.NewUint(10*common.One)), Equals, true)
+
+	// pool not enabled , pending rune is refunded
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].ToAddress.Equals(btcStaker.RuneAddress), Equals, true)
+	c.Check(items[0].InHash.Equals(btcStaker.PendingTxID), Equals, true)
+	c.Check(items[0].Coin.Equals(common.NewCoin(common.RuneAsset(), cosmos.NewUint(10*common.One))), Equals, true)
+	staker, err = k.GetStaker(ctx, common.BTCAsset, btcStaker.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(staker.PendingRune.IsZero(), Equals, true)
+	c.Check(staker.LastStakeHeight, Equals, int64(0))
+
+	// the pending RUNE of a suspended pool is left to the pool suspension
+	ethPool := NewPool()
+	ethPool.Asset = common.ETHAsset
+	ethPool.Status = PoolSuspended
+	c.Assert(k.SetPool(ctx, ethPool), IsNil)
+	ethStaker := newPendingStaker(common.ETHAsset)
+	k.SetStaker(ctx, ethStaker)
+	c.Assert(expirePendingRune(ctx, k, mgr, constAccessor), IsNil)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 1)
+	k.RemoveStaker(ctx, ethStaker)
+
+	// expiry disabled
+	k.SetMimir(ctx, constants.PendingRuneExpiryBlocks.String(), 0)
+	k.SetStaker(ctx, newPendingStaker(common.BTCAsset))
+	c.Assert(expirePendingRune(ctx.WithBlockHeight(100000000), k, mgr, constAccessor), IsNil)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 1)
+}
+
+func (s *HelperSuite) TestRefundPendingRune(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	staker := Staker{
+		Asset:           common.BTCAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		LastStakeHeight: 10,
+		Units:           cosmos.ZeroUint(),
+		PendingRune:     cosmos.NewUint(10 * common.One),
+		PendingTxID:     GetRandomTxHash(),
+		RuneDeposit:     cosmos.ZeroUint(),
+		AssetDeposit:    cosmos.ZeroUint(),
+	}
+	k.SetStaker(ctx, staker)
+
+	// the refund can't be queued , the pending rune is kept
+	mgr.txOutStore = NewTxOutStoreFailDummy(nil)
+	c.Check(refundPendingRune(ctx, k, mgr, staker), NotNil)
+	mgr.txOutStore = NewTxOutStoreFailDummy(kaboom)
+	c.Check(refundPendingRune(ctx, k, mgr, staker), NotNil)
+	stakers, err := k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].PendingRune.Equal(cosmos.NewUint(10*common.One)), Equals, true)
+
+	// the pending rune is all taken as fee
+	mgr.txOutStore = NewTxOutStoreFailDummy(ErrNotEnoughToPayFee)
+	c.Assert(refundPendingRune(ctx, k, mgr, staker), IsNil)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+}
+
+func (s *HelperSuite) TestRecordPoolSnapshots(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	interval := constAccessor.GetInt64Value(constants.PoolSnapshotInterval)
+	retention := constAccessor.GetInt64Value(constants.PoolSnapshotRetentionBlocks)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	emptyPool := NewPool()
+	emptyPool.Asset = common.BTCAsset
+	c.Assert(k.SetPool(ctx, emptyPool), IsNil)
+
+	// not on the interval
+	ctx = ctx.WithBlockHeight(interval + 1)
+	c.Assert(recordPoolSnapshots(ctx, k, constAccessor), IsNil)
+	snapshots, err := k.GetPoolSnapshots(ctx, common.BNBAsset, 0, common.BlockHeight(ctx))
+	c.Assert(err, IsNil)
+	c.Check(snapshots, HasLen, 0)
+
+	ctx = ctx.WithBlockHeight(interval)
+	c.Assert(k.AddToLiquidityFees(ctx, common.BNBAsset, cosmos.NewUint(10)), IsNil)
+	c.Assert(recordPoolSnapshots(ctx, k, constAccessor), IsNil)
+	snapshot, err := k.GetPoolSnapshot(ctx, common.BNBAsset, interval)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.BalanceRune.Equal(pool.BalanceRune), Equals, true)
+	c.Check(snapshot.BalanceAsset.Equal(pool.BalanceAsset), Equals, true)
+	c.Check(snapshot.PoolUnits.Equal(pool.PoolUnits), Equals, true)
+	c.Check(snapshot.LiquidityFees.Equal(cosmos.NewUint(10)), Equals, true)
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BTCAsset, 0, common.BlockHeight(ctx))
+	c.Assert(err, IsNil)
+	c.Check(snapshots, HasLen, 0)
+
+	// liquidity fees are cumulative
+	ctx = ctx.WithBlockHeight(interval * 2)
+	c.Assert(k.AddToLiquidityFees(ctx.WithBlockHeight(interval+1), common.BNBAsset, cosmos.NewUint(5)), IsNil)
+	c.Assert(k.AddToLiquidityFees(ctx, common.BNBAsset, cosmos.NewUint(5)), IsNil)
+	c.Assert(recordPoolSnapshots(ctx, k, constAccessor), IsNil)
+	snapshot, err = k.GetPoolSnapshot(ctx, common.BNBAsset, interval*2)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.LiquidityFees.Equal(cosmos.NewUint(20)), Equals, true)
+
+	// snapshots older than the retention are pruned , liquidity fees are carried over from the latest snapshot
+	ctx = ctx.WithBlockHeight(retention + interval*2)
+	c.Assert(k.AddToLiquidityFees(ctx, common.BNBAsset, cosmos.NewUint(5)), IsNil)
+	c.Assert(recordPoolSnapshots(ctx, k, constAccessor), IsNil)
+	snapshot, err = k.GetPoolSnapshot(ctx, common.BNBAsset, retention+interval*2)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.LiquidityFees.Equal(cosmos.NewUint(25)), Equals, true)
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BNBAsset, 0, common.BlockHeight(ctx))
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 2)
+	c.Check(snapshots[0].Height, Equals, interval*2)
+}
+
+func (s *HelperSuite) TestUpdatePoolPriceAccumulators(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	interval := constAccessor.GetInt64Value(constants.PriceCheckpointInterval)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(200 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	bootstrap := NewPool()
+	bootstrap.Asset = common.BTCAsset
+	bootstrap.BalanceRune = cosmos.NewUint(100 * common.One)
+	bootstrap.BalanceAsset = cosmos.NewUint(100 * common.One)
+	bootstrap.Status = PoolBootstrap
+	c.Assert(k.SetPool(ctx, bootstrap), IsNil)
+
+	// the first price of the pool is checkpointed even though it is not on the interval
+	first := interval / 2
+	for h := first; h <= interval; h++ {
+		ctx = ctx.WithBlockHeight(h)
+		c.Assert(updatePoolPriceAccumulators(ctx, k, constAccessor), IsNil)
+	}
+	acc, err := k.GetPoolPriceAccumulator(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(acc.LastPrice.Equal(cosmos.NewUint(2*common.One)), Equals, true)
+	c.Check(acc.FirstHeight, Equals, first)
+	c.Check(acc.LastHeight, Equals, interval)
+	acc, err = k.GetPoolPriceAccumulator(ctx, common.BTCAsset)
+	c.Assert(err, IsNil)
+	c.Check(acc.IsEmpty(), Equals, true)
+	twap, err := k.GetPoolTWAP(ctx, common.BNBAsset, interval-first)
+	c.Assert(err, IsNil)
+	c.Check(twap.Equal(cosmos.NewUint(2*common.One)), Equals, true)
+	// not enough history
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, interval)
+	c.Assert(err, IsNil)
+	c.Check(twap.IsZero(), Equals, true)
+
+	// the price doubles for the next interval
+	pool.BalanceRune = cosmos.NewUint(400 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	for h := interval + 1; h <= interval*2; h++ {
+		ctx = ctx.WithBlockHeight(h)
+		c.Assert(updatePoolPriceAccumulators(ctx, k, constAccessor), IsNil)
+	}
+	acc, err = k.GetPoolPriceAccumulator(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(acc.CumulativePrice.Equal(cosmos.NewUint(uint64(2*common.One*(interval-first+1)+4*common.One*(interval-1)))), Equals, true)
+	// the window starts at the checkpoint of the first interval
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, interval)
+	c.Assert(err, IsNil)
+	c.Check(twap.Equal(cosmos.NewUint(uint64((2*common.One+4*common.One*(interval-1))/interval))), Equals, true)
+	// the window starts between two checkpoints , the earlier one is used
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, interval/2)
+	c.Assert(err, IsNil)
+	c.Check(twap.Equal(cosmos.NewUint(uint64((2*common.One+4*common.One*(interval-1))/interval))), Equals, true)
+}
+
 func (s *HelperSuite) TestAddGasFees(c *C) {
 	testCases := []struct {
 		name        string
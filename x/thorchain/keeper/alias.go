@@ -32,10 +32,18 @@ var (
 )
 
 type (
-	MsgSwap = types.MsgSwap
+	MsgSwap        = types.MsgSwap
+	StreamingSwap  = types.StreamingSwap
+	LimitOrder     = types.LimitOrder
+	LimitOrders    = types.LimitOrders
+	LimitOrderPair = types.LimitOrderPair
+	PoolSnapshot   = types.PoolSnapshot
 
 	PoolStatus              = types.PoolStatus
 	Pool                    = types.Pool
+	PoolPriceAccumulator    = types.PoolPriceAccumulator
+	PoolPriceCheckpoint     = types.PoolPriceCheckpoint
+	PoolSuspension          = types.PoolSuspension
 	Pools                   = types.Pools
 	Staker                  = types.Staker
 	ObservedTxVoter         = types.ObservedTxVoter
@@ -50,6 +58,8 @@ type (
 	Vault                   = types.Vault
 	Vaults                  = types.Vaults
 	Jail                    = types.Jail
+	BondProviders           = types.BondProviders
+	NodeMaintenance         = types.NodeMaintenance
 	NodeAccount             = types.NodeAccount
 	NodeAccounts            = types.NodeAccounts
 	NodeStatus              = types.NodeStatus
@@ -59,5 +69,11 @@ type (
 	TxMarkers               = types.TxMarkers
 	NetworkFee              = types.NetworkFee
 	ObservedNetworkFeeVoter = types.ObservedNetworkFeeVoter
+	SolvencyVoter           = types.SolvencyVoter
+	VaultSolvency           = types.VaultSolvency
+	ChainHalt               = types.ChainHalt
+	OutboundValue           = types.OutboundValue
+	SlashRecord             = types.SlashRecord
+	ChainObservation        = types.ChainObservation
 	RagnarokUnstakePosition = types.RagnarokUnstakePosition
 )
@@ -33,7 +33,11 @@ type Keeper interface {
 	KeeperObserver
 	KeeperObservedTx
 	KeeperTxOut
+	KeeperScheduledOutbound
 	KeeperLiquidityFees
+	KeeperPoolSnapshot
+	KeeperPoolPrice
+	KeeperPoolSuspension
 	KeeperVault
 	KeeperReserveContributors
 	KeeperVaultData
@@ -46,9 +50,16 @@ type Keeper interface {
 	KeeperErrataTx
 	KeeperBanVoter
 	KeeperSwapQueue
+	KeeperStreamingSwap
+	KeeperLimitOrder
 	KeeperMimir
 	KeeperNetworkFee
 	KeeperObservedNetworkFeeVoter
+	KeeperSolvency
+	KeeperChainHalt
+	KeeperOutboundValue
+	KeeperSlashRecord
+	KeeperNodeMaintenance
 }
 
 type KeeperPool interface {
@@ -73,6 +84,9 @@ type KeeperStaker interface {
 	GetStaker(ctx cosmos.Context, asset common.Asset, addr common.Address) (Staker, error)
 	SetStaker(ctx cosmos.Context, staker Staker)
 	RemoveStaker(ctx cosmos.Context, staker Staker)
+	GetStakersByAddress(ctx cosmos.Context, addr common.Address) ([]Staker, error)
+	GetStakersWithPendingRune(ctx cosmos.Context) ([]Staker, error)
+	GetStakersWithPendingRuneBefore(ctx cosmos.Context, height int64) ([]Staker, error)
 }
 
 type KeeperNodeAccount interface {
@@ -94,12 +108,20 @@ type KeeperNodeAccount interface {
 	ResetNodeAccountSlashPoints(_ cosmos.Context, _ cosmos.AccAddress)
 	GetNodeAccountJail(ctx cosmos.Context, addr cosmos.AccAddress) (Jail, error)
 	SetNodeAccountJail(ctx cosmos.Context, addr cosmos.AccAddress, height int64, reason string) error
+	GetBondProviders(ctx cosmos.Context, addr cosmos.AccAddress) (BondProviders, error)
+	SetBondProviders(ctx cosmos.Context, bp BondProviders) error
 }
 
 type KeeperObserver interface {
 	GetObservingAddresses(ctx cosmos.Context) ([]cosmos.AccAddress, error)
 	AddObservingAddresses(ctx cosmos.Context, inAddresses []cosmos.AccAddress) error
 	ClearObservingAddresses(ctx cosmos.Context)
+	GetChainObservingAddresses(ctx cosmos.Context, chain common.Chain) ([]cosmos.AccAddress, error)
+	AddChainObservingAddresses(ctx cosmos.Context, chain common.Chain, inAddresses []cosmos.AccAddress) error
+	GetObservedChains(ctx cosmos.Context) (common.Chains, error)
+	GetChainObservation(ctx cosmos.Context, addr cosmos.AccAddress, chain common.Chain) (ChainObservation, error)
+	SetChainObservation(ctx cosmos.Context, observation ChainObservation) error
+	GetChainObservations(ctx cosmos.Context, addr cosmos.AccAddress) ([]ChainObservation, error)
 }
 
 type KeeperObservedTx interface {
@@ -119,12 +141,42 @@ type KeeperTxOut interface {
 	GetTxOut(ctx cosmos.Context, height int64) (*TxOut, error)
 }
 
+type KeeperScheduledOutbound interface {
+	AppendScheduledOutbound(ctx cosmos.Context, height int64, item *TxOutItem) error
+	GetScheduledOutbound(ctx cosmos.Context, height int64) (*TxOut, error)
+	ClearScheduledOutbound(ctx cosmos.Context, height int64)
+	GetScheduledOutboundIterator(ctx cosmos.Context) cosmos.Iterator
+}
+
 type KeeperLiquidityFees interface {
 	AddToLiquidityFees(ctx cosmos.Context, asset common.Asset, fee cosmos.Uint) error
 	GetTotalLiquidityFees(ctx cosmos.Context, height uint64) (cosmos.Uint, error)
 	GetPoolLiquidityFees(ctx cosmos.Context, height uint64, asset common.Asset) (cosmos.Uint, error)
 }
 
+type KeeperPoolSnapshot interface {
+	SetPoolSnapshot(ctx cosmos.Context, snapshot PoolSnapshot)
+	GetPoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64) (PoolSnapshot, error)
+	GetLatestPoolSnapshot(ctx cosmos.Context, asset common.Asset, before int64) (PoolSnapshot, error)
+	GetPoolSnapshots(ctx cosmos.Context, asset common.Asset, from, to int64) ([]PoolSnapshot, error)
+	PrunePoolSnapshots(ctx cosmos.Context, asset common.Asset, before int64)
+}
+
+type KeeperPoolPrice interface {
+	GetPoolPriceAccumulator(ctx cosmos.Context, asset common.Asset) (PoolPriceAccumulator, error)
+	SetPoolPriceAccumulator(ctx cosmos.Context, acc PoolPriceAccumulator)
+	SetPoolPriceCheckpoint(ctx cosmos.Context, checkpoint PoolPriceCheckpoint)
+	PrunePoolPriceCheckpoints(ctx cosmos.Context, asset common.Asset, before int64)
+	GetPoolTWAP(ctx cosmos.Context, asset common.Asset, window int64) (cosmos.Uint, error)
+}
+
+type KeeperPoolSuspension interface {
+	GetPoolSuspensionIterator(ctx cosmos.Context) cosmos.Iterator
+	GetPoolSuspension(ctx cosmos.Context, asset common.Asset) (PoolSuspension, error)
+	SetPoolSuspension(ctx cosmos.Context, suspension PoolSuspension)
+	RemovePoolSuspension(ctx cosmos.Context, asset common.Asset)
+}
+
 type KeeperVault interface {
 	GetVaultIterator(ctx cosmos.Context) cosmos.Iterator
 	VaultExists(ctx cosmos.Context, pk common.PubKey) bool
@@ -210,6 +262,25 @@ type KeeperSwapQueue interface {
 	RemoveSwapQueueItem(ctx cosmos.Context, txID common.TxID)
 }
 
+type KeeperStreamingSwap interface {
+	GetStreamingSwapIterator(ctx cosmos.Context) cosmos.Iterator
+	GetStreamingSwap(ctx cosmos.Context, txID common.TxID) (StreamingSwap, error)
+	SetStreamingSwap(ctx cosmos.Context, stream StreamingSwap)
+	StreamingSwapExists(ctx cosmos.Context, txID common.TxID) bool
+	RemoveStreamingSwap(ctx cosmos.Context, txID common.TxID)
+}
+
+type KeeperLimitOrder interface {
+	GetLimitOrderIterator(ctx cosmos.Context) cosmos.Iterator
+	GetLimitOrder(ctx cosmos.Context, txID common.TxID) (LimitOrder, error)
+	SetLimitOrder(ctx cosmos.Context, order LimitOrder)
+	LimitOrderExists(ctx cosmos.Context, txID common.TxID) bool
+	RemoveLimitOrder(ctx cosmos.Context, txID common.TxID)
+	GetLimitOrderPairs(ctx cosmos.Context) ([]LimitOrderPair, error)
+	GetLimitOrdersByRate(ctx cosmos.Context, source, target common.Asset, limit int) (LimitOrders, error)
+	GetExpiredLimitOrders(ctx cosmos.Context, height int64, limit int) (LimitOrders, error)
+}
+
 type KeeperMimir interface {
 	GetMimir(_ cosmos.Context, key string) (int64, error)
 	SetMimir(_ cosmos.Context, key string, value int64)
@@ -228,6 +299,41 @@ type KeeperObservedNetworkFeeVoter interface {
 	GetObservedNetworkFeeVoter(ctx cosmos.Context, height int64, chain common.Chain) (ObservedNetworkFeeVoter, error)
 }
 
+type KeeperChainHalt interface {
+	GetChainHaltIterator(ctx cosmos.Context) cosmos.Iterator
+	GetChainHalt(ctx cosmos.Context, chain common.Chain) (ChainHalt, error)
+	SetChainHalt(ctx cosmos.Context, halt ChainHalt)
+	RemoveChainHalt(ctx cosmos.Context, chain common.Chain)
+}
+
+type KeeperOutboundValue interface {
+	SetOutboundValue(ctx cosmos.Context, value OutboundValue)
+	GetOutboundValues(ctx cosmos.Context, chain common.Chain, from, to int64) ([]OutboundValue, error)
+	PruneOutboundValues(ctx cosmos.Context, chain common.Chain, before int64)
+}
+
+type KeeperSlashRecord interface {
+	AppendSlashRecord(ctx cosmos.Context, record SlashRecord) error
+	GetSlashRecords(ctx cosmos.Context, addr cosmos.AccAddress, from int64) ([]SlashRecord, error)
+	PruneSlashRecords(ctx cosmos.Context, before int64)
+}
+
+type KeeperNodeMaintenance interface {
+	GetNodeMaintenanceIterator(ctx cosmos.Context) cosmos.Iterator
+	GetNodeMaintenance(ctx cosmos.Context, addr cosmos.AccAddress) (NodeMaintenance, error)
+	SetNodeMaintenance(ctx cosmos.Context, maintenance NodeMaintenance) error
+}
+
+type KeeperSolvency interface {
+	SetSolvencyVoter(ctx cosmos.Context, voter SolvencyVoter)
+	GetSolvencyVoter(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) (SolvencyVoter, error)
+	PruneSolvencyVoters(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64)
+	GetSolvencyVoterIterator(ctx cosmos.Context) cosmos.Iterator
+	SetVaultSolvency(ctx cosmos.Context, solvency VaultSolvency)
+	GetVaultSolvency(ctx cosmos.Context, pubKey common.PubKey, chain common.Chain) (VaultSolvency, error)
+	GetVaultSolvencyIterator(ctx cosmos.Context) cosmos.Iterator
+}
+
 // NewKVStore creates new instances of the thorchain Keeper
 func NewKVStore(coinKeeper bank.Keeper, supplyKeeper supply.Keeper, storeKey cosmos.StoreKey, cdc *codec.Codec) Keeper {
 	return kv1.NewKVStore(coinKeeper, supplyKeeper, storeKey, cdc)
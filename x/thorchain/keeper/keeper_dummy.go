@@ -113,6 +113,15 @@ func (k KVStoreDummy) GetStaker(_ cosmos.Context, _ common.Asset, _ common.Addre
 func (k KVStoreDummy) SetStaker(_ cosmos.Context, _ Staker)                 {}
 func (k KVStoreDummy) RemoveStaker(_ cosmos.Context, _ Staker)              {}
 func (k KVStoreDummy) TotalActiveNodeAccount(_ cosmos.Context) (int, error) { return 0, kaboom }
+func (k KVStoreDummy) GetStakersByAddress(_ cosmos.Context, _ common.Address) ([]Staker, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetStakersWithPendingRune(_ cosmos.Context) ([]Staker, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetStakersWithPendingRuneBefore(_ cosmos.Context, _ int64) ([]Staker, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) ListNodeAccountsWithBond(_ cosmos.Context) (NodeAccounts, error) {
 	return nil, kaboom
 }
@@ -168,6 +177,14 @@ func (k KVStoreDummy) SetNodeAccountJail(ctx cosmos.Context, addr cosmos.AccAddr
 	return kaboom
 }
 
+func (k KVStoreDummy) GetBondProviders(_ cosmos.Context, _ cosmos.AccAddress) (BondProviders, error) {
+	return BondProviders{}, kaboom
+}
+
+func (k KVStoreDummy) SetBondProviders(_ cosmos.Context, _ BondProviders) error {
+	return kaboom
+}
+
 func (k KVStoreDummy) GetObservingAddresses(_ cosmos.Context) ([]cosmos.AccAddress, error) {
 	return nil, kaboom
 }
@@ -175,7 +192,26 @@ func (k KVStoreDummy) GetObservingAddresses(_ cosmos.Context) ([]cosmos.AccAddre
 func (k KVStoreDummy) AddObservingAddresses(_ cosmos.Context, _ []cosmos.AccAddress) error {
 	return kaboom
 }
-func (k KVStoreDummy) ClearObservingAddresses(_ cosmos.Context)                      {}
+func (k KVStoreDummy) ClearObservingAddresses(_ cosmos.Context) {}
+func (k KVStoreDummy) GetChainObservingAddresses(_ cosmos.Context, _ common.Chain) ([]cosmos.AccAddress, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) AddChainObservingAddresses(_ cosmos.Context, _ common.Chain, _ []cosmos.AccAddress) error {
+	return kaboom
+}
+
+func (k KVStoreDummy) GetObservedChains(_ cosmos.Context) (common.Chains, error) {
+	return nil, kaboom
+}
+
+func (k KVStoreDummy) GetChainObservation(_ cosmos.Context, _ cosmos.AccAddress, _ common.Chain) (ChainObservation, error) {
+	return ChainObservation{}, kaboom
+}
+func (k KVStoreDummy) SetChainObservation(_ cosmos.Context, _ ChainObservation) error { return kaboom }
+func (k KVStoreDummy) GetChainObservations(_ cosmos.Context, _ cosmos.AccAddress) ([]ChainObservation, error) {
+	return nil, kaboom
+}
 func (k KVStoreDummy) SetObservedTxInVoter(_ cosmos.Context, _ ObservedTxVoter)      {}
 func (k KVStoreDummy) GetObservedTxInVoterIterator(_ cosmos.Context) cosmos.Iterator { return nil }
 func (k KVStoreDummy) GetObservedTxInVoter(_ cosmos.Context, _ common.TxID) (ObservedTxVoter, error) {
@@ -202,6 +238,14 @@ func (k KVStoreDummy) SetTxOut(_ cosmos.Context, _ *TxOut) error
 func (k KVStoreDummy) AppendTxOut(_ cosmos.Context, _ int64, _ *TxOutItem) error { return kaboom }
 func (k KVStoreDummy) ClearTxOut(_ cosmos.Context, _ int64) error                { return kaboom }
 func (k KVStoreDummy) GetTxOutIterator(_ cosmos.Context) cosmos.Iterator         { return nil }
+func (k KVStoreDummy) AppendScheduledOutbound(_ cosmos.Context, _ int64, _ *TxOutItem) error {
+	return kaboom
+}
+func (k KVStoreDummy) GetScheduledOutbound(_ cosmos.Context, _ int64) (*TxOut, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) ClearScheduledOutbound(_ cosmos.Context, _ int64)              {}
+func (k KVStoreDummy) GetScheduledOutboundIterator(_ cosmos.Context) cosmos.Iterator { return nil }
 func (k KVStoreDummy) AddToLiquidityFees(_ cosmos.Context, _ common.Asset, _ cosmos.Uint) error {
 	return kaboom
 }
@@ -289,9 +333,95 @@ func (k KVStoreDummy) RemoveSwapQueueItem(ctx cosmos.Context, _ common.TxID)   {
 func (k KVStoreDummy) GetSwapQueueItem(ctx cosmos.Context, txID common.TxID) (MsgSwap, error) {
 	return MsgSwap{}, kaboom
 }
-func (k KVStoreDummy) GetMimir(_ cosmos.Context, key string) (int64, error) { return 0, kaboom }
-func (k KVStoreDummy) SetMimir(_ cosmos.Context, key string, value int64)   {}
-func (k KVStoreDummy) GetMimirIterator(ctx cosmos.Context) cosmos.Iterator  { return nil }
+func (k KVStoreDummy) GetStreamingSwapIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetStreamingSwap(ctx cosmos.Context, txID common.TxID) (StreamingSwap, error) {
+	return StreamingSwap{}, kaboom
+}
+func (k KVStoreDummy) SetStreamingSwap(ctx cosmos.Context, stream StreamingSwap)     {}
+func (k KVStoreDummy) StreamingSwapExists(ctx cosmos.Context, txID common.TxID) bool { return false }
+func (k KVStoreDummy) RemoveStreamingSwap(ctx cosmos.Context, txID common.TxID)      {}
+func (k KVStoreDummy) GetMimir(_ cosmos.Context, key string) (int64, error)          { return 0, kaboom }
+func (k KVStoreDummy) SetMimir(_ cosmos.Context, key string, value int64)            {}
+func (k KVStoreDummy) GetMimirIterator(ctx cosmos.Context) cosmos.Iterator           { return nil }
+
+func (k KVStoreDummy) GetLimitOrderIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetLimitOrder(ctx cosmos.Context, txID common.TxID) (LimitOrder, error) {
+	return LimitOrder{}, kaboom
+}
+func (k KVStoreDummy) SetLimitOrder(ctx cosmos.Context, order LimitOrder)         {}
+func (k KVStoreDummy) LimitOrderExists(ctx cosmos.Context, txID common.TxID) bool { return false }
+func (k KVStoreDummy) RemoveLimitOrder(ctx cosmos.Context, txID common.TxID)      {}
+func (k KVStoreDummy) GetLimitOrderPairs(ctx cosmos.Context) ([]LimitOrderPair, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetLimitOrdersByRate(ctx cosmos.Context, source, target common.Asset, limit int) (LimitOrders, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetExpiredLimitOrders(ctx cosmos.Context, height int64, limit int) (LimitOrders, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetPoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64) (PoolSnapshot, error) {
+	return PoolSnapshot{}, kaboom
+}
+func (k KVStoreDummy) GetLatestPoolSnapshot(ctx cosmos.Context, asset common.Asset, before int64) (PoolSnapshot, error) {
+	return PoolSnapshot{}, kaboom
+}
+func (k KVStoreDummy) SetPoolSnapshot(ctx cosmos.Context, snapshot PoolSnapshot)               {}
+func (k KVStoreDummy) PrunePoolSnapshots(ctx cosmos.Context, asset common.Asset, before int64) {}
+func (k KVStoreDummy) GetPoolSnapshots(ctx cosmos.Context, asset common.Asset, from, to int64) ([]PoolSnapshot, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) GetPoolPriceAccumulator(ctx cosmos.Context, asset common.Asset) (PoolPriceAccumulator, error) {
+	return PoolPriceAccumulator{}, kaboom
+}
+func (k KVStoreDummy) SetPoolPriceAccumulator(ctx cosmos.Context, acc PoolPriceAccumulator)      {}
+func (k KVStoreDummy) SetPoolPriceCheckpoint(ctx cosmos.Context, checkpoint PoolPriceCheckpoint) {}
+func (k KVStoreDummy) PrunePoolPriceCheckpoints(ctx cosmos.Context, asset common.Asset, before int64) {
+}
+func (k KVStoreDummy) GetPoolTWAP(ctx cosmos.Context, asset common.Asset, window int64) (cosmos.Uint, error) {
+	return cosmos.ZeroUint(), kaboom
+}
+func (k KVStoreDummy) GetPoolSuspensionIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetPoolSuspension(ctx cosmos.Context, asset common.Asset) (PoolSuspension, error) {
+	return PoolSuspension{}, kaboom
+}
+func (k KVStoreDummy) SetPoolSuspension(ctx cosmos.Context, suspension PoolSuspension) {}
+func (k KVStoreDummy) RemovePoolSuspension(ctx cosmos.Context, asset common.Asset)     {}
+func (k KVStoreDummy) GetSolvencyVoter(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) (SolvencyVoter, error) {
+	return SolvencyVoter{}, kaboom
+}
+func (k KVStoreDummy) PruneSolvencyVoters(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) {
+}
+func (k KVStoreDummy) SetSolvencyVoter(ctx cosmos.Context, voter SolvencyVoter)    {}
+func (k KVStoreDummy) GetSolvencyVoterIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetVaultSolvency(ctx cosmos.Context, pubKey common.PubKey, chain common.Chain) (VaultSolvency, error) {
+	return VaultSolvency{}, kaboom
+}
+func (k KVStoreDummy) SetVaultSolvency(ctx cosmos.Context, solvency VaultSolvency) {}
+func (k KVStoreDummy) GetVaultSolvencyIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetChainHalt(ctx cosmos.Context, chain common.Chain) (ChainHalt, error) {
+	return ChainHalt{}, kaboom
+}
+func (k KVStoreDummy) GetChainHaltIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) SetChainHalt(ctx cosmos.Context, halt ChainHalt)         {}
+func (k KVStoreDummy) RemoveChainHalt(ctx cosmos.Context, chain common.Chain)  {}
+func (k KVStoreDummy) GetOutboundValues(ctx cosmos.Context, chain common.Chain, from, to int64) ([]OutboundValue, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) SetOutboundValue(ctx cosmos.Context, value OutboundValue)                 {}
+func (k KVStoreDummy) PruneOutboundValues(ctx cosmos.Context, chain common.Chain, before int64) {}
+func (k KVStoreDummy) AppendSlashRecord(ctx cosmos.Context, record SlashRecord) error           { return kaboom }
+func (k KVStoreDummy) GetSlashRecords(ctx cosmos.Context, addr cosmos.AccAddress, from int64) ([]SlashRecord, error) {
+	return nil, kaboom
+}
+func (k KVStoreDummy) PruneSlashRecords(ctx cosmos.Context, before int64)            {}
+func (k KVStoreDummy) GetNodeMaintenanceIterator(ctx cosmos.Context) cosmos.Iterator { return nil }
+func (k KVStoreDummy) GetNodeMaintenance(ctx cosmos.Context, addr cosmos.AccAddress) (NodeMaintenance, error) {
+	return NodeMaintenance{}, kaboom
+}
+func (k KVStoreDummy) SetNodeMaintenance(ctx cosmos.Context, maintenance NodeMaintenance) error {
+	return kaboom
+}
 func (k KVStoreDummy) GetNetworkFee(ctx cosmos.Context, chain common.Chain) (NetworkFee, error) {
 	return NetworkFee{}, kaboom
 }
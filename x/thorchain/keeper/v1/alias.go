@@ -24,11 +24,17 @@ const (
 
 	// Bond type
 	AsgardKeygen = types.AsgardKeygen
+
+	// Pool status
+	PoolEnabled   = types.Enabled
+	PoolBootstrap = types.Bootstrap
 )
 
 var (
 	NewPool                    = types.NewPool
 	NewJail                    = types.NewJail
+	NewBondProviders           = types.NewBondProviders
+	NewNodeMaintenance         = types.NewNodeMaintenance
 	NewTxMarker                = types.NewTxMarker
 	NewVaultData               = types.NewVaultData
 	NewObservedTx              = types.NewObservedTx
@@ -54,11 +60,31 @@ var (
 	GetRandomPubKeySet         = types.GetRandomPubKeySet
 	NewObservedNetworkFeeVoter = types.NewObservedNetworkFeeVoter
 	NewNetworkFee              = types.NewNetworkFee
+	NewSolvencyVoter           = types.NewSolvencyVoter
+	NewVaultSolvency           = types.NewVaultSolvency
+	NewChainHalt               = types.NewChainHalt
+	NewOutboundValue           = types.NewOutboundValue
+	NewSlashRecord             = types.NewSlashRecord
+	NewChainObservation        = types.NewChainObservation
 	NewTssKeysignFailVoter     = types.NewTssKeysignFailVoter
+	NewStreamingSwap           = types.NewStreamingSwap
+	NewLimitOrder              = types.NewLimitOrder
+	NewPoolSnapshot            = types.NewPoolSnapshot
+	NewPoolPriceAccumulator    = types.NewPoolPriceAccumulator
+	NewPoolPriceCheckpoint     = types.NewPoolPriceCheckpoint
+	NewPoolSuspension          = types.NewPoolSuspension
 )
 
 type (
 	MsgSwap                 = types.MsgSwap
+	StreamingSwap           = types.StreamingSwap
+	LimitOrder              = types.LimitOrder
+	LimitOrders             = types.LimitOrders
+	LimitOrderPair          = types.LimitOrderPair
+	PoolSnapshot            = types.PoolSnapshot
+	PoolPriceAccumulator    = types.PoolPriceAccumulator
+	PoolPriceCheckpoint     = types.PoolPriceCheckpoint
+	PoolSuspension          = types.PoolSuspension
 	Pool                    = types.Pool
 	Pools                   = types.Pools
 	Staker                  = types.Staker
@@ -75,6 +101,8 @@ type (
 	Vault                   = types.Vault
 	Vaults                  = types.Vaults
 	Jail                    = types.Jail
+	BondProviders           = types.BondProviders
+	NodeMaintenance         = types.NodeMaintenance
 	NodeAccount             = types.NodeAccount
 	NodeAccounts            = types.NodeAccounts
 	NodeStatus              = types.NodeStatus
@@ -84,5 +112,11 @@ type (
 	TxMarkers               = types.TxMarkers
 	NetworkFee              = types.NetworkFee
 	ObservedNetworkFeeVoter = types.ObservedNetworkFeeVoter
+	SolvencyVoter           = types.SolvencyVoter
+	VaultSolvency           = types.VaultSolvency
+	ChainHalt               = types.ChainHalt
+	OutboundValue           = types.OutboundValue
+	SlashRecord             = types.SlashRecord
+	ChainObservation        = types.ChainObservation
 	RagnarokUnstakePosition = types.RagnarokUnstakePosition
 )
@@ -30,7 +30,13 @@ const (
 	prefixTxOut              kvTypes.DbPrefix = "txout/"
 	prefixTotalLiquidityFee  kvTypes.DbPrefix = "total_liquidity_fee/"
 	prefixPoolLiquidityFee   kvTypes.DbPrefix = "pool_liquidity_fee/"
+	prefixPoolSnapshot       kvTypes.DbPrefix = "pool_snapshot/"
+	prefixPoolPrice          kvTypes.DbPrefix = "pool_price/"
+	prefixPriceCheckpoint    kvTypes.DbPrefix = "pool_price_checkpoint/"
+	prefixPoolSuspension     kvTypes.DbPrefix = "pool_suspension/"
 	prefixStaker             kvTypes.DbPrefix = "staker/"
+	prefixStakerIndex        kvTypes.DbPrefix = "staker_index/"
+	prefixStakerPending      kvTypes.DbPrefix = "staker_pending/"
 	prefixLastChainHeight    kvTypes.DbPrefix = "last_chain_height/"
 	prefixLastSignedHeight   kvTypes.DbPrefix = "last_signed_height/"
 	prefixNodeAccount        kvTypes.DbPrefix = "node_account/"
@@ -53,9 +59,25 @@ const (
 	prefixNodeSlashPoints    kvTypes.DbPrefix = "slash/"
 	prefixNodeJail           kvTypes.DbPrefix = "jail/"
 	prefixSwapQueueItem      kvTypes.DbPrefix = "swapitem/"
+	prefixStreamingSwap      kvTypes.DbPrefix = "streaming_swap/"
+	prefixLimitOrder         kvTypes.DbPrefix = "limit_order/"
+	prefixLimitOrderPair     kvTypes.DbPrefix = "limit_order_pair/"
+	prefixLimitOrderRate     kvTypes.DbPrefix = "limit_order_rate/"
+	prefixLimitOrderExpiry   kvTypes.DbPrefix = "limit_order_expiry/"
 	prefixMimir              kvTypes.DbPrefix = "mimir/"
 	prefixNetworkFee         kvTypes.DbPrefix = "network_fee/"
 	prefixNetworkFeeVoter    kvTypes.DbPrefix = "network_fee_voter/"
+	prefixSolvencyVoter      kvTypes.DbPrefix = "solvency_voter/"
+	prefixVaultSolvency      kvTypes.DbPrefix = "vault_solvency/"
+	prefixChainHalt          kvTypes.DbPrefix = "chain_halt/"
+	prefixOutboundValue      kvTypes.DbPrefix = "outbound_value/"
+	prefixScheduledOutbound  kvTypes.DbPrefix = "scheduled_outbound/"
+	prefixSlashRecord        kvTypes.DbPrefix = "slash_record/"
+	prefixSlashRecordHeight  kvTypes.DbPrefix = "slash_record_height/"
+	prefixChainObserving     kvTypes.DbPrefix = "chain_observing_addresses/"
+	prefixChainObservation   kvTypes.DbPrefix = "chain_observation/"
+	prefixBondProviders      kvTypes.DbPrefix = "bond_providers/"
+	prefixNodeMaintenance    kvTypes.DbPrefix = "node_maintenance/"
 )
 
 func dbError(ctx cosmos.Context, wrapper string, err error) error {
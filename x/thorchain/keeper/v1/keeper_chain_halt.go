@@ -0,0 +1,28 @@
+package keeperv1
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// GetChainHaltIterator iterate the halted chains
+func (k KVStore) GetChainHaltIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixChainHalt)
+}
+
+// GetChainHalt retrieve the halt of the given chain from the kv store, an empty record is returned when trading on the chain is not halted
+func (k KVStore) GetChainHalt(ctx cosmos.Context, chain common.Chain) (ChainHalt, error) {
+	record := ChainHalt{}
+	_, err := k.get(ctx, k.GetKey(ctx, prefixChainHalt, chain.String()), &record)
+	return record, err
+}
+
+// SetChainHalt save the chain halt to kv store
+func (k KVStore) SetChainHalt(ctx cosmos.Context, halt ChainHalt) {
+	k.set(ctx, k.GetKey(ctx, prefixChainHalt, halt.Chain.String()), halt)
+}
+
+// RemoveChainHalt remove the halt of the given chain from kv store
+func (k KVStore) RemoveChainHalt(ctx cosmos.Context, chain common.Chain) {
+	k.del(ctx, k.GetKey(ctx, prefixChainHalt, chain.String()))
+}
@@ -0,0 +1,60 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperChainHaltSuite struct{}
+
+var _ = Suite(&KeeperChainHaltSuite{})
+
+func (s *KeeperChainHaltSuite) TestChainHalt(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	halt, err := k.GetChainHalt(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halt.IsEmpty(), Equals, true)
+
+	k.SetChainHalt(ctx, NewChainHalt(common.BNBChain, 10, "insolvency"))
+	halt, err = k.GetChainHalt(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halt.Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(halt.Height, Equals, int64(10))
+	c.Check(halt.Reason, Equals, "insolvency")
+	iter := k.GetChainHaltIterator(ctx)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+
+	k.RemoveChainHalt(ctx, common.BNBChain)
+	halt, err = k.GetChainHalt(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(halt.IsEmpty(), Equals, true)
+}
+
+func (s *KeeperChainHaltSuite) TestOutboundValue(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	for height := int64(1); height <= 10; height++ {
+		k.SetOutboundValue(ctx, NewOutboundValue(common.BNBChain, height, cosmos.NewUint(uint64(height))))
+	}
+	k.SetOutboundValue(ctx, NewOutboundValue(common.BTCChain, 5, cosmos.NewUint(100)))
+
+	values, err := k.GetOutboundValues(ctx, common.BNBChain, 3, 6)
+	c.Assert(err, IsNil)
+	c.Assert(values, HasLen, 4)
+	c.Check(values[0].Height, Equals, int64(3))
+	c.Check(values[3].Height, Equals, int64(6))
+	values, err = k.GetOutboundValues(ctx, common.BNBChain, 6, 3)
+	c.Assert(err, IsNil)
+	c.Check(values, HasLen, 0)
+
+	k.PruneOutboundValues(ctx, common.BNBChain, 8)
+	values, err = k.GetOutboundValues(ctx, common.BNBChain, 0, 100)
+	c.Assert(err, IsNil)
+	c.Assert(values, HasLen, 3)
+	c.Check(values[0].Height, Equals, int64(8))
+	values, err = k.GetOutboundValues(ctx, common.BTCChain, 0, 100)
+	c.Assert(err, IsNil)
+	c.Check(values, HasLen, 1)
+}
@@ -0,0 +1,128 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	kvTypes "gitlab.com/thorchain/thornode/x/thorchain/keeper/types"
+)
+
+// GetLimitOrderIterator iterate limit orders
+func (k KVStore) GetLimitOrderIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixLimitOrder)
+}
+
+// GetLimitOrder retrieve the limit order of the given tx id from the kv store, an empty record is returned when it doesn't exist
+func (k KVStore) GetLimitOrder(ctx cosmos.Context, txID common.TxID) (LimitOrder, error) {
+	record := LimitOrder{
+		MinOut: cosmos.ZeroUint(),
+	}
+	_, err := k.get(ctx, k.GetKey(ctx, prefixLimitOrder, txID.String()), &record)
+	return record, err
+}
+
+// getLimitOrderPairKey pair of assets that has at least one open limit order
+func (k KVStore) getLimitOrderPairKey(ctx cosmos.Context, source, target common.Asset) string {
+	return k.GetKey(ctx, prefixLimitOrderPair, fmt.Sprintf("%s/%s", source, target))
+}
+
+// getLimitOrderRatePrefix all the limit orders of a pair , rates are encoded as fixed width hex , so the orders iterate from
+// the lowest rate
+func (k KVStore) getLimitOrderRatePrefix(ctx cosmos.Context, source, target common.Asset) string {
+	return k.GetKey(ctx, prefixLimitOrderRate, fmt.Sprintf("%s/%s/", source, target))
+}
+
+func (k KVStore) getLimitOrderRateKey(ctx cosmos.Context, order LimitOrder) string {
+	return fmt.Sprintf("%s%064x/%s", k.getLimitOrderRatePrefix(ctx, order.Source(), order.TargetAsset), order.Rate().BigInt(), order.Tx.ID)
+}
+
+// getLimitOrderExpiryKey heights are zero padded , so the limit orders iterate in expiry order
+func (k KVStore) getLimitOrderExpiryKey(ctx cosmos.Context, height int64, txID common.TxID) string {
+	return k.GetKey(ctx, prefixLimitOrderExpiry, fmt.Sprintf("%020d/%s", height, txID))
+}
+
+// SetLimitOrder save the limit order to kv store , the order is indexed by its rate and its expiry height
+func (k KVStore) SetLimitOrder(ctx cosmos.Context, order LimitOrder) {
+	k.set(ctx, k.GetKey(ctx, prefixLimitOrder, order.Tx.ID.String()), order)
+	k.set(ctx, k.getLimitOrderRateKey(ctx, order), order.Tx.ID)
+	k.set(ctx, k.getLimitOrderExpiryKey(ctx, order.ExpiryHeight, order.Tx.ID), order.Tx.ID)
+	pairKey := k.getLimitOrderPairKey(ctx, order.Source(), order.TargetAsset)
+	if !k.has(ctx, pairKey) {
+		k.set(ctx, pairKey, LimitOrderPair{Source: order.Source(), Target: order.TargetAsset})
+	}
+}
+
+// LimitOrderExists check whether the limit order of the given tx id exist in the kv store
+func (k KVStore) LimitOrderExists(ctx cosmos.Context, txID common.TxID) bool {
+	return k.has(ctx, k.GetKey(ctx, prefixLimitOrder, txID.String()))
+}
+
+// RemoveLimitOrder remove the limit order and its indexes from kv store
+func (k KVStore) RemoveLimitOrder(ctx cosmos.Context, txID common.TxID) {
+	order, err := k.GetLimitOrder(ctx, txID)
+	if err != nil {
+		ctx.Logger().Error("fail to get limit order", "tx id", txID, "error", err)
+	}
+	k.del(ctx, k.GetKey(ctx, prefixLimitOrder, txID.String()))
+	if order.IsEmpty() {
+		return
+	}
+	k.del(ctx, k.getLimitOrderRateKey(ctx, order))
+	k.del(ctx, k.getLimitOrderExpiryKey(ctx, order.ExpiryHeight, order.Tx.ID))
+	iter := k.getIterator(ctx, kvTypes.DbPrefix(k.getLimitOrderRatePrefix(ctx, order.Source(), order.TargetAsset)))
+	empty := !iter.Valid()
+	iter.Close()
+	if empty {
+		k.del(ctx, k.getLimitOrderPairKey(ctx, order.Source(), order.TargetAsset))
+	}
+}
+
+// GetLimitOrderPairs return the pairs of assets that have open limit orders
+func (k KVStore) GetLimitOrderPairs(ctx cosmos.Context) ([]LimitOrderPair, error) {
+	iter := k.getIterator(ctx, prefixLimitOrderPair)
+	defer iter.Close()
+	pairs := make([]LimitOrderPair, 0)
+	for ; iter.Valid(); iter.Next() {
+		var pair LimitOrderPair
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &pair); err != nil {
+			return nil, dbError(ctx, "Unmarshal: limit order pair", err)
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs, nil
+}
+
+// GetLimitOrdersByRate return at most limit open orders swapping source to target , starting from the one asking for the
+// lowest rate
+func (k KVStore) GetLimitOrdersByRate(ctx cosmos.Context, source, target common.Asset, limit int) (LimitOrders, error) {
+	return k.getIndexedLimitOrders(ctx, k.getIterator(ctx, kvTypes.DbPrefix(k.getLimitOrderRatePrefix(ctx, source, target))), limit)
+}
+
+// GetExpiredLimitOrders return at most limit open orders that expire on or before the given height
+func (k KVStore) GetExpiredLimitOrders(ctx cosmos.Context, height int64, limit int) (LimitOrders, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.GetKey(ctx, prefixLimitOrderExpiry, "")), []byte(k.GetKey(ctx, prefixLimitOrderExpiry, fmt.Sprintf("%020d", height+1))))
+	return k.getIndexedLimitOrders(ctx, iter, limit)
+}
+
+// getIndexedLimitOrders return at most limit orders pointed by the index iterator , the iterator is closed
+func (k KVStore) getIndexedLimitOrders(ctx cosmos.Context, iter cosmos.Iterator, limit int) (LimitOrders, error) {
+	defer iter.Close()
+	orders := make(LimitOrders, 0)
+	for ; iter.Valid() && len(orders) < limit; iter.Next() {
+		var txID common.TxID
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &txID); err != nil {
+			return nil, dbError(ctx, "Unmarshal: limit order index", err)
+		}
+		order, err := k.GetLimitOrder(ctx, txID)
+		if err != nil {
+			return nil, err
+		}
+		if order.IsEmpty() {
+			continue
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
@@ -0,0 +1,95 @@
+package keeperv1
+
+import (
+	"math/big"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperLimitOrderSuite struct{}
+
+var _ = Suite(&KeeperLimitOrderSuite{})
+
+func (s *KeeperLimitOrderSuite) TestKeeperLimitOrder(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// not found
+	txID := GetRandomTxHash()
+	c.Check(k.LimitOrderExists(ctx, txID), Equals, false)
+	order, err := k.GetLimitOrder(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(order.IsEmpty(), Equals, true)
+
+	tx := GetRandomTx()
+	tx.ID = txID
+	order = NewLimitOrder(tx, common.RuneAsset(), GetRandomBNBAddress(), cosmos.NewUint(100), 1, 10)
+	k.SetLimitOrder(ctx, order)
+	c.Check(k.LimitOrderExists(ctx, txID), Equals, true)
+	order2, err := k.GetLimitOrder(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(order2.Tx.ID.Equals(txID), Equals, true)
+	c.Check(order2.MinOut.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(order2.ExpiryHeight, Equals, int64(10))
+
+	iter := k.GetLimitOrderIterator(ctx)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+
+	pairs, err := k.GetLimitOrderPairs(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(pairs, HasLen, 1)
+	c.Check(pairs[0].Source.Equals(tx.Coins[0].Asset), Equals, true)
+	c.Check(pairs[0].Target.IsRune(), Equals, true)
+
+	// orders of a pair are returned from the lowest rate
+	tx2 := tx
+	tx2.ID = GetRandomTxHash()
+	k.SetLimitOrder(ctx, NewLimitOrder(tx2, common.RuneAsset(), GetRandomBNBAddress(), cosmos.NewUint(50), 1, 20))
+	orders, err := k.GetLimitOrdersByRate(ctx, tx.Coins[0].Asset, common.RuneAsset(), 10)
+	c.Assert(err, IsNil)
+	c.Assert(orders, HasLen, 2)
+	c.Check(orders[0].Tx.ID.Equals(tx2.ID), Equals, true)
+	c.Check(orders[1].Tx.ID.Equals(txID), Equals, true)
+	orders, err = k.GetLimitOrdersByRate(ctx, tx.Coins[0].Asset, common.RuneAsset(), 1)
+	c.Assert(err, IsNil)
+	c.Check(orders, HasLen, 1)
+	// a rate wider than 40 digits still sort after the lower ones
+	tx3 := tx
+	tx3.ID = GetRandomTxHash()
+	k.SetLimitOrder(ctx, NewLimitOrder(tx3, common.RuneAsset(), GetRandomBNBAddress(), cosmos.NewUintFromBigInt(new(big.Int).Lsh(big.NewInt(1), 150)), 1, 20))
+	orders, err = k.GetLimitOrdersByRate(ctx, tx.Coins[0].Asset, common.RuneAsset(), 10)
+	c.Assert(err, IsNil)
+	c.Assert(orders, HasLen, 3)
+	c.Check(orders[2].Tx.ID.Equals(tx3.ID), Equals, true)
+	k.RemoveLimitOrder(ctx, tx3.ID)
+	orders, err = k.GetLimitOrdersByRate(ctx, common.RuneAsset(), tx.Coins[0].Asset, 10)
+	c.Assert(err, IsNil)
+	c.Check(orders, HasLen, 0)
+
+	// orders expire by height
+	orders, err = k.GetExpiredLimitOrders(ctx, 9, 10)
+	c.Assert(err, IsNil)
+	c.Check(orders, HasLen, 0)
+	orders, err = k.GetExpiredLimitOrders(ctx, 10, 10)
+	c.Assert(err, IsNil)
+	c.Assert(orders, HasLen, 1)
+	c.Check(orders[0].Tx.ID.Equals(txID), Equals, true)
+
+	k.RemoveLimitOrder(ctx, txID)
+	c.Check(k.LimitOrderExists(ctx, txID), Equals, false)
+	orders, err = k.GetExpiredLimitOrders(ctx, 100, 10)
+	c.Assert(err, IsNil)
+	c.Check(orders, HasLen, 1)
+	pairs, err = k.GetLimitOrderPairs(ctx)
+	c.Assert(err, IsNil)
+	c.Check(pairs, HasLen, 1)
+
+	// the pair is gone with its last order
+	k.RemoveLimitOrder(ctx, tx2.ID)
+	pairs, err = k.GetLimitOrderPairs(ctx)
+	c.Assert(err, IsNil)
+	c.Check(pairs, HasLen, 0)
+}
@@ -269,3 +269,19 @@ func (k KVStore) SetNodeAccountJail(ctx cosmos.Context, addr cosmos.AccAddress,
 	k.set(ctx, k.GetKey(ctx, prefixNodeJail, addr.String()), jail)
 	return nil
 }
+
+// GetBondProviders - gets the bond providers whitelisted by the operator of the given node account
+func (k KVStore) GetBondProviders(ctx cosmos.Context, addr cosmos.AccAddress) (BondProviders, error) {
+	record := NewBondProviders(addr)
+	_, err := k.get(ctx, k.GetKey(ctx, prefixBondProviders, addr.String()), &record)
+	return record, err
+}
+
+// SetBondProviders - update the bond providers of a node account
+func (k KVStore) SetBondProviders(ctx cosmos.Context, bp BondProviders) error {
+	if err := bp.Valid(); err != nil {
+		return err
+	}
+	k.set(ctx, k.GetKey(ctx, prefixBondProviders, bp.NodeAddress.String()), bp)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
 type KeeperNodeAccountSuite struct{}
@@ -221,3 +222,25 @@ func (s *KeeperNodeAccountSuite) TestJail(c *C) {
 	c.Check(jail.ReleaseHeight, Equals, int64(70))
 	c.Check(jail.Reason, Equals, "bar")
 }
+
+func (s *KeeperNodeAccountSuite) TestBondProviders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+	bp, err := k.GetBondProviders(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeAddress.Equals(addr), Equals, true)
+	c.Check(bp.Providers, HasLen, 0)
+
+	provider := GetRandomBNBAddress()
+	bp.Bond(provider, cosmos.NewUint(100*common.One), cosmos.ZeroUint())
+	bp.NodeOperatorFee = 1000
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	bp, err = k.GetBondProviders(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(bp.NodeOperatorFee, Equals, int64(1000))
+	c.Assert(bp.Providers, HasLen, 1)
+	c.Check(bp.Get(provider).Units.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+
+	bp.NodeOperatorFee = -1
+	c.Check(k.SetBondProviders(ctx, bp), NotNil)
+}
@@ -0,0 +1,27 @@
+package keeperv1
+
+import (
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// GetNodeMaintenanceIterator iterate the maintenance records of node accounts
+func (k KVStore) GetNodeMaintenanceIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixNodeMaintenance)
+}
+
+// GetNodeMaintenance retrieve the latest maintenance of the given node account from the kv store, an empty record is
+// returned when the node account never requested maintenance
+func (k KVStore) GetNodeMaintenance(ctx cosmos.Context, addr cosmos.AccAddress) (NodeMaintenance, error) {
+	record := NodeMaintenance{}
+	_, err := k.get(ctx, k.GetKey(ctx, prefixNodeMaintenance, addr.String()), &record)
+	return record, err
+}
+
+// SetNodeMaintenance save the node maintenance to kv store
+func (k KVStore) SetNodeMaintenance(ctx cosmos.Context, maintenance NodeMaintenance) error {
+	if err := maintenance.Valid(); err != nil {
+		return err
+	}
+	k.set(ctx, k.GetKey(ctx, prefixNodeMaintenance, maintenance.NodeAddress.String()), maintenance)
+	return nil
+}
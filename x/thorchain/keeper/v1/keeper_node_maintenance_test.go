@@ -0,0 +1,28 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type KeeperNodeMaintenanceSuite struct{}
+
+var _ = Suite(&KeeperNodeMaintenanceSuite{})
+
+func (s *KeeperNodeMaintenanceSuite) TestNodeMaintenance(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+	maintenance, err := k.GetNodeMaintenance(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(maintenance.IsEmpty(), Equals, true)
+
+	c.Check(k.SetNodeMaintenance(ctx, NewNodeMaintenance(addr, 0, 10)), NotNil)
+	c.Assert(k.SetNodeMaintenance(ctx, NewNodeMaintenance(addr, 5, 10)), IsNil)
+	maintenance, err = k.GetNodeMaintenance(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(maintenance.NodeAddress.Equals(addr), Equals, true)
+	c.Check(maintenance.StartHeight, Equals, int64(5))
+	c.Check(maintenance.EndHeight, Equals, int64(10))
+	iter := k.GetNodeMaintenanceIterator(ctx)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+}
@@ -1,6 +1,11 @@
 package keeperv1
 
-import "gitlab.com/thorchain/thornode/common/cosmos"
+import (
+	"strings"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
 
 // GetObservingAddresses - get list of observed addresses. This is a list of
 // addresses that have recently contributed via observing a tx that got 2/3rds
@@ -23,9 +28,104 @@ func (k KVStore) AddObservingAddresses(ctx cosmos.Context, inAddresses []cosmos.
 	if err != nil {
 		return err
 	}
-	all := append(curr, inAddresses...)
 
-	// ensure uniqueness
+	k.set(ctx, k.GetKey(ctx, prefixObservingAddresses, ""), uniqueAddresses(append(curr, inAddresses...)))
+	return nil
+}
+
+// ClearObservingAddresses - clear all observing addresses , include the observing addresses of each chain
+func (k KVStore) ClearObservingAddresses(ctx cosmos.Context) {
+	k.del(ctx, k.GetKey(ctx, prefixObservingAddresses, ""))
+
+	var keys [][]byte
+	iter := k.getIterator(ctx, prefixChainObserving)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetChainObservingAddresses - get list of addresses that have contributed to observe a tx of the given chain
+// that got 2/3rds majority
+func (k KVStore) GetChainObservingAddresses(ctx cosmos.Context, chain common.Chain) ([]cosmos.AccAddress, error) {
+	record := make([]cosmos.AccAddress, 0)
+	_, err := k.get(ctx, k.GetKey(ctx, prefixChainObserving, chain.String()), &record)
+	return record, err
+}
+
+// AddChainObservingAddresses - add a list of addresses that have been helpful in getting enough observations
+// to process a tx of the given chain
+func (k KVStore) AddChainObservingAddresses(ctx cosmos.Context, chain common.Chain, inAddresses []cosmos.AccAddress) error {
+	if len(inAddresses) == 0 {
+		return nil
+	}
+	curr, err := k.GetChainObservingAddresses(ctx, chain)
+	if err != nil {
+		return err
+	}
+	k.set(ctx, k.GetKey(ctx, prefixChainObserving, chain.String()), uniqueAddresses(append(curr, inAddresses...)))
+	return nil
+}
+
+// GetObservedChains - get the chains that have observing addresses , which are the chains reached consensus on a tx
+func (k KVStore) GetObservedChains(ctx cosmos.Context) (common.Chains, error) {
+	var chains common.Chains
+	keyPrefix := k.GetKey(ctx, prefixChainObserving, "")
+	iter := k.getIterator(ctx, prefixChainObserving)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		chain, err := common.NewChain(strings.TrimPrefix(string(iter.Key()), keyPrefix))
+		if err != nil {
+			return nil, dbError(ctx, "fail to parse chain", err)
+		}
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+// getChainObservationKey chain observations are keyed by node address first , so the chains of a node account iterate together
+func (k KVStore) getChainObservationKey(ctx cosmos.Context, addr cosmos.AccAddress, chain common.Chain) string {
+	return k.GetKey(ctx, prefixChainObservation, addr.String()+"/"+chain.String())
+}
+
+// GetChainObservation - get how well the given node account observe the given chain
+func (k KVStore) GetChainObservation(ctx cosmos.Context, addr cosmos.AccAddress, chain common.Chain) (ChainObservation, error) {
+	record := NewChainObservation(addr, chain)
+	_, err := k.get(ctx, k.getChainObservationKey(ctx, addr, chain), &record)
+	return record, err
+}
+
+// SetChainObservation - save the given chain observation
+func (k KVStore) SetChainObservation(ctx cosmos.Context, observation ChainObservation) error {
+	if err := observation.Valid(); err != nil {
+		return err
+	}
+	k.set(ctx, k.getChainObservationKey(ctx, observation.NodeAddress, observation.Chain), observation)
+	return nil
+}
+
+// GetChainObservations - get how well the given node account observe each chain
+func (k KVStore) GetChainObservations(ctx cosmos.Context, addr cosmos.AccAddress) ([]ChainObservation, error) {
+	result := make([]ChainObservation, 0)
+	store := ctx.KVStore(k.storeKey)
+	iter := cosmos.KVStorePrefixIterator(store, []byte(k.GetKey(ctx, prefixChainObservation, addr.String()+"/")))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var observation ChainObservation
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &observation); err != nil {
+			return nil, dbError(ctx, "Unmarshal: chain observation", err)
+		}
+		result = append(result, observation)
+	}
+	return result, nil
+}
+
+// uniqueAddresses remove the duplicate addresses , keep the order of the first occurrence
+func uniqueAddresses(all []cosmos.AccAddress) []cosmos.AccAddress {
 	uniq := make([]cosmos.AccAddress, 0, len(all))
 	m := make(map[string]bool)
 	for _, val := range all {
@@ -34,12 +134,5 @@ func (k KVStore) AddObservingAddresses(ctx cosmos.Context, inAddresses []cosmos.
 			uniq = append(uniq, val)
 		}
 	}
-
-	k.set(ctx, k.GetKey(ctx, prefixObservingAddresses, ""), uniq)
-	return nil
-}
-
-// ClearObservingAddresses - clear all observing addresses
-func (k KVStore) ClearObservingAddresses(ctx cosmos.Context) {
-	k.del(ctx, k.GetKey(ctx, prefixObservingAddresses, ""))
+	return uniq
 }
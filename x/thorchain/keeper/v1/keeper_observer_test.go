@@ -3,6 +3,7 @@ package keeperv1
 import (
 	. "gopkg.in/check.v1"
 
+	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
@@ -26,3 +27,51 @@ func (s *KeeperObserverSuite) TestObserver(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(addrs, HasLen, 0)
 }
+
+func (s *KeeperObserverSuite) TestChainObserver(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	addr1 := GetRandomBech32Addr()
+	addr2 := GetRandomBech32Addr()
+	c.Assert(k.AddChainObservingAddresses(ctx, common.BNBChain, []cosmos.AccAddress{addr1, addr2}), IsNil)
+	c.Assert(k.AddChainObservingAddresses(ctx, common.BNBChain, []cosmos.AccAddress{addr1}), IsNil)
+	c.Assert(k.AddChainObservingAddresses(ctx, common.BTCChain, []cosmos.AccAddress{addr1}), IsNil)
+	addrs, err := k.GetChainObservingAddresses(ctx, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Assert(addrs, HasLen, 2)
+	chains, err := k.GetObservedChains(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(chains, HasLen, 2)
+	c.Check(chains.Has(common.BNBChain), Equals, true)
+	c.Check(chains.Has(common.BTCChain), Equals, true)
+
+	k.ClearObservingAddresses(ctx)
+	chains, err = k.GetObservedChains(ctx)
+	c.Assert(err, IsNil)
+	c.Check(chains, HasLen, 0)
+	addrs, err = k.GetChainObservingAddresses(ctx, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(addrs, HasLen, 0)
+}
+
+func (s *KeeperObserverSuite) TestChainObservation(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	addr := GetRandomBech32Addr()
+	observation, err := k.GetChainObservation(ctx, addr, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(observation.Total, Equals, int64(0))
+	observation.Total = 4
+	observation.Missed = 1
+	c.Assert(k.SetChainObservation(ctx, observation), IsNil)
+	c.Assert(k.SetChainObservation(ctx, NewChainObservation(addr, common.BNBChain)), IsNil)
+	c.Assert(k.SetChainObservation(ctx, NewChainObservation(GetRandomBech32Addr(), common.BNBChain)), IsNil)
+	c.Check(k.SetChainObservation(ctx, NewChainObservation(addr, common.EmptyChain)), NotNil)
+
+	observation, err = k.GetChainObservation(ctx, addr, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(observation.Missed, Equals, int64(1))
+	observations, err := k.GetChainObservations(ctx, addr)
+	c.Assert(err, IsNil)
+	c.Check(observations, HasLen, 2)
+}
@@ -0,0 +1,59 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	kvTypes "gitlab.com/thorchain/thornode/x/thorchain/keeper/types"
+)
+
+// getOutboundValueKey heights are zero padded , so the outbound values of a chain iterate in height order
+func (k KVStore) getOutboundValueKey(ctx cosmos.Context, chain common.Chain, height int64) string {
+	return k.GetKey(ctx, prefixOutboundValue, fmt.Sprintf("%s/%020d", chain.String(), height))
+}
+
+// SetOutboundValue save the outbound value of a chain in a block to kv store
+func (k KVStore) SetOutboundValue(ctx cosmos.Context, value OutboundValue) {
+	k.set(ctx, k.getOutboundValueKey(ctx, value.Chain, value.Height), value)
+}
+
+// GetOutboundValues return the outbound values of the given chain between from and to height (inclusive) , ordered by height
+func (k KVStore) GetOutboundValues(ctx cosmos.Context, chain common.Chain, from, to int64) ([]OutboundValue, error) {
+	values := make([]OutboundValue, 0)
+	if from < 0 || to < from {
+		return values, nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.getOutboundValueKey(ctx, chain, from)), []byte(k.getOutboundValueKey(ctx, chain, to+1)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var value OutboundValue
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &value); err != nil {
+			return nil, dbError(ctx, "Unmarshal: outbound value", err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// PruneOutboundValues remove the outbound values of the given chain recorded before the given height
+func (k KVStore) PruneOutboundValues(ctx cosmos.Context, chain common.Chain, before int64) {
+	var keys [][]byte
+	iter := k.getIterator(ctx, kvTypes.DbPrefix(k.GetKey(ctx, prefixOutboundValue, chain.String()+"/")))
+	for ; iter.Valid(); iter.Next() {
+		var value OutboundValue
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &value); err != nil {
+			ctx.Logger().Error("fail to unmarshal outbound value", "error", err)
+			continue
+		}
+		if value.Height >= before {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		k.del(ctx, string(key))
+	}
+}
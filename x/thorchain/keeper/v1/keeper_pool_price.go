@@ -0,0 +1,92 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+func (k KVStore) getPoolPriceCheckpointKey(ctx cosmos.Context, asset common.Asset, height int64) string {
+	return k.GetKey(ctx, prefixPriceCheckpoint, fmt.Sprintf("%s/%020d", asset.String(), height))
+}
+
+// GetPoolPriceAccumulator retrieve the price accumulator of the given pool, a new accumulator is returned when it doesn't exist
+func (k KVStore) GetPoolPriceAccumulator(ctx cosmos.Context, asset common.Asset) (PoolPriceAccumulator, error) {
+	record := NewPoolPriceAccumulator(asset)
+	_, err := k.get(ctx, k.GetKey(ctx, prefixPoolPrice, asset.String()), &record)
+	return record, err
+}
+
+// SetPoolPriceAccumulator save the pool price accumulator to kv store
+func (k KVStore) SetPoolPriceAccumulator(ctx cosmos.Context, acc PoolPriceAccumulator) {
+	k.set(ctx, k.GetKey(ctx, prefixPoolPrice, acc.Asset.String()), acc)
+}
+
+// SetPoolPriceCheckpoint save the pool price checkpoint to kv store
+func (k KVStore) SetPoolPriceCheckpoint(ctx cosmos.Context, checkpoint PoolPriceCheckpoint) {
+	k.set(ctx, k.getPoolPriceCheckpointKey(ctx, checkpoint.Asset, checkpoint.Height), checkpoint)
+}
+
+// getLatestPoolPriceCheckpoint retrieve the latest price checkpoint of the given pool at or before the given height ,
+// an empty record is returned when there is none
+func (k KVStore) getLatestPoolPriceCheckpoint(ctx cosmos.Context, asset common.Asset, height int64) (PoolPriceCheckpoint, error) {
+	record := PoolPriceCheckpoint{
+		Asset:           asset,
+		CumulativePrice: cosmos.ZeroUint(),
+	}
+	if height <= 0 {
+		return record, nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator([]byte(k.getPoolPriceCheckpointKey(ctx, asset, 0)), []byte(k.getPoolPriceCheckpointKey(ctx, asset, height+1)))
+	defer iter.Close()
+	if !iter.Valid() {
+		return record, nil
+	}
+	if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &record); err != nil {
+		return record, dbError(ctx, "Unmarshal: pool price checkpoint", err)
+	}
+	return record, nil
+}
+
+// PrunePoolPriceCheckpoints remove the price checkpoints of the given pool taken before the given height
+func (k KVStore) PrunePoolPriceCheckpoints(ctx cosmos.Context, asset common.Asset, before int64) {
+	if before <= 0 {
+		return
+	}
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.getPoolPriceCheckpointKey(ctx, asset, 0)), []byte(k.getPoolPriceCheckpointKey(ctx, asset, before)))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		k.del(ctx, string(key))
+	}
+}
+
+// GetPoolTWAP return the time weighted average price of one asset in RUNE (1e8 precision) over at least the last window
+// blocks , taken from the latest price checkpoint at or before the start of the window. Zero is returned when the pool
+// doesn't have enough price history to cover the window.
+func (k KVStore) GetPoolTWAP(ctx cosmos.Context, asset common.Asset, window int64) (cosmos.Uint, error) {
+	if window <= 0 {
+		return cosmos.ZeroUint(), nil
+	}
+	acc, err := k.GetPoolPriceAccumulator(ctx, asset)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	if acc.IsEmpty() || acc.LastHeight-window < acc.FirstHeight {
+		return cosmos.ZeroUint(), nil
+	}
+	checkpoint, err := k.getLatestPoolPriceCheckpoint(ctx, asset, acc.LastHeight-window)
+	if err != nil {
+		return cosmos.ZeroUint(), err
+	}
+	if checkpoint.IsEmpty() {
+		return cosmos.ZeroUint(), nil
+	}
+	return acc.AveragePrice(checkpoint.CumulativePrice, checkpoint.Height), nil
+}
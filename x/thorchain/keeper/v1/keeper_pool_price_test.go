@@ -0,0 +1,65 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperPoolPriceSuite struct{}
+
+var _ = Suite(&KeeperPoolPriceSuite{})
+
+func (s *KeeperPoolPriceSuite) TestGetPoolTWAP(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// no price yet
+	acc, err := k.GetPoolPriceAccumulator(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(acc.IsEmpty(), Equals, true)
+	twap, err := k.GetPoolTWAP(ctx, common.BNBAsset, 100)
+	c.Assert(err, IsNil)
+	c.Check(twap.IsZero(), Equals, true)
+
+	acc.Update(cosmos.NewUint(100), 10)
+	k.SetPoolPriceCheckpoint(ctx, NewPoolPriceCheckpoint(acc))
+	acc.Update(cosmos.NewUint(200), 50)
+	k.SetPoolPriceCheckpoint(ctx, NewPoolPriceCheckpoint(acc))
+	acc.Update(cosmos.NewUint(200), 100)
+	k.SetPoolPriceAccumulator(ctx, acc)
+	acc, err = k.GetPoolPriceAccumulator(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(acc.CumulativePrice.Equal(cosmos.NewUint(14000)), Equals, true)
+
+	// price 100 for 40 blocks , price 200 for 50 blocks
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 90)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(155))
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 50)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(200))
+	// the latest checkpoint before the window is used
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 60)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(155))
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 5)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(200))
+	// window goes beyond the first price
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 91)
+	c.Assert(err, IsNil)
+	c.Check(twap.IsZero(), Equals, true)
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 0)
+	c.Assert(err, IsNil)
+	c.Check(twap.IsZero(), Equals, true)
+
+	// the checkpoints before the window are pruned
+	k.PrunePoolPriceCheckpoints(ctx, common.BNBAsset, 50)
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 90)
+	c.Assert(err, IsNil)
+	c.Check(twap.IsZero(), Equals, true)
+	twap, err = k.GetPoolTWAP(ctx, common.BNBAsset, 50)
+	c.Assert(err, IsNil)
+	c.Check(twap.Uint64(), Equals, uint64(200))
+}
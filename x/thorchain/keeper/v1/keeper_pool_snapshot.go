@@ -0,0 +1,101 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	kvTypes "gitlab.com/thorchain/thornode/x/thorchain/keeper/types"
+)
+
+// getPoolSnapshotKey heights are zero padded , so the snapshots of a pool iterate in height order
+func (k KVStore) getPoolSnapshotKey(ctx cosmos.Context, asset common.Asset, height int64) string {
+	return k.GetKey(ctx, prefixPoolSnapshot, fmt.Sprintf("%s/%020d", asset.String(), height))
+}
+
+func (k KVStore) getPoolSnapshotIterator(ctx cosmos.Context, asset common.Asset) cosmos.Iterator {
+	return k.getIterator(ctx, kvTypes.DbPrefix(k.GetKey(ctx, prefixPoolSnapshot, asset.String()+"/")))
+}
+
+// SetPoolSnapshot save the pool snapshot to kv store
+func (k KVStore) SetPoolSnapshot(ctx cosmos.Context, snapshot PoolSnapshot) {
+	k.set(ctx, k.getPoolSnapshotKey(ctx, snapshot.Asset, snapshot.Height), snapshot)
+}
+
+// GetPoolSnapshot retrieve the snapshot of the given pool at the given height, an empty record is returned when it doesn't exist
+func (k KVStore) GetPoolSnapshot(ctx cosmos.Context, asset common.Asset, height int64) (PoolSnapshot, error) {
+	record := PoolSnapshot{
+		Asset:         asset,
+		BalanceRune:   cosmos.ZeroUint(),
+		BalanceAsset:  cosmos.ZeroUint(),
+		PoolUnits:     cosmos.ZeroUint(),
+		LiquidityFees: cosmos.ZeroUint(),
+	}
+	_, err := k.get(ctx, k.getPoolSnapshotKey(ctx, asset, height), &record)
+	return record, err
+}
+
+// GetLatestPoolSnapshot retrieve the latest snapshot of the given pool taken before the given height, an empty record is
+// returned when there is none
+func (k KVStore) GetLatestPoolSnapshot(ctx cosmos.Context, asset common.Asset, before int64) (PoolSnapshot, error) {
+	record := PoolSnapshot{
+		Asset:         asset,
+		BalanceRune:   cosmos.ZeroUint(),
+		BalanceAsset:  cosmos.ZeroUint(),
+		PoolUnits:     cosmos.ZeroUint(),
+		LiquidityFees: cosmos.ZeroUint(),
+	}
+	if before <= 0 {
+		return record, nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator([]byte(k.getPoolSnapshotKey(ctx, asset, 0)), []byte(k.getPoolSnapshotKey(ctx, asset, before)))
+	defer iter.Close()
+	if !iter.Valid() {
+		return record, nil
+	}
+	if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &record); err != nil {
+		return record, dbError(ctx, "Unmarshal: pool snapshot", err)
+	}
+	return record, nil
+}
+
+// GetPoolSnapshots return the snapshots of the given pool taken between from and to height (inclusive) , ordered by height
+func (k KVStore) GetPoolSnapshots(ctx cosmos.Context, asset common.Asset, from, to int64) ([]PoolSnapshot, error) {
+	snapshots := make([]PoolSnapshot, 0)
+	if from < 0 || to < from {
+		return snapshots, nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.getPoolSnapshotKey(ctx, asset, from)), []byte(k.getPoolSnapshotKey(ctx, asset, to+1)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var snapshot PoolSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &snapshot); err != nil {
+			return nil, dbError(ctx, "Unmarshal: pool snapshot", err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// PrunePoolSnapshots remove the snapshots of the given pool taken before the given height
+func (k KVStore) PrunePoolSnapshots(ctx cosmos.Context, asset common.Asset, before int64) {
+	var keys [][]byte
+	iter := k.getPoolSnapshotIterator(ctx, asset)
+	for ; iter.Valid(); iter.Next() {
+		var snapshot PoolSnapshot
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &snapshot); err != nil {
+			ctx.Logger().Error("fail to unmarshal pool snapshot", "error", err)
+			continue
+		}
+		if snapshot.Height >= before {
+			break
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		k.del(ctx, string(key))
+	}
+}
@@ -0,0 +1,71 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperPoolSnapshotSuite struct{}
+
+var _ = Suite(&KeeperPoolSnapshotSuite{})
+
+func (s *KeeperPoolSnapshotSuite) TestKeeperPoolSnapshot(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// not found
+	snapshot, err := k.GetPoolSnapshot(ctx, common.BNBAsset, 10)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.IsEmpty(), Equals, true)
+	c.Check(snapshot.LiquidityFees.IsZero(), Equals, true)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100)
+	pool.BalanceAsset = cosmos.NewUint(100)
+	for _, height := range []int64{100, 10, 90, 20} {
+		k.SetPoolSnapshot(ctx, NewPoolSnapshot(pool, height, cosmos.NewUint(uint64(height))))
+	}
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	k.SetPoolSnapshot(ctx, NewPoolSnapshot(btcPool, 50, cosmos.ZeroUint()))
+
+	snapshot, err = k.GetPoolSnapshot(ctx, common.BNBAsset, 10)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.Height, Equals, int64(10))
+	c.Check(snapshot.LiquidityFees.Equal(cosmos.NewUint(10)), Equals, true)
+
+	// latest snapshot before the given height
+	snapshot, err = k.GetLatestPoolSnapshot(ctx, common.BNBAsset, 90)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.Height, Equals, int64(20))
+	snapshot, err = k.GetLatestPoolSnapshot(ctx, common.BNBAsset, 1000)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.Height, Equals, int64(100))
+	snapshot, err = k.GetLatestPoolSnapshot(ctx, common.BNBAsset, 10)
+	c.Assert(err, IsNil)
+	c.Check(snapshot.IsEmpty(), Equals, true)
+	c.Check(snapshot.LiquidityFees.IsZero(), Equals, true)
+
+	// snapshots are ordered by height , and only include the given pool
+	snapshots, err := k.GetPoolSnapshots(ctx, common.BNBAsset, 0, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 4)
+	c.Check(snapshots[0].Height, Equals, int64(10))
+	c.Check(snapshots[1].Height, Equals, int64(20))
+	c.Check(snapshots[2].Height, Equals, int64(90))
+	c.Check(snapshots[3].Height, Equals, int64(100))
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BNBAsset, 20, 90)
+	c.Assert(err, IsNil)
+	c.Check(snapshots, HasLen, 2)
+
+	k.PrunePoolSnapshots(ctx, common.BNBAsset, 90)
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BNBAsset, 0, 1000)
+	c.Assert(err, IsNil)
+	c.Assert(snapshots, HasLen, 2)
+	c.Check(snapshots[0].Height, Equals, int64(90))
+	snapshots, err = k.GetPoolSnapshots(ctx, common.BTCAsset, 0, 1000)
+	c.Assert(err, IsNil)
+	c.Check(snapshots, HasLen, 1)
+}
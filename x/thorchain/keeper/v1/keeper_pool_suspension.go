@@ -0,0 +1,28 @@
+package keeperv1
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// GetPoolSuspensionIterator iterate pool suspensions
+func (k KVStore) GetPoolSuspensionIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixPoolSuspension)
+}
+
+// GetPoolSuspension retrieve the suspension of the given pool from the kv store, an empty record is returned when the pool is not suspended
+func (k KVStore) GetPoolSuspension(ctx cosmos.Context, asset common.Asset) (PoolSuspension, error) {
+	record := PoolSuspension{}
+	_, err := k.get(ctx, k.GetKey(ctx, prefixPoolSuspension, asset.String()), &record)
+	return record, err
+}
+
+// SetPoolSuspension save the pool suspension to kv store
+func (k KVStore) SetPoolSuspension(ctx cosmos.Context, suspension PoolSuspension) {
+	k.set(ctx, k.GetKey(ctx, prefixPoolSuspension, suspension.Asset.String()), suspension)
+}
+
+// RemovePoolSuspension remove the suspension of the given pool from kv store
+func (k KVStore) RemovePoolSuspension(ctx cosmos.Context, asset common.Asset) {
+	k.del(ctx, k.GetKey(ctx, prefixPoolSuspension, asset.String()))
+}
@@ -0,0 +1,41 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperPoolSuspensionSuite struct{}
+
+var _ = Suite(&KeeperPoolSuspensionSuite{})
+
+func (s *KeeperPoolSuspensionSuite) TestKeeperPoolSuspension(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// not found
+	suspension, err := k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.IsEmpty(), Equals, true)
+
+	k.SetPoolSuspension(ctx, NewPoolSuspension(common.BNBAsset, PoolEnabled, 10))
+	k.SetPoolSuspension(ctx, NewPoolSuspension(common.BTCAsset, PoolBootstrap, 12))
+	suspension, err = k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(suspension.PreviousStatus, Equals, PoolEnabled)
+	c.Check(suspension.SuspendedHeight, Equals, int64(10))
+
+	count := 0
+	iter := k.GetPoolSuspensionIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Check(count, Equals, 2)
+
+	k.RemovePoolSuspension(ctx, common.BNBAsset)
+	suspension, err = k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.IsEmpty(), Equals, true)
+}
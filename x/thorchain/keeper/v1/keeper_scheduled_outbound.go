@@ -0,0 +1,40 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// getScheduledOutboundKey heights are zero padded , so the scheduled outbounds iterate in the order they are released
+func (k KVStore) getScheduledOutboundKey(ctx cosmos.Context, height int64) string {
+	return k.GetKey(ctx, prefixScheduledOutbound, fmt.Sprintf("%020d", height))
+}
+
+// AppendScheduledOutbound schedule the given item to be released at the given height
+func (k KVStore) AppendScheduledOutbound(ctx cosmos.Context, height int64, item *TxOutItem) error {
+	block, err := k.GetScheduledOutbound(ctx, height)
+	if err != nil {
+		return err
+	}
+	block.TxArray = append(block.TxArray, item)
+	k.set(ctx, k.getScheduledOutboundKey(ctx, height), block)
+	return nil
+}
+
+// GetScheduledOutbound return the outbounds scheduled to be released at the given height
+func (k KVStore) GetScheduledOutbound(ctx cosmos.Context, height int64) (*TxOut, error) {
+	record := NewTxOut(height)
+	_, err := k.get(ctx, k.getScheduledOutboundKey(ctx, height), &record)
+	return record, err
+}
+
+// ClearScheduledOutbound remove the outbounds scheduled to be released at the given height
+func (k KVStore) ClearScheduledOutbound(ctx cosmos.Context, height int64) {
+	k.del(ctx, k.getScheduledOutboundKey(ctx, height))
+}
+
+// GetScheduledOutboundIterator iterate the scheduled outbounds by release height
+func (k KVStore) GetScheduledOutboundIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixScheduledOutbound)
+}
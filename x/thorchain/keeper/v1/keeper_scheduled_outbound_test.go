@@ -0,0 +1,50 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperScheduledOutboundSuite struct{}
+
+var _ = Suite(&KeeperScheduledOutboundSuite{})
+
+func (KeeperScheduledOutboundSuite) TestScheduledOutbound(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	item := &TxOutItem{
+		Chain:     common.BNBChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(k.AppendScheduledOutbound(ctx, 100, item), IsNil)
+	c.Assert(k.AppendScheduledOutbound(ctx, 100, item), IsNil)
+	c.Assert(k.AppendScheduledOutbound(ctx, 20, item), IsNil)
+
+	txOut, err := k.GetScheduledOutbound(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(txOut.Height, Equals, int64(100))
+	c.Check(txOut.TxArray, HasLen, 2)
+	// nothing at the current block
+	txOut, err = k.GetTxOut(ctx, common.BlockHeight(ctx))
+	c.Assert(err, IsNil)
+	c.Check(txOut.TxArray, HasLen, 0)
+
+	// iterate by release height
+	var heights []int64
+	iter := k.GetScheduledOutboundIterator(ctx)
+	for ; iter.Valid(); iter.Next() {
+		var txOut TxOut
+		c.Assert(k.Cdc().UnmarshalBinaryBare(iter.Value(), &txOut), IsNil)
+		heights = append(heights, txOut.Height)
+	}
+	iter.Close()
+	c.Check(heights, DeepEquals, []int64{20, 100})
+
+	k.ClearScheduledOutbound(ctx, 100)
+	txOut, err = k.GetScheduledOutbound(ctx, 100)
+	c.Assert(err, IsNil)
+	c.Check(txOut.TxArray, HasLen, 0)
+}
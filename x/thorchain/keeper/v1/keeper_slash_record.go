@@ -0,0 +1,95 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// getSlashRecordKey heights are zero padded , so the slash records of a node account iterate in height order
+func (k KVStore) getSlashRecordKey(ctx cosmos.Context, addr cosmos.AccAddress, height int64) string {
+	return k.GetKey(ctx, prefixSlashRecord, fmt.Sprintf("%s/%020d", addr.String(), height))
+}
+
+// getSlashRecordHeightKey index the slash records by height , so old records can be pruned without going through every node account
+func (k KVStore) getSlashRecordHeightKey(ctx cosmos.Context, height int64, addr cosmos.AccAddress) string {
+	return k.GetKey(ctx, prefixSlashRecordHeight, fmt.Sprintf("%020d/%s", height, addr.String()))
+}
+
+// AppendSlashRecord add the given record to the slash ledger of the node account , records of the same block with the same reason , tx ,
+// vault and chain are aggregated into one entry , the entry is removed once its points and bond add up to zero
+func (k KVStore) AppendSlashRecord(ctx cosmos.Context, record SlashRecord) error {
+	if err := record.Valid(); err != nil {
+		return err
+	}
+	key := k.getSlashRecordKey(ctx, record.NodeAddress, record.Height)
+	records := make([]SlashRecord, 0)
+	if _, err := k.get(ctx, key, &records); err != nil {
+		return err
+	}
+	found := false
+	for i, r := range records {
+		if r.Reason != record.Reason || !r.TxID.Equals(record.TxID) || !r.PubKey.Equals(record.PubKey) || !r.Chain.Equals(record.Chain) {
+			continue
+		}
+		records[i].Points += record.Points
+		records[i].Bond = records[i].Bond.Add(record.Bond)
+		if records[i].Points == 0 && records[i].Bond.IsZero() {
+			records = append(records[:i], records[i+1:]...)
+		}
+		found = true
+		break
+	}
+	if !found {
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		k.del(ctx, key)
+		k.del(ctx, k.getSlashRecordHeightKey(ctx, record.Height, record.NodeAddress))
+		return nil
+	}
+	k.set(ctx, key, records)
+	k.set(ctx, k.getSlashRecordHeightKey(ctx, record.Height, record.NodeAddress), key)
+	return nil
+}
+
+// GetSlashRecords return the slash ledger of the given node account from the given height , ordered by height
+func (k KVStore) GetSlashRecords(ctx cosmos.Context, addr cosmos.AccAddress, from int64) ([]SlashRecord, error) {
+	result := make([]SlashRecord, 0)
+	if from < 0 {
+		from = 0
+	}
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.getSlashRecordKey(ctx, addr, from)), []byte(k.GetKey(ctx, prefixSlashRecord, addr.String()+"0")))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var records []SlashRecord
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &records); err != nil {
+			return nil, dbError(ctx, "Unmarshal: slash records", err)
+		}
+		result = append(result, records...)
+	}
+	return result, nil
+}
+
+// PruneSlashRecords remove the slash records of all the node accounts recorded before the given height
+func (k KVStore) PruneSlashRecords(ctx cosmos.Context, before int64) {
+	if before <= 0 {
+		return
+	}
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.GetKey(ctx, prefixSlashRecordHeight, "")), []byte(k.GetKey(ctx, prefixSlashRecordHeight, fmt.Sprintf("%020d", before))))
+	for ; iter.Valid(); iter.Next() {
+		var key string
+		if err := k.cdc.UnmarshalBinaryBare(iter.Value(), &key); err != nil {
+			ctx.Logger().Error("fail to unmarshal slash record key", "error", err)
+			continue
+		}
+		keys = append(keys, iter.Key(), []byte(key))
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
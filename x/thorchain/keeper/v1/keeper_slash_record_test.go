@@ -0,0 +1,69 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type KeeperSlashRecordSuite struct{}
+
+var _ = Suite(&KeeperSlashRecordSuite{})
+
+func (KeeperSlashRecordSuite) TestSlashRecords(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	addr := GetRandomBech32Addr()
+	other := GetRandomBech32Addr()
+	records, err := k.GetSlashRecords(ctx, addr, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 0)
+
+	// invalid record
+	c.Check(k.AppendSlashRecord(ctx, NewSlashRecord(addr, 0, 2, "observe", common.BlankTxID, common.EmptyPubKey)), NotNil)
+
+	txID := GetRandomTxHash()
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(addr, 20, 2, "observe", txID, common.EmptyPubKey)), IsNil)
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(addr, 20, 3, "observe", txID, common.EmptyPubKey)), IsNil)
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(addr, 20, 1, "observe", GetRandomTxHash(), common.EmptyPubKey)), IsNil)
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(addr, 20, 2, "fail_keysign", common.BlankTxID, GetRandomPubKey())), IsNil)
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(addr, 5, 720, "fail_keygen", common.BlankTxID, GetRandomPubKey())), IsNil)
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(other, 10, 2, "lack_of_observing", common.BlankTxID, common.EmptyPubKey)), IsNil)
+
+	records, err = k.GetSlashRecords(ctx, addr, 0)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 4)
+	c.Check(records[0].Height, Equals, int64(5))
+	// records of the same block , reason and tx are aggregated
+	c.Check(records[1].Points, Equals, int64(5))
+	c.Check(records[1].TxID.Equals(txID), Equals, true)
+	c.Check(records[2].Points, Equals, int64(1))
+	c.Check(records[3].Points, Equals, int64(2))
+	records, err = k.GetSlashRecords(ctx, addr, 6)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 3)
+
+	// the records add up to nothing
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(other, 12, 2, "observe", common.BlankTxID, common.EmptyPubKey)), IsNil)
+	c.Assert(k.AppendSlashRecord(ctx, NewSlashRecord(other, 12, -2, "observe", common.BlankTxID, common.EmptyPubKey)), IsNil)
+	records, err = k.GetSlashRecords(ctx, other, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 1)
+
+	k.PruneSlashRecords(ctx, 10)
+	records, err = k.GetSlashRecords(ctx, addr, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 3)
+	records, err = k.GetSlashRecords(ctx, other, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 1)
+	k.PruneSlashRecords(ctx, 21)
+	records, err = k.GetSlashRecords(ctx, addr, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 0)
+	records, err = k.GetSlashRecords(ctx, other, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 0)
+	iter := k.getIterator(ctx, prefixSlashRecordHeight)
+	defer iter.Close()
+	c.Check(iter.Valid(), Equals, false)
+}
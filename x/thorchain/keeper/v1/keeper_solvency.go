@@ -0,0 +1,62 @@
+package keeperv1
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// getSolvencyVoterKey heights are zero padded , so the voters of a vault on a chain iterate in height order
+func (k KVStore) getSolvencyVoterKey(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) string {
+	return k.GetKey(ctx, prefixSolvencyVoter, fmt.Sprintf("%s/%s/%020d", pubKey, chain, height))
+}
+
+// SetSolvencyVoter - save a solvency voter object
+func (k KVStore) SetSolvencyVoter(ctx cosmos.Context, voter SolvencyVoter) {
+	k.set(ctx, k.getSolvencyVoterKey(ctx, voter.Chain, voter.PubKey, voter.Height), voter)
+}
+
+// GetSolvencyVoterIterator iterate solvency voters
+func (k KVStore) GetSolvencyVoterIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixSolvencyVoter)
+}
+
+// GetSolvencyVoter - gets the solvency voter of the vault on the chain at the given height , a new voter is returned when
+// nobody voted for it yet
+func (k KVStore) GetSolvencyVoter(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) (SolvencyVoter, error) {
+	record := NewSolvencyVoter(chain, pubKey, height)
+	_, err := k.get(ctx, k.getSolvencyVoterKey(ctx, chain, pubKey, height), &record)
+	return record, err
+}
+
+// PruneSolvencyVoters remove the solvency voters of the vault on the chain up to the given height (inclusive)
+func (k KVStore) PruneSolvencyVoters(ctx cosmos.Context, chain common.Chain, pubKey common.PubKey, height int64) {
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.getSolvencyVoterKey(ctx, chain, pubKey, 0)), []byte(k.getSolvencyVoterKey(ctx, chain, pubKey, height+1)))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		k.del(ctx, string(key))
+	}
+}
+
+// SetVaultSolvency save the latest observed balance of a vault on a chain
+func (k KVStore) SetVaultSolvency(ctx cosmos.Context, solvency VaultSolvency) {
+	k.set(ctx, k.GetKey(ctx, prefixVaultSolvency, solvency.String()), solvency)
+}
+
+// GetVaultSolvency retrieve the latest observed balance of a vault on the given chain , an empty record is returned when there is none
+func (k KVStore) GetVaultSolvency(ctx cosmos.Context, pubKey common.PubKey, chain common.Chain) (VaultSolvency, error) {
+	record := VaultSolvency{}
+	_, err := k.get(ctx, k.GetKey(ctx, prefixVaultSolvency, VaultSolvency{PubKey: pubKey, Chain: chain}.String()), &record)
+	return record, err
+}
+
+// GetVaultSolvencyIterator iterate the observed vault balances
+func (k KVStore) GetVaultSolvencyIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixVaultSolvency)
+}
@@ -0,0 +1,83 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperSolvencySuite struct{}
+
+var _ = Suite(&KeeperSolvencySuite{})
+
+func (*KeeperSolvencySuite) TestSolvencyVoter(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pubKey := GetRandomPubKey()
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))}
+	voter, err := k.GetSolvencyVoter(ctx, common.BNBChain, pubKey, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voter.IsEmpty(), Equals, false)
+	c.Check(voter.Signers, HasLen, 0)
+
+	voter.Sign(GetRandomBech32Addr(), coins)
+	k.SetSolvencyVoter(ctx, voter)
+	voter, err = k.GetSolvencyVoter(ctx, common.BNBChain, pubKey, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 1)
+	c.Check(voter.Reports, HasLen, 1)
+	c.Check(voter.Reports[0].Equals(coins), Equals, true)
+	c.Check(voter.Height, Equals, int64(1024))
+	c.Check(k.GetSolvencyVoterIterator(ctx), NotNil)
+
+	// a different height is a different voter
+	voter, err = k.GetSolvencyVoter(ctx, common.BNBChain, pubKey, 1025)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+	voter.Sign(GetRandomBech32Addr(), coins)
+	k.SetSolvencyVoter(ctx, voter)
+	other := NewSolvencyVoter(common.BTCChain, pubKey, 1000)
+	other.Sign(GetRandomBech32Addr(), common.Coins{})
+	k.SetSolvencyVoter(ctx, other)
+
+	// voters up to the given height are pruned, other chains are left alone
+	k.PruneSolvencyVoters(ctx, common.BNBChain, pubKey, 1024)
+	voter, err = k.GetSolvencyVoter(ctx, common.BNBChain, pubKey, 1024)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 0)
+	voter, err = k.GetSolvencyVoter(ctx, common.BNBChain, pubKey, 1025)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 1)
+	voter, err = k.GetSolvencyVoter(ctx, common.BTCChain, pubKey, 1000)
+	c.Assert(err, IsNil)
+	c.Check(voter.Signers, HasLen, 1)
+}
+
+func (*KeeperSolvencySuite) TestVaultSolvency(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pubKey := GetRandomPubKey()
+	solvency, err := k.GetVaultSolvency(ctx, pubKey, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(solvency.IsEmpty(), Equals, true)
+
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))}
+	k.SetVaultSolvency(ctx, NewVaultSolvency(pubKey, common.BNBChain, coins, 1024, 10))
+	solvency, err = k.GetVaultSolvency(ctx, pubKey, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(solvency.PubKey.Equals(pubKey), Equals, true)
+	c.Check(solvency.Coins.Equals(coins), Equals, true)
+	c.Check(solvency.Height, Equals, int64(1024))
+	c.Check(solvency.BlockHeight, Equals, int64(10))
+
+	solvency, err = k.GetVaultSolvency(ctx, pubKey, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(solvency.IsEmpty(), Equals, true)
+
+	iter := k.GetVaultSolvencyIterator(ctx)
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	c.Check(count, Equals, 1)
+}
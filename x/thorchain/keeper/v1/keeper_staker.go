@@ -1,6 +1,8 @@
 package keeperv1
 
 import (
+	"fmt"
+
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper/types"
@@ -15,21 +17,136 @@ func (k KVStore) GetStakerIterator(ctx cosmos.Context, asset common.Asset) cosmo
 // GetStaker retrieve staker from the data store
 func (k KVStore) GetStaker(ctx cosmos.Context, asset common.Asset, addr common.Address) (Staker, error) {
 	record := Staker{
-		Asset:       asset,
-		RuneAddress: addr,
-		Units:       cosmos.ZeroUint(),
-		PendingRune: cosmos.ZeroUint(),
+		Asset:        asset,
+		RuneAddress:  addr,
+		Units:        cosmos.ZeroUint(),
+		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}
 	_, err := k.get(ctx, k.GetKey(ctx, prefixStaker, record.Key()), &record)
+	// stakers saved before deposits were recorded don't have the deposit fields
+	if record.RuneDeposit == (cosmos.Uint{}) {
+		record.RuneDeposit = cosmos.ZeroUint()
+	}
+	if record.AssetDeposit == (cosmos.Uint{}) {
+		record.AssetDeposit = cosmos.ZeroUint()
+	}
 	return record, err
 }
 
-// SetStaker save the staker to kv store
+// SetStaker save the staker to kv store , the index entries of the addresses the staker no longer has are removed
 func (k KVStore) SetStaker(ctx cosmos.Context, staker Staker) {
+	k.removeStakerIndex(ctx, staker, staker.RuneAddress, staker.AssetAddress)
 	k.set(ctx, k.GetKey(ctx, prefixStaker, staker.Key()), staker)
+	for _, addr := range []common.Address{staker.RuneAddress, staker.AssetAddress} {
+		if addr.IsEmpty() {
+			continue
+		}
+		key := k.getStakerIndexKey(ctx, addr, staker)
+		if !k.has(ctx, key) {
+			k.set(ctx, key, stakerIndex{Asset: staker.Asset, RuneAddress: staker.RuneAddress})
+		}
+	}
+	if staker.PendingRune != (cosmos.Uint{}) && !staker.PendingRune.IsZero() {
+		k.set(ctx, k.getStakerPendingKey(ctx, staker), stakerIndex{Asset: staker.Asset, RuneAddress: staker.RuneAddress})
+	}
 }
 
 // RemoveStaker remove the staker to kv store
 func (k KVStore) RemoveStaker(ctx cosmos.Context, staker Staker) {
+	k.removeStakerIndex(ctx, staker)
 	k.del(ctx, k.GetKey(ctx, prefixStaker, staker.Key()))
+	for _, addr := range []common.Address{staker.RuneAddress, staker.AssetAddress} {
+		if addr.IsEmpty() {
+			continue
+		}
+		k.del(ctx, k.getStakerIndexKey(ctx, addr, staker))
+	}
+	k.del(ctx, k.getStakerPendingKey(ctx, staker))
+}
+
+// stakerIndex point from either the rune address or the asset address of a staker to the staker record
+type stakerIndex struct {
+	Asset       common.Asset   `json:"asset"`
+	RuneAddress common.Address `json:"rune_address"`
+}
+
+// removeStakerIndex remove the index entries of the addresses of the saved staker record , except the given ones to keep ,
+// and its pending RUNE index entry
+func (k KVStore) removeStakerIndex(ctx cosmos.Context, staker Staker, keep ...common.Address) {
+	var saved Staker
+	ok, err := k.get(ctx, k.GetKey(ctx, prefixStaker, staker.Key()), &saved)
+	if err != nil {
+		ctx.Logger().Error("fail to get staker", "key", staker.Key(), "error", err)
+		return
+	}
+	if !ok {
+		return
+	}
+	k.del(ctx, k.getStakerPendingKey(ctx, saved))
+	for _, addr := range []common.Address{saved.RuneAddress, saved.AssetAddress} {
+		if addr.IsEmpty() || containsAddress(keep, addr) {
+			continue
+		}
+		k.del(ctx, k.getStakerIndexKey(ctx, addr, staker))
+	}
+}
+
+func containsAddress(addrs []common.Address, addr common.Address) bool {
+	for _, item := range addrs {
+		if item.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// getStakerPendingKey heights are zero padded , so the stakers with pending RUNE iterate from the one staked the earliest
+func (k KVStore) getStakerPendingKey(ctx cosmos.Context, staker Staker) string {
+	return k.GetKey(ctx, prefixStakerPending, fmt.Sprintf("%020d/%s", staker.LastStakeHeight, staker.Key()))
+}
+
+func (k KVStore) getStakerIndexKey(ctx cosmos.Context, addr common.Address, staker Staker) string {
+	return k.GetKey(ctx, prefixStakerIndex, fmt.Sprintf("%s/%s", addr, staker.Key()))
+}
+
+// GetStakersByAddress return the staker records of all the pools the given rune address or asset address staked in
+func (k KVStore) GetStakersByAddress(ctx cosmos.Context, addr common.Address) ([]Staker, error) {
+	key := k.GetKey(ctx, prefixStakerIndex, fmt.Sprintf("%s/", addr))
+	return k.getIndexedStakers(ctx, k.getIterator(ctx, types.DbPrefix(key)))
+}
+
+// getIndexedStakers return the staker records pointed by the staker index the given iterator walk through , the iterator is closed
+func (k KVStore) getIndexedStakers(ctx cosmos.Context, iterator cosmos.Iterator) ([]Staker, error) {
+	defer iterator.Close()
+	stakers := make([]Staker, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var idx stakerIndex
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &idx); err != nil {
+			return nil, dbError(ctx, "fail to unmarshal staker index", err)
+		}
+		staker := Staker{Asset: idx.Asset, RuneAddress: idx.RuneAddress}
+		if !k.has(ctx, k.GetKey(ctx, prefixStaker, staker.Key())) {
+			continue
+		}
+		staker, err := k.GetStaker(ctx, idx.Asset, idx.RuneAddress)
+		if err != nil {
+			return nil, err
+		}
+		stakers = append(stakers, staker)
+	}
+	return stakers, nil
+}
+
+// GetStakersWithPendingRune return all the stakers which have RUNE waiting for the asset side of the stake
+func (k KVStore) GetStakersWithPendingRune(ctx cosmos.Context) ([]Staker, error) {
+	return k.getIndexedStakers(ctx, k.getIterator(ctx, prefixStakerPending))
+}
+
+// GetStakersWithPendingRuneBefore return the stakers which have RUNE waiting for the asset side since the given height or earlier
+func (k KVStore) GetStakersWithPendingRuneBefore(ctx cosmos.Context, height int64) ([]Staker, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator([]byte(k.GetKey(ctx, prefixStakerPending, "")), []byte(k.GetKey(ctx, prefixStakerPending, fmt.Sprintf("%020d", height+1))))
+	return k.getIndexedStakers(ctx, iter)
 }
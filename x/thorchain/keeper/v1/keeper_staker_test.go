@@ -37,3 +37,130 @@ func (s *KeeperStakerSuite) TestStaker(c *C) {
 	iter.Close()
 	k.RemoveStaker(ctx, staker)
 }
+
+func (s *KeeperStakerSuite) TestGetStakersByAddress(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	runeAddr := GetRandomBNBAddress()
+	bnbAddr := GetRandomBNBAddress()
+	btcAddr := GetRandomBTCAddress()
+	bnbStaker := Staker{
+		Asset:        common.BNBAsset,
+		Units:        cosmos.NewUint(12),
+		RuneAddress:  runeAddr,
+		AssetAddress: bnbAddr,
+	}
+	k.SetStaker(ctx, bnbStaker)
+	btcStaker := Staker{
+		Asset:        common.BTCAsset,
+		Units:        cosmos.NewUint(24),
+		RuneAddress:  runeAddr,
+		AssetAddress: btcAddr,
+	}
+	k.SetStaker(ctx, btcStaker)
+	k.SetStaker(ctx, Staker{
+		Asset:        common.BNBAsset,
+		Units:        cosmos.NewUint(36),
+		RuneAddress:  GetRandomBNBAddress(),
+		AssetAddress: GetRandomBNBAddress(),
+	})
+
+	stakers, err := k.GetStakersByAddress(ctx, runeAddr)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 2)
+	stakers, err = k.GetStakersByAddress(ctx, btcAddr)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(stakers[0].Units.Equal(cosmos.NewUint(24)), Equals, true)
+	stakers, err = k.GetStakersByAddress(ctx, GetRandomBNBAddress())
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+
+	k.RemoveStaker(ctx, btcStaker)
+	stakers, err = k.GetStakersByAddress(ctx, runeAddr)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].Asset.Equals(common.BNBAsset), Equals, true)
+	stakers, err = k.GetStakersByAddress(ctx, btcAddr)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+
+	// the staker is no longer found by the asset address it changed from
+	newBnbAddr := GetRandomBNBAddress()
+	bnbStaker.AssetAddress = newBnbAddr
+	k.SetStaker(ctx, bnbStaker)
+	stakers, err = k.GetStakersByAddress(ctx, bnbAddr)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+	stakers, err = k.GetStakersByAddress(ctx, newBnbAddr)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].AssetAddress.Equals(newBnbAddr), Equals, true)
+	stakers, err = k.GetStakersByAddress(ctx, runeAddr)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 1)
+
+	// the index entries of the saved addresses are removed , even when the given record doesn't carry them
+	k.RemoveStaker(ctx, Staker{Asset: common.BNBAsset, RuneAddress: runeAddr})
+	stakers, err = k.GetStakersByAddress(ctx, newBnbAddr)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+	iter := k.getIterator(ctx, prefixStakerIndex)
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	// only the index entries of the unrelated staker are left
+	c.Check(count, Equals, 2)
+}
+
+func (s *KeeperStakerSuite) TestGetStakersWithPendingRune(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	staker := Staker{
+		Asset:        common.BTCAsset,
+		Units:        cosmos.ZeroUint(),
+		PendingRune:  cosmos.NewUint(100),
+		RuneAddress:  GetRandomBNBAddress(),
+		AssetAddress: GetRandomBTCAddress(),
+	}
+	k.SetStaker(ctx, staker)
+	k.SetStaker(ctx, Staker{
+		Asset:        common.BNBAsset,
+		Units:        cosmos.NewUint(36),
+		PendingRune:  cosmos.ZeroUint(),
+		RuneAddress:  GetRandomBNBAddress(),
+		AssetAddress: GetRandomBNBAddress(),
+	})
+	stakers, err := k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].PendingRune.Equal(cosmos.NewUint(100)), Equals, true)
+
+	// only the stakers waiting since the given height or earlier
+	staker.LastStakeHeight = 10
+	k.SetStaker(ctx, staker)
+	stakers, err = k.GetStakersWithPendingRuneBefore(ctx, 9)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+	stakers, err = k.GetStakersWithPendingRuneBefore(ctx, 10)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 1)
+	// staking again move the staker in the index
+	staker.LastStakeHeight = 20
+	k.SetStaker(ctx, staker)
+	stakers, err = k.GetStakersWithPendingRuneBefore(ctx, 10)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 1)
+
+	// asset side arrived
+	staker.PendingRune = cosmos.ZeroUint()
+	staker.Units = cosmos.NewUint(100)
+	k.SetStaker(ctx, staker)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 0)
+}
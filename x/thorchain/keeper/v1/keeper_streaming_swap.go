@@ -0,0 +1,38 @@
+package keeperv1
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// GetStreamingSwapIterator iterate streaming swaps
+func (k KVStore) GetStreamingSwapIterator(ctx cosmos.Context) cosmos.Iterator {
+	return k.getIterator(ctx, prefixStreamingSwap)
+}
+
+// GetStreamingSwap retrieve the streaming swap of the given tx id from the kv store, an empty record is returned when it doesn't exist
+func (k KVStore) GetStreamingSwap(ctx cosmos.Context, txID common.TxID) (StreamingSwap, error) {
+	record := StreamingSwap{
+		TxID:    txID,
+		Deposit: cosmos.ZeroUint(),
+		In:      cosmos.ZeroUint(),
+		Out:     cosmos.ZeroUint(),
+	}
+	_, err := k.get(ctx, k.GetKey(ctx, prefixStreamingSwap, txID.String()), &record)
+	return record, err
+}
+
+// SetStreamingSwap save the streaming swap to kv store
+func (k KVStore) SetStreamingSwap(ctx cosmos.Context, stream StreamingSwap) {
+	k.set(ctx, k.GetKey(ctx, prefixStreamingSwap, stream.TxID.String()), stream)
+}
+
+// StreamingSwapExists check whether the streaming swap of the given tx id exist in the kv store
+func (k KVStore) StreamingSwapExists(ctx cosmos.Context, txID common.TxID) bool {
+	return k.has(ctx, k.GetKey(ctx, prefixStreamingSwap, txID.String()))
+}
+
+// RemoveStreamingSwap remove the streaming swap from kv store
+func (k KVStore) RemoveStreamingSwap(ctx cosmos.Context, txID common.TxID) {
+	k.del(ctx, k.GetKey(ctx, prefixStreamingSwap, txID.String()))
+}
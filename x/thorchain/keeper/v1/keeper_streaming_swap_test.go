@@ -0,0 +1,40 @@
+package keeperv1
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type KeeperStreamingSwapSuite struct{}
+
+var _ = Suite(&KeeperStreamingSwapSuite{})
+
+func (s *KeeperStreamingSwapSuite) TestKeeperStreamingSwap(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	// not found
+	txID := GetRandomTxHash()
+	c.Check(k.StreamingSwapExists(ctx, txID), Equals, false)
+	stream, err := k.GetStreamingSwap(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(stream.IsEmpty(), Equals, true)
+	c.Check(stream.TxID.Equals(txID), Equals, true)
+
+	stream = NewStreamingSwap(txID, 3, 5, cosmos.NewUint(1000))
+	stream.Count = 2
+	k.SetStreamingSwap(ctx, stream)
+	c.Check(k.StreamingSwapExists(ctx, txID), Equals, true)
+	stream2, err := k.GetStreamingSwap(ctx, txID)
+	c.Assert(err, IsNil)
+	c.Check(stream2.Count, Equals, int64(2))
+	c.Check(stream2.Quantity, Equals, int64(5))
+	c.Check(stream2.Deposit.Equal(cosmos.NewUint(1000)), Equals, true)
+
+	iter := k.GetStreamingSwapIterator(ctx)
+	c.Check(iter.Valid(), Equals, true)
+	iter.Close()
+
+	k.RemoveStreamingSwap(ctx, txID)
+	c.Check(k.StreamingSwapExists(ctx, txID), Equals, false)
+}
@@ -25,7 +25,7 @@ func (k KVStore) GetSwapQueueItem(ctx cosmos.Context, txID common.TxID) (MsgSwap
 	if !ok {
 		return record, errors.New("not found")
 	}
-	return record, err
+	return record.WithDefaults(), err
 }
 
 // RemoveSwapQueueItem - removes a swap item from the kv store
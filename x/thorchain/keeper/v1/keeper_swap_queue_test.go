@@ -1,7 +1,11 @@
 package keeperv1
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
 )
 
 type KeeperSwapQueueSuite struct{}
@@ -24,6 +28,29 @@ func (s *KeeperSwapQueueSuite) TestKeeperSwapQueue(c *C) {
 	c.Assert(err, IsNil)
 	c.Check(msg2.Tx.ID.Equals(msg.Tx.ID), Equals, true)
 
+	// swaps queued before the limits existed don't have them
+	type legacyMsgSwap struct {
+		Tx          common.Tx         `json:"tx"`
+		TargetAsset common.Asset      `json:"target_asset"`
+		Destination common.Address    `json:"destination"`
+		TradeTarget cosmos.Uint       `json:"trade_target"`
+		Signer      cosmos.AccAddress `json:"signer"`
+	}
+	cdc := codec.New()
+	cdc.RegisterConcrete(legacyMsgSwap{}, "thorchain/Swap", nil)
+	legacy := legacyMsgSwap{
+		Tx:          GetRandomTx(),
+		TargetAsset: common.BNBAsset,
+		TradeTarget: cosmos.ZeroUint(),
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(k.GetKey(ctx, prefixSwapQueueItem, legacy.Tx.ID.String())), cdc.MustMarshalBinaryBare(legacy))
+	msg3, err := k.GetSwapQueueItem(ctx, legacy.Tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(msg3.MaxSlip.IsZero(), Equals, true)
+	c.Check(msg3.MaxLiquidityFee.IsZero(), Equals, true)
+	c.Check(msg3.AffiliateBasisPoints.IsZero(), Equals, true)
+
 	iter := k.GetSwapQueueIterator(ctx)
 	defer iter.Close()
 
@@ -0,0 +1,73 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// isNodeInMaintenance return true when the given node account is in maintenance at the current block height
+func isNodeInMaintenance(ctx cosmos.Context, keeper keeper.Keeper, addr cosmos.AccAddress) bool {
+	maintenance, err := keeper.GetNodeMaintenance(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get node maintenance", "node address", addr.String(), "error", err)
+		return false
+	}
+	return maintenance.InMaintenance(common.BlockHeight(ctx))
+}
+
+// getNodesInMaintenance return the node accounts that are in maintenance at the current block height
+func getNodesInMaintenance(ctx cosmos.Context, keeper keeper.Keeper) ([]NodeMaintenance, error) {
+	result := make([]NodeMaintenance, 0)
+	iter := keeper.GetNodeMaintenanceIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var maintenance NodeMaintenance
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &maintenance); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal node maintenance: %w", err)
+		}
+		if maintenance.InMaintenance(common.BlockHeight(ctx)) {
+			result = append(result, maintenance)
+		}
+	}
+	return result, nil
+}
+
+// getMaxMaintenanceNodes return how many of the given number of active node accounts can be in maintenance at once
+// no matter what mimir say , it is always less than a third of the active node accounts , so the rest can still reach consensus
+func getMaxMaintenanceNodes(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues, totalActive int64) int64 {
+	bps, err := keeper.GetMimir(ctx, constants.MaxMaintenanceNodesBasisPoints.String())
+	if bps < 0 || err != nil {
+		bps = constAccessor.GetInt64Value(constants.MaxMaintenanceNodesBasisPoints)
+	}
+	maxNodes := totalActive * bps / MaxMaintenanceBasisPoints
+	if faultTolerance := (totalActive - 1) / 3; maxNodes > faultTolerance {
+		maxNodes = faultTolerance
+	}
+	if maxNodes < 0 {
+		maxNodes = 0
+	}
+	return maxNodes
+}
+
+// getSigningCandidates return the given members excluding the node accounts in maintenance , as those might not be able to sign
+// when that leaves less than the threshold needed to sign , all the members are returned instead
+func getSigningCandidates(ctx cosmos.Context, keeper keeper.Keeper, members common.PubKeys, threshold int) common.PubKeys {
+	candidates := make(common.PubKeys, 0, len(members))
+	for _, member := range members {
+		na, err := keeper.GetNodeAccountByPubKey(ctx, member)
+		if err != nil {
+			ctx.Logger().Error("fail to get node account", "pubkey", member.String(), "error", err)
+		} else if isNodeInMaintenance(ctx, keeper, na.NodeAddress) {
+			continue
+		}
+		candidates = append(candidates, member)
+	}
+	if len(candidates) < threshold {
+		return members
+	}
+	return candidates
+}
@@ -77,10 +77,23 @@ func (om *ObserverMgrV1) List() []cosmos.AccAddress {
 	return result
 }
 
-// EndBlock emit the observers
+// EndBlock emit the observers , both the observers of all chains , and the observers of each chain
 func (om *ObserverMgrV1) EndBlock(ctx cosmos.Context, keeper keeper.Keeper) {
 	if err := keeper.AddObservingAddresses(ctx, om.List()); err != nil {
 		ctx.Logger().Error("fail to append observers", "error", err)
 	}
+	chains := make(common.Chains, 0, len(om.chains))
+	for chain := range om.chains {
+		chains = append(chains, chain)
+	}
+	// Sort the chains, ensures we avoid a consensus failure
+	sort.SliceStable(chains, func(i, j int) bool {
+		return chains[i].String() < chains[j].String()
+	})
+	for _, chain := range chains {
+		if err := keeper.AddChainObservingAddresses(ctx, chain, om.chains[chain]); err != nil {
+			ctx.Logger().Error("fail to append chain observers", "chain", chain, "error", err)
+		}
+	}
 	om.reset() // do not remove, would cause consensus failure
 }
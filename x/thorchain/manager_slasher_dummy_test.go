@@ -37,7 +37,7 @@ func (d DummySlasher) SlashNodeAccount(ctx cosmos.Context, observedPubKey common
 	return kaboom
 }
 
-func (d DummySlasher) IncSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
+func (d DummySlasher) IncSlashPoints(ctx cosmos.Context, point int64, txID common.TxID, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
 		found := false
 		for k := range d.pts {
@@ -53,7 +53,7 @@ func (d DummySlasher) IncSlashPoints(ctx cosmos.Context, point int64, addresses
 	}
 }
 
-func (d DummySlasher) DecSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
+func (d DummySlasher) DecSlashPoints(ctx cosmos.Context, point int64, txID common.TxID, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
 		found := false
 		for k := range d.pts {
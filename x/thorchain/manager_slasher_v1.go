@@ -94,21 +94,27 @@ func (s *SlasherV1) HandleDoubleSign(ctx cosmos.Context, addr crypto.Address, in
 				}
 			}
 
-			return s.keeper.SetNodeAccount(ctx, na)
+			if err := s.keeper.SetNodeAccount(ctx, na); err != nil {
+				return fmt.Errorf("fail to save node account: %w", err)
+			}
+			addBondSlashRecord(ctx, s.keeper, na.NodeAddress, slashAmount, SlashReasonDoubleSign, common.EmptyPubKey, common.EmptyChain)
+			return nil
 		}
 	}
 
 	return fmt.Errorf("could not find node account with validator address: %s", addr)
 }
 
-// LackObserving Slash node accounts that didn't observe a single inbound txn
+// LackObserving Slash node accounts that didn't observe a chain which reached consensus on a txn within the block
+// each chain is accounted separately , so a node account stop observing one chain get slashed even it still observe others
+// node accounts in maintenance are excused , and what they miss while in maintenance doesn't count toward their miss rate
 func (s *SlasherV1) LackObserving(ctx cosmos.Context, constAccessor constants.ConstantValues) error {
-	accs, err := s.keeper.GetObservingAddresses(ctx)
+	chains, err := s.keeper.GetObservedChains(ctx)
 	if err != nil {
-		return fmt.Errorf("fail to get observing addresses: %w", err)
+		return fmt.Errorf("fail to get observed chains: %w", err)
 	}
 
-	if len(accs) == 0 {
+	if len(chains) == 0 {
 		// nobody observed anything, THORNode must of had no input txs within this block
 		return nil
 	}
@@ -118,20 +124,54 @@ func (s *SlasherV1) LackObserving(ctx cosmos.Context, constAccessor constants.Co
 		return fmt.Errorf("unable to get list of active accounts: %w", err)
 	}
 
+	inMaintenance := make(map[string]bool)
 	for _, na := range nodes {
-		found := false
-		for _, addr := range accs {
-			if na.NodeAddress.Equals(addr) {
-				found = true
-				break
-			}
+		inMaintenance[na.NodeAddress.String()] = isNodeInMaintenance(ctx, s.keeper, na.NodeAddress)
+	}
+
+	lackOfObservationPenalty := constAccessor.GetInt64Value(constants.LackOfObservationPenalty)
+	window := getChainObservationWindow(ctx, s.keeper, constAccessor)
+	for _, chain := range chains {
+		accs, err := s.keeper.GetChainObservingAddresses(ctx, chain)
+		if err != nil {
+			return fmt.Errorf("fail to get observing addresses of chain(%s): %w", chain, err)
+		}
+		if len(accs) == 0 {
+			continue
 		}
+		for _, na := range nodes {
+			if inMaintenance[na.NodeAddress.String()] {
+				continue
+			}
+			found := false
+			for _, addr := range accs {
+				if na.NodeAddress.Equals(addr) {
+					found = true
+					break
+				}
+			}
 
-		// this na is not found, therefore it should be slashed
-		if !found {
-			lackOfObservationPenalty := constAccessor.GetInt64Value(constants.LackOfObservationPenalty)
-			if err := s.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, lackOfObservationPenalty); err != nil {
-				ctx.Logger().Error("fail to inc slash points", "error", err)
+			observation, err := s.keeper.GetChainObservation(ctx, na.NodeAddress, chain)
+			if err != nil {
+				ctx.Logger().Error("fail to get chain observation", "chain", chain, "error", err)
+			} else {
+				observation.Roll(common.BlockHeight(ctx), window)
+				observation.Total++
+				if !found {
+					observation.Missed++
+				}
+				if err := s.keeper.SetChainObservation(ctx, observation); err != nil {
+					ctx.Logger().Error("fail to save chain observation", "chain", chain, "error", err)
+				}
+			}
+
+			// this na is not found, therefore it should be slashed
+			if !found {
+				record := NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), lackOfObservationPenalty, SlashReasonLackOfObserving, common.BlankTxID, common.EmptyPubKey)
+				record.Chain = chain
+				if err := addSlashPoints(ctx, s.keeper, record); err != nil {
+					ctx.Logger().Error("fail to inc slash points", "error", err)
+				}
 			}
 		}
 	}
@@ -139,6 +179,15 @@ func (s *SlasherV1) LackObserving(ctx cosmos.Context, constAccessor constants.Co
 	return nil
 }
 
+// getChainObservationWindow return the number of blocks in a period of the rolling window the chain observations are counted over
+func getChainObservationWindow(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) int64 {
+	window, err := keeper.GetMimir(ctx, constants.ChainObservationWindowBlocks.String())
+	if window <= 0 || err != nil {
+		window = constAccessor.GetInt64Value(constants.ChainObservationWindowBlocks)
+	}
+	return window
+}
+
 // LackSigning slash account that fail to sign tx
 func (s *SlasherV1) LackSigning(ctx cosmos.Context, constAccessor constants.ConstantValues, mgr Manager) error {
 	var resultErr error
@@ -162,20 +211,23 @@ func (s *SlasherV1) LackSigning(ctx cosmos.Context, constAccessor constants.Cons
 				// log the error, and continue
 				ctx.Logger().Error("Unable to get vault", "error", err, "vault pub key", tx.VaultPubKey.String())
 			}
-			// slash if its a yggdrasil vault
+			// slash if its a yggdrasil vault , unless the node account is in maintenance , the outbound is rescheduled to asgard either way
 			if vault.IsYggdrasil() {
 				na, err := s.keeper.GetNodeAccountByPubKey(ctx, tx.VaultPubKey)
 				if err != nil {
 					ctx.Logger().Error("Unable to get node account", "error", err, "vault pub key", tx.VaultPubKey.String())
 					continue
 				}
-				if err := s.keeper.IncNodeAccountSlashPoints(ctx, na.NodeAddress, signingTransPeriod*2); err != nil {
-					ctx.Logger().Error("fail to inc slash points", "error", err, "node addr", na.NodeAddress.String())
-				}
-				releaseHeight := common.BlockHeight(ctx) + (signingTransPeriod * 2)
-				reason := "fail to send yggdrasil transaction"
-				if err := s.keeper.SetNodeAccountJail(ctx, na.NodeAddress, releaseHeight, reason); err != nil {
-					ctx.Logger().Error("fail to set node account jail", "node address", na.NodeAddress, "reason", reason, "error", err)
+				if !isNodeInMaintenance(ctx, s.keeper, na.NodeAddress) {
+					record := NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), signingTransPeriod*2, SlashReasonFailSendOutbound, tx.InHash, tx.VaultPubKey)
+					if err := addSlashPoints(ctx, s.keeper, record); err != nil {
+						ctx.Logger().Error("fail to inc slash points", "error", err, "node addr", na.NodeAddress.String())
+					}
+					releaseHeight := common.BlockHeight(ctx) + (signingTransPeriod * 2)
+					reason := "fail to send yggdrasil transaction"
+					if err := s.keeper.SetNodeAccountJail(ctx, na.NodeAddress, releaseHeight, reason); err != nil {
+						ctx.Logger().Error("fail to set node account jail", "node address", na.NodeAddress, "reason", reason, "error", err)
+					}
 				}
 			}
 
@@ -308,7 +360,11 @@ func (s *SlasherV1) SlashNodeAccount(ctx cosmos.Context, observedPubKey common.P
 		if err := s.keeper.SetVaultData(ctx, vaultData); err != nil {
 			return fmt.Errorf("fail to save vault data: %w", err)
 		}
-		return s.keeper.SetNodeAccount(ctx, nodeAccount)
+		if err := s.keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
+			return fmt.Errorf("fail to save node account: %w", err)
+		}
+		addBondSlashRecord(ctx, s.keeper, nodeAccount.NodeAddress, slashAmount, SlashReasonLostFunds, observedPubKey, asset.Chain)
+		return nil
 	}
 	pool, err := s.keeper.GetPool(ctx, asset)
 	if err != nil {
@@ -347,22 +403,29 @@ func (s *SlasherV1) SlashNodeAccount(ctx cosmos.Context, observedPubKey common.P
 		return fmt.Errorf("fail to emit slash event: %w", err)
 	}
 
-	return s.keeper.SetNodeAccount(ctx, nodeAccount)
+	if err := s.keeper.SetNodeAccount(ctx, nodeAccount); err != nil {
+		return fmt.Errorf("fail to save node account: %w", err)
+	}
+	addBondSlashRecord(ctx, s.keeper, nodeAccount.NodeAddress, runeValue, SlashReasonLostFunds, observedPubKey, asset.Chain)
+	return nil
 }
 
-// IncSlashPoints will increase the given account's slash points
-func (s *SlasherV1) IncSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
+// IncSlashPoints will increase the given account's slash points for making an observation , txID is the tx observed , if any
+func (s *SlasherV1) IncSlashPoints(ctx cosmos.Context, point int64, txID common.TxID, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
-		if err := s.keeper.IncNodeAccountSlashPoints(ctx, addr, point); err != nil {
+		record := NewSlashRecord(addr, common.BlockHeight(ctx), point, SlashReasonObserve, txID, common.EmptyPubKey)
+		if err := addSlashPoints(ctx, s.keeper, record); err != nil {
 			ctx.Logger().Error("fail to increase node account slash point", "error", err, "address", addr.String())
 		}
 	}
 }
 
-// DecSlashPoints will decrease the given account's slash points
-func (s *SlasherV1) DecSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress) {
+// DecSlashPoints will decrease the given account's slash points once their observation reached consensus within the observe flex
+// txID is the tx observed , if any
+func (s *SlasherV1) DecSlashPoints(ctx cosmos.Context, point int64, txID common.TxID, addresses ...cosmos.AccAddress) {
 	for _, addr := range addresses {
-		if err := s.keeper.DecNodeAccountSlashPoints(ctx, addr, point); err != nil {
+		record := NewSlashRecord(addr, common.BlockHeight(ctx), -point, SlashReasonObserveRedeem, txID, common.EmptyPubKey)
+		if err := addSlashPoints(ctx, s.keeper, record); err != nil {
 			ctx.Logger().Error("fail to decrease node account slash point", "error", err, "address", addr.String())
 		}
 	}
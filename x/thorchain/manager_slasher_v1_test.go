@@ -21,23 +21,45 @@ func (s *SlashingSuite) SetUpSuite(c *C) {
 
 type TestSlashObservingKeeper struct {
 	keeper.KVStoreDummy
-	addrs                     []cosmos.AccAddress
+	chainAddrs                map[common.Chain][]cosmos.AccAddress
+	observations              map[string]ChainObservation
 	nas                       NodeAccounts
 	failGetObservingAddress   bool
 	failListActiveNodeAccount bool
 	failSetNodeAccount        bool
 	slashPts                  map[string]int64
+	slashRecords              []SlashRecord
 }
 
-func (k *TestSlashObservingKeeper) GetObservingAddresses(_ cosmos.Context) ([]cosmos.AccAddress, error) {
+func (k *TestSlashObservingKeeper) GetObservedChains(_ cosmos.Context) (common.Chains, error) {
 	if k.failGetObservingAddress {
 		return nil, kaboom
 	}
-	return k.addrs, nil
+	var chains common.Chains
+	for chain := range k.chainAddrs {
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+func (k *TestSlashObservingKeeper) GetChainObservingAddresses(_ cosmos.Context, chain common.Chain) ([]cosmos.AccAddress, error) {
+	return k.chainAddrs[chain], nil
 }
 
 func (k *TestSlashObservingKeeper) ClearObservingAddresses(_ cosmos.Context) {
-	k.addrs = nil
+	k.chainAddrs = nil
+}
+
+func (k *TestSlashObservingKeeper) GetChainObservation(_ cosmos.Context, addr cosmos.AccAddress, chain common.Chain) (ChainObservation, error) {
+	if observation, ok := k.observations[addr.String()+chain.String()]; ok {
+		return observation, nil
+	}
+	return NewChainObservation(addr, chain), nil
+}
+
+func (k *TestSlashObservingKeeper) SetChainObservation(_ cosmos.Context, observation ChainObservation) error {
+	k.observations[observation.NodeAddress.String()+observation.Chain.String()] = observation
+	return nil
 }
 
 func (k *TestSlashObservingKeeper) IncNodeAccountSlashPoints(_ cosmos.Context, addr cosmos.AccAddress, pts int64) error {
@@ -48,6 +70,11 @@ func (k *TestSlashObservingKeeper) IncNodeAccountSlashPoints(_ cosmos.Context, a
 	return nil
 }
 
+func (k *TestSlashObservingKeeper) AppendSlashRecord(_ cosmos.Context, record SlashRecord) error {
+	k.slashRecords = append(k.slashRecords, record)
+	return nil
+}
+
 func (k *TestSlashObservingKeeper) ListActiveNodeAccounts(_ cosmos.Context) (NodeAccounts, error) {
 	if k.failListActiveNodeAccount {
 		return nil, kaboom
@@ -77,9 +104,12 @@ func (s *SlashingSuite) TestObservingSlashing(c *C) {
 		GetRandomNodeAccount(NodeActive),
 	}
 	keeper := &TestSlashObservingKeeper{
-		nas:      nas,
-		addrs:    []cosmos.AccAddress{nas[0].NodeAddress},
-		slashPts: make(map[string]int64, 0),
+		nas: nas,
+		chainAddrs: map[common.Chain][]cosmos.AccAddress{
+			common.BNBChain: {nas[0].NodeAddress},
+		},
+		observations: make(map[string]ChainObservation),
+		slashPts:     make(map[string]int64, 0),
 	}
 	ver := constants.SWVersion
 	constAccessor := constants.GetConstantValues(ver)
@@ -91,6 +121,11 @@ func (s *SlashingSuite) TestObservingSlashing(c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(keeper.slashPts[nas[0].NodeAddress.String()], Equals, int64(0))
 	c.Assert(keeper.slashPts[nas[1].NodeAddress.String()], Equals, lackOfObservationPenalty)
+	c.Assert(keeper.slashRecords, HasLen, 1)
+	c.Check(keeper.slashRecords[0].NodeAddress.Equals(nas[1].NodeAddress), Equals, true)
+	c.Check(keeper.slashRecords[0].Points, Equals, lackOfObservationPenalty)
+	c.Check(keeper.slashRecords[0].Reason, Equals, SlashReasonLackOfObserving)
+	c.Check(keeper.slashRecords[0].Chain.Equals(common.BNBChain), Equals, true)
 
 	// manually clear the observing address, as clear observing address had been moved to moduleManager begin block
 	keeper.ClearObservingAddresses(ctx)
@@ -102,6 +137,98 @@ func (s *SlashingSuite) TestObservingSlashing(c *C) {
 	c.Assert(keeper.slashPts[nas[1].NodeAddress.String()], Equals, lackOfObservationPenalty)
 }
 
+func (s *SlashingSuite) TestLackObservingPerChain(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	lackOfObservationPenalty := constAccessor.GetInt64Value(constants.LackOfObservationPenalty)
+	k.SetMimir(ctx, constants.ChainObservationWindowBlocks.String(), 10)
+
+	na1 := GetRandomNodeAccount(NodeActive)
+	na2 := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na1), IsNil)
+	c.Assert(k.SetNodeAccount(ctx, na2), IsNil)
+
+	// na2 still observe BNB , but stopped observing BTC
+	obMgr := NewObserverMgrV1()
+	obMgr.AppendObserver(common.BNBChain, []cosmos.AccAddress{na1.NodeAddress, na2.NodeAddress})
+	obMgr.AppendObserver(common.BTCChain, []cosmos.AccAddress{na1.NodeAddress})
+	obMgr.EndBlock(ctx, k)
+
+	slasher := NewSlasherV1(k)
+	c.Assert(slasher.LackObserving(ctx, constAccessor), IsNil)
+	pts, err := k.GetNodeAccountSlashPoints(ctx, na1.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, int64(0))
+	pts, err = k.GetNodeAccountSlashPoints(ctx, na2.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, lackOfObservationPenalty)
+	records, err := k.GetSlashRecords(ctx, na2.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Chain.Equals(common.BTCChain), Equals, true)
+
+	observation, err := k.GetChainObservation(ctx, na2.NodeAddress, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(observation.Total, Equals, int64(1))
+	c.Check(observation.Missed, Equals, int64(1))
+	observation, err = k.GetChainObservation(ctx, na2.NodeAddress, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(observation.Total, Equals, int64(1))
+	c.Check(observation.Missed, Equals, int64(0))
+
+	// no consensus on anything , nobody get slashed
+	k.ClearObservingAddresses(ctx)
+	c.Assert(slasher.LackObserving(ctx, constAccessor), IsNil)
+	pts, err = k.GetNodeAccountSlashPoints(ctx, na2.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, lackOfObservationPenalty)
+
+	// the counters roll over with the window
+	ctx = ctx.WithBlockHeight(common.BlockHeight(ctx) + 10)
+	obMgr.AppendObserver(common.BTCChain, []cosmos.AccAddress{na1.NodeAddress, na2.NodeAddress})
+	obMgr.EndBlock(ctx, k)
+	c.Assert(slasher.LackObserving(ctx, constAccessor), IsNil)
+	observation, err = k.GetChainObservation(ctx, na2.NodeAddress, common.BTCChain)
+	c.Assert(err, IsNil)
+	c.Check(observation.Total, Equals, int64(1))
+	c.Check(observation.Missed, Equals, int64(0))
+	c.Check(observation.GetTotal(), Equals, int64(2))
+	c.Check(observation.GetMissed(), Equals, int64(1))
+}
+
+func (s *SlashingSuite) TestLackObservingMaintenance(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	na1 := GetRandomNodeAccount(NodeActive)
+	na2 := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na1), IsNil)
+	c.Assert(k.SetNodeAccount(ctx, na2), IsNil)
+	height := common.BlockHeight(ctx)
+	c.Assert(k.SetNodeMaintenance(ctx, NewNodeMaintenance(na2.NodeAddress, height, height+10)), IsNil)
+
+	// na2 is in maintenance , it is excused for not observing
+	obMgr := NewObserverMgrV1()
+	obMgr.AppendObserver(common.BNBChain, []cosmos.AccAddress{na1.NodeAddress})
+	obMgr.EndBlock(ctx, k)
+
+	slasher := NewSlasherV1(k)
+	c.Assert(slasher.LackObserving(ctx, constAccessor), IsNil)
+	pts, err := k.GetNodeAccountSlashPoints(ctx, na2.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, int64(0))
+	observation, err := k.GetChainObservation(ctx, na2.NodeAddress, common.BNBChain)
+	c.Assert(err, IsNil)
+	c.Check(observation.Total, Equals, int64(0))
+
+	// maintenance is over
+	ctx = ctx.WithBlockHeight(height + 10)
+	c.Assert(slasher.LackObserving(ctx, constAccessor), IsNil)
+	pts, err = k.GetNodeAccountSlashPoints(ctx, na2.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(pts, Equals, constAccessor.GetInt64Value(constants.LackOfObservationPenalty))
+}
+
 func (s *SlashingSuite) TestLackObservingErrors(c *C) {
 	ctx, _ := setupKeeperForTest(c)
 
@@ -110,9 +237,12 @@ func (s *SlashingSuite) TestLackObservingErrors(c *C) {
 		GetRandomNodeAccount(NodeActive),
 	}
 	keeper := &TestSlashObservingKeeper{
-		nas:      nas,
-		addrs:    []cosmos.AccAddress{nas[0].NodeAddress},
-		slashPts: make(map[string]int64, 0),
+		nas: nas,
+		chainAddrs: map[common.Chain][]cosmos.AccAddress{
+			common.BNBChain: {nas[0].NodeAddress},
+		},
+		observations: make(map[string]ChainObservation),
+		slashPts:     make(map[string]int64, 0),
 	}
 	ver := constants.SWVersion
 	constAccessor := constants.GetConstantValues(ver)
@@ -388,8 +518,10 @@ func (s *SlashingSuite) TestNewSlasher(c *C) {
 		GetRandomNodeAccount(NodeActive),
 	}
 	keeper := &TestSlashObservingKeeper{
-		nas:      nas,
-		addrs:    []cosmos.AccAddress{nas[0].NodeAddress},
+		nas: nas,
+		chainAddrs: map[common.Chain][]cosmos.AccAddress{
+			common.BNBChain: {nas[0].NodeAddress},
+		},
 		slashPts: make(map[string]int64, 0),
 	}
 	slasher := NewSlasherV1(keeper)
@@ -478,7 +610,45 @@ func (s *SlashingSuite) TestIncreaseDecreaseSlashPoints(c *C) {
 	}
 	slasher := NewSlasherV1(keeper)
 	addr := GetRandomBech32Addr()
-	slasher.IncSlashPoints(ctx, 1, addr)
-	slasher.DecSlashPoints(ctx, 1, addr)
+	slasher.IncSlashPoints(ctx, 1, common.BlankTxID, addr)
+	slasher.DecSlashPoints(ctx, 1, common.BlankTxID, addr)
 	c.Assert(keeper.slashPoints[addr.String()], Equals, int64(0))
 }
+
+func (s *SlashingSuite) TestSlashBondRecords(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	FundModule(c, ctx, k, BondName, 1000)
+	slasher := NewSlasherV1(k)
+
+	na := GetRandomNodeAccount(NodeActive)
+	na.Bond = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	// the bond taken for double sign is recorded
+	pk, err := cosmos.GetPubKeyFromBech32(cosmos.Bech32PubKeyTypeConsPub, na.ValidatorConsPubKey)
+	c.Assert(err, IsNil)
+	c.Assert(slasher.HandleDoubleSign(ctx, pk.Address(), common.BlockHeight(ctx), constAccessor), IsNil)
+	records, err := k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 1)
+	c.Check(records[0].Reason, Equals, SlashReasonDoubleSign)
+	c.Check(records[0].Bond.Equal(cosmos.NewUint(5000000)), Equals, true, Commentf("%s", records[0].Bond))
+
+	// so is the bond taken for the funds sent out of a vault without a reason
+	c.Assert(slasher.SlashNodeAccount(ctx, na.PubKeySet.Secp256k1, common.BNBAsset, cosmos.NewUint(common.One), mgr), IsNil)
+	records, err = k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Check(records[1].Reason, Equals, SlashReasonLostFunds)
+	c.Check(records[1].PubKey.Equals(na.PubKeySet.Secp256k1), Equals, true)
+	c.Check(records[1].Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(records[1].Bond.Equal(cosmos.NewUint(common.One*3/2)), Equals, true, Commentf("%s", records[1].Bond))
+}
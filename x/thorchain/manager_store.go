@@ -51,6 +51,13 @@ func (smgr *StoreMgr) Iterator(ctx cosmos.Context) error {
 func (smgr *StoreMgr) migrate(ctx cosmos.Context, i uint64) error {
 	ctx.Logger().Info("Migrating store to new version", "version", i)
 	// add the logic to migrate store here when it is needed
+	switch i {
+	case 7:
+		// shipped with 0.7.0 , run once all the active node accounts upgraded
+		if err := migrateStoreV7(ctx, smgr.keeper); err != nil {
+			return fmt.Errorf("fail to migrate store to version %d: %w", i, err)
+		}
+	}
 	smgr.keeper.SetStoreVersion(ctx, int64(i))
 	return nil
 }
@@ -0,0 +1,50 @@
+package thorchain
+
+import (
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// migrateStoreV7 record the deposits of the stakers which were saved before deposits were tracked
+// the original deposits are unknown , so the redeemable value of their units at current pool depths is used instead
+// every staker is saved again , which build the index from rune address and asset address to the staker , and the index
+// of the stakers with pending RUNE
+func migrateStoreV7(ctx cosmos.Context, keeper keeper.Keeper) error {
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		return ErrInternal(err, "fail to get pools")
+	}
+	for _, pool := range pools {
+		stakers := make([]Staker, 0)
+		iterator := keeper.GetStakerIterator(ctx, pool.Asset)
+		for ; iterator.Valid(); iterator.Next() {
+			var staker Staker
+			if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &staker); err != nil {
+				ctx.Logger().Error("fail to unmarshal staker", "error", err)
+				continue
+			}
+			if staker.RuneDeposit == (cosmos.Uint{}) {
+				staker.RuneDeposit = cosmos.ZeroUint()
+			}
+			if staker.AssetDeposit == (cosmos.Uint{}) {
+				staker.AssetDeposit = cosmos.ZeroUint()
+			}
+			stakers = append(stakers, staker)
+		}
+		iterator.Close()
+		for _, staker := range stakers {
+			// every stake add to the deposits , a staker with units but no deposits is saved before deposits were recorded
+			if !staker.Units.IsZero() && staker.RuneDeposit.IsZero() && staker.AssetDeposit.IsZero() {
+				runeAmt, assetAmt, _, err := calculateUnstake(pool.PoolUnits, pool.BalanceRune, pool.BalanceAsset, staker.Units, cosmos.NewUint(MaxUnstakeBasisPoints))
+				if err != nil {
+					ctx.Logger().Error("fail to calculate staker deposits", "pool", pool.Asset, "staker", staker.RuneAddress, "error", err)
+				} else {
+					staker.RuneDeposit = runeAmt
+					staker.AssetDeposit = assetAmt
+				}
+			}
+			keeper.SetStaker(ctx, staker)
+		}
+	}
+	return nil
+}
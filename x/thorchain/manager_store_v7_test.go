@@ -0,0 +1,127 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type MigrateV7Suite struct{}
+
+var _ = Suite(&MigrateV7Suite{})
+
+func (s *MigrateV7Suite) TestMigrate(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(200 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	// staker saved before deposits were recorded
+	oldStaker := Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		AssetAddress:    GetRandomBNBAddress(),
+		LastStakeHeight: 1,
+		Units:           cosmos.NewUint(25 * common.One),
+		PendingRune:     cosmos.ZeroUint(),
+	}
+	k.SetStaker(ctx, oldStaker)
+	newStaker := Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		AssetAddress:    GetRandomBNBAddress(),
+		LastStakeHeight: 1,
+		Units:           cosmos.NewUint(75 * common.One),
+		PendingRune:     cosmos.ZeroUint(),
+		RuneDeposit:     cosmos.NewUint(120 * common.One),
+		AssetDeposit:    cosmos.NewUint(80 * common.One),
+	}
+	k.SetStaker(ctx, newStaker)
+	// staker with pending rune saved before the pending rune index
+	pendingStaker := Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		LastStakeHeight: 1,
+		Units:           cosmos.ZeroUint(),
+		PendingRune:     cosmos.NewUint(common.One),
+		PendingTxID:     GetRandomTxHash(),
+	}
+	k.SetStaker(ctx, pendingStaker)
+	ctx.KVStore(keyThorchain).Delete([]byte(k.GetKey(ctx, "staker_pending/", fmt.Sprintf("%020d/%s", pendingStaker.LastStakeHeight, pendingStaker.Key()))))
+	stakers, err := k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 0)
+
+	c.Assert(migrateStoreV7(ctx, k), IsNil)
+	staker, err := k.GetStaker(ctx, common.BNBAsset, oldStaker.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(staker.RuneDeposit.Equal(cosmos.NewUint(50*common.One)), Equals, true, Commentf("%d", staker.RuneDeposit.Uint64()))
+	c.Check(staker.AssetDeposit.Equal(cosmos.NewUint(25*common.One)), Equals, true, Commentf("%d", staker.AssetDeposit.Uint64()))
+	staker, err = k.GetStaker(ctx, common.BNBAsset, newStaker.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(staker.RuneDeposit.Equal(cosmos.NewUint(120*common.One)), Equals, true)
+	c.Check(staker.AssetDeposit.Equal(cosmos.NewUint(80*common.One)), Equals, true)
+	stakers, err = k.GetStakersByAddress(ctx, oldStaker.AssetAddress)
+	c.Assert(err, IsNil)
+	c.Check(stakers, HasLen, 1)
+	stakers, err = k.GetStakersWithPendingRune(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(stakers, HasLen, 1)
+	c.Check(stakers[0].RuneAddress.Equals(pendingStaker.RuneAddress), Equals, true)
+}
+
+func (s *MigrateV7Suite) TestMigrateThroughStoreMgr(c *C) {
+	swVersion := constants.SWVersion
+	defer func() {
+		constants.SWVersion = swVersion
+	}()
+	constants.SWVersion = semver.MustParse("0.7.0")
+
+	ctx, k := setupKeeperForTest(c)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(200 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	oldStaker := Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		AssetAddress:    GetRandomBNBAddress(),
+		LastStakeHeight: 1,
+		Units:           cosmos.NewUint(25 * common.One),
+		PendingRune:     cosmos.ZeroUint(),
+	}
+	k.SetStaker(ctx, oldStaker)
+
+	// the network still run 0.6.0 , nothing to migrate
+	na := GetRandomNodeAccount(NodeActive)
+	na.Version = semver.MustParse("0.6.0")
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	smgr := NewStoreMgr(k)
+	c.Assert(smgr.Iterator(ctx), IsNil)
+	c.Check(k.GetStoreVersion(ctx), Equals, int64(6))
+	staker, err := k.GetStaker(ctx, common.BNBAsset, oldStaker.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(staker.RuneDeposit.IsZero(), Equals, true)
+
+	// all the active node accounts upgraded to 0.7.0
+	na.Version = semver.MustParse("0.7.0")
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	c.Assert(smgr.Iterator(ctx), IsNil)
+	c.Check(k.GetStoreVersion(ctx), Equals, int64(7))
+	staker, err = k.GetStaker(ctx, common.BNBAsset, oldStaker.RuneAddress)
+	c.Assert(err, IsNil)
+	c.Check(staker.RuneDeposit.Equal(cosmos.NewUint(50*common.One)), Equals, true)
+	c.Check(staker.AssetDeposit.Equal(cosmos.NewUint(25*common.One)), Equals, true)
+}
@@ -1,6 +1,8 @@
 package thorchain
 
 import (
+	"errors"
+	"fmt"
 	"sort"
 
 	"github.com/blang/semver"
@@ -38,12 +40,34 @@ func (vm *SwapQv1) FetchQueue(ctx cosmos.Context) ([]MsgSwap, error) {
 		if err := vm.k.Cdc().UnmarshalBinaryBare(iterator.Value(), &msg); err != nil {
 			return msgs, err
 		}
-		msgs = append(msgs, msg)
+		msgs = append(msgs, msg.WithDefaults())
 	}
 
 	return msgs, nil
 }
 
+// splitReady - split the queued swaps into the ones that can be processed on the current block, and the
+// streaming swaps that are waiting for their next sub-swap to be due
+func (vm *SwapQv1) splitReady(ctx cosmos.Context, msgs []MsgSwap) ([]MsgSwap, []MsgSwap) {
+	ready := make([]MsgSwap, 0, len(msgs))
+	waiting := make([]MsgSwap, 0)
+	for _, msg := range msgs {
+		if msg.IsStreaming() {
+			stream, err := vm.k.GetStreamingSwap(ctx, msg.Tx.ID)
+			if err != nil {
+				ctx.Logger().Error("fail to get streaming swap", "tx id", msg.Tx.ID, "error", err)
+				continue
+			}
+			if !stream.IsEmpty() && !stream.IsReady(common.BlockHeight(ctx)) {
+				waiting = append(waiting, msg)
+				continue
+			}
+		}
+		ready = append(ready, msg)
+	}
+	return ready, waiting
+}
+
 // EndBlock trigger the real swap to be processed
 func (vm *SwapQv1) EndBlock(ctx cosmos.Context, mgr Manager, version semver.Version, constAccessor constants.ConstantValues) error {
 	handler := NewSwapHandler(vm.k, mgr)
@@ -54,159 +78,428 @@ func (vm *SwapQv1) EndBlock(ctx cosmos.Context, mgr Manager, version semver.Vers
 		return err
 	}
 
-	swaps, err := vm.scoreMsgs(ctx, msgs)
+	ready, _ := vm.splitReady(ctx, msgs)
+	swaps, err := vm.scoreMsgs(ctx, ready)
 	if err != nil {
 		ctx.Logger().Error("fail to fetch swap items", "error", err)
 		// continue, don't exit, just do them out of order (instead of not at all)
 	}
 	swaps = swaps.Sort()
 
-	for i := 0; i < vm.getTodoNum(len(swaps)); i++ {
+	for i := 0; i < vm.getTodoNum(ctx, len(swaps), constAccessor); i++ {
 		pick := swaps[i]
 
+		if pick.msg.IsStreaming() {
+			done, err := vm.processStreamingSwap(ctx, mgr, pick.msg, constAccessor)
+			if err != nil {
+				ctx.Logger().Error("fail to process streaming swap", "msg", pick.msg.Tx.String(), "error", err)
+			}
+			if !done {
+				continue
+			}
+			vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID)
+			continue
+		}
+
 		_, err := handler.handle(ctx, pick.msg, version, constAccessor)
 		if err != nil {
 			ctx.Logger().Error("fail to swap", "msg", pick.msg.Tx.String(), "error", err)
-			if newErr := refundTx(ctx, ObservedTx{Tx: pick.msg.Tx}, mgr, vm.k, constAccessor, CodeSwapFail, err.Error(), ""); nil != newErr {
+			if newErr := refundTx(ctx, ObservedTx{Tx: pick.msg.Tx}, mgr, vm.k, constAccessor, getSwapRefundCode(err), err.Error(), ""); nil != newErr {
 				ctx.Logger().Error("fail to refund swap", "error", err)
 			}
 		}
 		vm.k.RemoveSwapQueueItem(ctx, pick.msg.Tx.ID)
 	}
 
+	if err := vm.processLimitOrders(ctx, mgr, constAccessor); err != nil {
+		ctx.Logger().Error("fail to process limit orders", "error", err)
+	}
+
+	return nil
+}
+
+// FetchLimitOrders - grabs all limit orders from the kvstore and returns them
+func (vm *SwapQv1) FetchLimitOrders(ctx cosmos.Context) (LimitOrders, error) {
+	orders := make(LimitOrders, 0)
+	iterator := vm.k.GetLimitOrderIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var order LimitOrder
+		if err := vm.k.Cdc().UnmarshalBinaryBare(iterator.Value(), &order); err != nil {
+			return orders, err
+		}
+		orders = append(orders, order)
+	}
+	return orders, nil
+}
+
+// processLimitOrders - refund the limit orders that are expired , and fill the ones that can emit at least the min out
+// with current pool depths , the rest stay in the kv store until the next block. At most MaxLimitOrdersPerBlock orders are
+// expired , at most MaxLimitOrdersPerBlock orders are filled , and at most MaxLimitOrdersPerBlock orders that fail to fill
+// are skipped in one block
+func (vm *SwapQv1) processLimitOrders(ctx cosmos.Context, mgr Manager, constAccessor constants.ConstantValues) error {
+	maxOrders, err := vm.k.GetMimir(ctx, constants.MaxLimitOrdersPerBlock.String())
+	if maxOrders < 0 || err != nil {
+		maxOrders = constAccessor.GetInt64Value(constants.MaxLimitOrdersPerBlock)
+	}
+	height := common.BlockHeight(ctx)
+	expired, err := vm.k.GetExpiredLimitOrders(ctx, height, int(maxOrders))
+	if err != nil {
+		return fmt.Errorf("fail to get expired limit orders: %w", err)
+	}
+	for _, order := range expired {
+		vm.k.RemoveLimitOrder(ctx, order.Tx.ID)
+		if err := refundTx(ctx, ObservedTx{Tx: order.Tx}, mgr, vm.k, constAccessor, CodeLimitOrderExpired, "limit order expired", ""); err != nil {
+			ctx.Logger().Error("fail to refund expired limit order", "tx id", order.Tx.ID, "error", err)
+		}
+		if err := mgr.EventMgr().EmitEvent(ctx, NewEventLimitOrder(order, LimitOrderExpired, cosmos.ZeroUint())); err != nil {
+			ctx.Logger().Error("fail to emit limit order event", "error", err)
+		}
+	}
+
+	haltTrading, err := vm.k.GetMimir(ctx, "HaltTrading")
+	if (haltTrading > 0 && haltTrading < height && err == nil) || vm.k.RagnarokInProgress(ctx) {
+		return nil
+	}
+	pairs, err := vm.k.GetLimitOrderPairs(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get limit order pairs: %w", err)
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+	transactionFee := constAccessor.GetInt64Value(constants.TransactionFee)
+	// only the orders that are filled count toward the budget , orders that pass the pool price but fail on slip are
+	// skipped with a budget of their own , so a few of them at the top of a pair don't hold back the orders behind them
+	todo := int(maxOrders)
+	skips := int(maxOrders)
+	// start from a different pair every block , so the pairs at the end of the list get evaluated even when the orders of
+	// the first ones use up the whole budget
+	first := int(height % int64(len(pairs)))
+	for i := 0; i < len(pairs) && todo > 0 && skips > 0; i++ {
+		pair := pairs[(first+i)%len(pairs)]
+		orders, err := vm.k.GetLimitOrdersByRate(ctx, pair.Source, pair.Target, todo+skips)
+		if err != nil {
+			return fmt.Errorf("fail to get limit orders of %s to %s: %w", pair.Source, pair.Target, err)
+		}
+		for _, order := range orders {
+			if todo <= 0 || skips <= 0 {
+				break
+			}
+			// expired orders that didn't fit in this block are refunded in the next one
+			if order.IsExpired(height) {
+				continue
+			}
+			// the pool price is the best rate a swap can get , orders are sorted by rate , thus when the pool price can't
+			// emit the min out of this order , none of the following orders of the pair can be filled either
+			spotOut, err := getSpotSwapOut(ctx, vm.k, order.Tx.Coins[0], order.TargetAsset)
+			if err != nil || spotOut.LT(order.MinOut) {
+				break
+			}
+			// the pools are not touched when the swap can't emit the min out , in which case the order wait for the next block
+			amount, events, swapErr := swap(ctx, vm.k, order.Tx, order.TargetAsset, order.Destination, order.MinOut, cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.NewUint(uint64(transactionFee)))
+			if swapErr != nil {
+				skips--
+				continue
+			}
+			todo--
+			vm.k.RemoveLimitOrder(ctx, order.Tx.ID)
+			if err := vm.settleLimitOrder(ctx, mgr, order, amount, events); err != nil {
+				ctx.Logger().Error("fail to settle limit order", "tx id", order.Tx.ID, "error", err)
+				if newErr := refundTx(ctx, ObservedTx{Tx: order.Tx}, mgr, vm.k, constAccessor, CodeSwapFail, err.Error(), ""); newErr != nil {
+					ctx.Logger().Error("fail to refund limit order", "error", newErr)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// settleLimitOrder - send out the target asset of a filled limit order
+func (vm *SwapQv1) settleLimitOrder(ctx cosmos.Context, mgr Manager, order LimitOrder, amount cosmos.Uint, events []EventSwap) error {
+	for _, evt := range events {
+		if err := mgr.EventMgr().EmitSwapEvent(ctx, evt); err != nil {
+			ctx.Logger().Error("fail to emit swap event", "error", err)
+		}
+		if err := vm.k.AddToLiquidityFees(ctx, evt.Pool, evt.LiquidityFeeInRune); err != nil {
+			return err
+		}
+	}
+	toi := &TxOutItem{
+		Chain:     order.TargetAsset.Chain,
+		InHash:    order.Tx.ID,
+		ToAddress: order.Destination,
+		Coin:      common.NewCoin(order.TargetAsset, amount),
+	}
+	ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi)
+	if err != nil {
+		// when the emit asset is not enough to pay for tx fee, consider it as a success
+		if !errors.Is(err, ErrNotEnoughToPayFee) {
+			return ErrInternal(err, "fail to add outbound tx")
+		}
+		ok = true
+	}
+	if !ok {
+		return errFailAddOutboundTx
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventLimitOrder(order, LimitOrderFilled, amount)); err != nil {
+		ctx.Logger().Error("fail to emit limit order event", "error", err)
+	}
+	return nil
+}
+
+// processStreamingSwap - execute the next sub-swap of a streaming swap, it returns true when the streaming swap is finished
+// and can be removed from the swap queue
+func (vm *SwapQv1) processStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap, constAccessor constants.ConstantValues) (bool, error) {
+	stream, err := vm.k.GetStreamingSwap(ctx, msg.Tx.ID)
+	if err != nil {
+		return false, fmt.Errorf("fail to get streaming swap: %w", err)
+	}
+	source := msg.Tx.Coins[0]
+	if stream.IsEmpty() {
+		if err := validateStreamingSwap(ctx, vm.k, msg, constAccessor); err != nil {
+			if refundErr := refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, vm.k, constAccessor, CodeSwapFailInvalidStream, err.Error(), ""); refundErr != nil {
+				return true, fmt.Errorf("fail to refund streaming swap: %w", refundErr)
+			}
+			return true, nil
+		}
+		deposit := source.Amount
+		// affiliate fee is paid upfront , the rest of the deposit get streamed
+		if msg.HasAffiliateFee() {
+			if err := validateAffiliateFee(ctx, vm.k, msg.AffiliateBasisPoints, constAccessor); err != nil {
+				if refundErr := refundTx(ctx, ObservedTx{Tx: msg.Tx}, mgr, vm.k, constAccessor, CodeInvalidAffiliateFee, err.Error(), ""); refundErr != nil {
+					return true, fmt.Errorf("fail to refund streaming swap: %w", refundErr)
+				}
+				return true, nil
+			}
+			affiliateFee := getAffiliateFee(source, msg.AffiliateBasisPoints)
+			deposit = common.SafeSub(deposit, affiliateFee.Amount)
+			payAffiliateFee(ctx, mgr, vm.k, msg.Tx, affiliateFee, msg.AffiliateAddress, msg.AffiliateBasisPoints, constAccessor)
+		}
+		stream = NewStreamingSwap(msg.Tx.ID, msg.StreamInterval, msg.StreamQuantity, deposit)
+	}
+
+	swapIn := stream.NextSize()
+	tx := msg.Tx
+	tx.Coins = common.Coins{common.NewCoin(source.Asset, swapIn)}
+	// price limit and liquidity fee limit are given for the whole deposit, thus each sub-swap get its share of it
+	tradeTarget := common.GetShare(swapIn, stream.Deposit, msg.TradeTarget)
+	maxLiquidityFee := common.GetShare(swapIn, stream.Deposit, msg.MaxLiquidityFee)
+	// outbound fee is taken once when the accumulated output is sent out , thus it doesn't apply to sub-swaps
+	swapOut, events, swapErr := swap(ctx, vm.k, tx, msg.TargetAsset, msg.Destination, tradeTarget, msg.MaxSlip, maxLiquidityFee, cosmos.ZeroUint())
+
+	stream.Count++
+	stream.LastHeight = common.BlockHeight(ctx)
+	failReason := ""
+	if swapErr != nil {
+		ctx.Logger().Error("fail to execute streaming sub-swap", "tx id", msg.Tx.ID, "count", stream.Count, "error", swapErr)
+		failReason = swapErr.Error()
+		swapOut = cosmos.ZeroUint()
+		stream.AddFailedSwap(stream.Count, failReason)
+	} else {
+		stream.In = stream.In.Add(swapIn)
+		stream.Out = stream.Out.Add(swapOut)
+		for _, evt := range events {
+			if err := mgr.EventMgr().EmitSwapEvent(ctx, evt); err != nil {
+				ctx.Logger().Error("fail to emit swap event", "error", err)
+			}
+			if err := vm.k.AddToLiquidityFees(ctx, evt.Pool, evt.LiquidityFeeInRune); err != nil {
+				ctx.Logger().Error("fail to add liquidity fees", "error", err)
+			}
+		}
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventStreamingSwap(stream, swapIn, swapOut, failReason)); err != nil {
+		ctx.Logger().Error("fail to emit streaming swap event", "error", err)
+	}
+
+	if !stream.IsDone() {
+		vm.k.SetStreamingSwap(ctx, stream)
+		return false, nil
+	}
+	vm.k.RemoveStreamingSwap(ctx, stream.TxID)
+	return true, vm.settleStreamingSwap(ctx, mgr, msg, stream, constAccessor)
+}
+
+// settleStreamingSwap - send out the accumulated output of a finished streaming swap , and refund whatever could not be swapped
+func (vm *SwapQv1) settleStreamingSwap(ctx cosmos.Context, mgr Manager, msg MsgSwap, stream StreamingSwap, constAccessor constants.ConstantValues) error {
+	if !stream.Out.IsZero() {
+		toi := &TxOutItem{
+			Chain:     msg.TargetAsset.Chain,
+			InHash:    msg.Tx.ID,
+			ToAddress: msg.Destination,
+			Coin:      common.NewCoin(msg.TargetAsset, stream.Out),
+		}
+		ok, err := mgr.TxOutStore().TryAddTxOutItem(ctx, mgr, toi)
+		if err != nil {
+			// when the emit asset is not enough to pay for tx fee, consider it as a success
+			if !errors.Is(err, ErrNotEnoughToPayFee) {
+				return ErrInternal(err, "fail to add outbound tx")
+			}
+			ok = true
+		}
+		if !ok {
+			return errFailAddOutboundTx
+		}
+	}
+
+	remainder := stream.Remainder()
+	if remainder.IsZero() {
+		return nil
+	}
+	tx := msg.Tx
+	tx.Coins = common.Coins{common.NewCoin(msg.Tx.Coins[0].Asset, remainder)}
+	reason := "streaming swap not fully filled"
+	if len(stream.FailedSwapReasons) > 0 {
+		reason = stream.FailedSwapReasons[len(stream.FailedSwapReasons)-1]
+	}
+	if err := refundTx(ctx, ObservedTx{Tx: tx}, mgr, vm.k, constAccessor, CodeSwapFail, reason, ""); err != nil {
+		return fmt.Errorf("fail to refund streaming swap remainder: %w", err)
+	}
 	return nil
 }
 
 // getTodoNum - determine how many swaps to do.
-func (vm *SwapQv1) getTodoNum(queueLen int) int {
-	// Do half the length of the queue. Unless...
-	//	1. The queue length is greater than 200
-	//  2. The queue legnth is less than 10
-	maxSwaps := 100 // TODO: make this a constant
-	minSwaps := 10  // TODO: make this a constant
-	todo := queueLen / 2
-	if minSwaps >= queueLen {
+func (vm *SwapQv1) getTodoNum(ctx cosmos.Context, queueLen int, constAccessor constants.ConstantValues) int {
+	// Do 1/SwapQueueDivisor of the queue. Unless...
+	//	1. The result is greater than MaxSwapsPerBlock
+	//  2. The queue length is less than MinSwapsPerBlock
+	minSwaps, maxSwaps, divisor := vm.getSwapQueueLimits(ctx, constAccessor)
+	todo := queueLen / int(divisor)
+	if int(minSwaps) >= queueLen {
 		todo = queueLen
 	}
-	if maxSwaps < todo {
-		todo = maxSwaps
+	if int(maxSwaps) < todo {
+		todo = int(maxSwaps)
 	}
 	return todo
 }
 
+// getSwapQueueLimits - return the min swaps per block , max swaps per block and swap queue divisor , mimir take precedence over constants
+func (vm *SwapQv1) getSwapQueueLimits(ctx cosmos.Context, constAccessor constants.ConstantValues) (int64, int64, int64) {
+	minSwaps, err := vm.k.GetMimir(ctx, constants.MinSwapsPerBlock.String())
+	if minSwaps < 0 || err != nil {
+		minSwaps = constAccessor.GetInt64Value(constants.MinSwapsPerBlock)
+	}
+	maxSwaps, err := vm.k.GetMimir(ctx, constants.MaxSwapsPerBlock.String())
+	if maxSwaps < 0 || err != nil {
+		maxSwaps = constAccessor.GetInt64Value(constants.MaxSwapsPerBlock)
+	}
+	divisor, err := vm.k.GetMimir(ctx, constants.SwapQueueDivisor.String())
+	if divisor <= 0 || err != nil {
+		divisor = constAccessor.GetInt64Value(constants.SwapQueueDivisor)
+	}
+	if divisor <= 0 {
+		divisor = 1
+	}
+	return minSwaps, maxSwaps, divisor
+}
+
 // scoreMsgs - this takes a list of MsgSwap, and converts them to a scored
-// swapItem list
+// swapItem list , a double swap is scored by the sum of both legs
 func (vm *SwapQv1) scoreMsgs(ctx cosmos.Context, msgs []MsgSwap) (swapItems, error) {
 	pools := make(map[common.Asset]Pool, 0)
-	items := make(swapItems, 0)
+	items := make(swapItems, 0, len(msgs))
 
-	for _, msg := range msgs {
-		if _, ok := pools[msg.TargetAsset]; !ok {
-			var err error
-			pools[msg.TargetAsset], err = vm.k.GetPool(ctx, msg.TargetAsset)
-			if err != nil {
-				return items, err
-			}
+	getPool := func(asset common.Asset) (Pool, error) {
+		if pool, ok := pools[asset]; ok {
+			return pool, nil
+		}
+		pool, err := vm.k.GetPool(ctx, asset)
+		if err != nil {
+			return pool, err
 		}
+		pools[asset] = pool
+		return pool, nil
+	}
 
+	for _, msg := range msgs {
 		item := swapItem{
 			msg:  msg,
 			fee:  cosmos.ZeroUint(),
 			slip: cosmos.ZeroUint(),
 		}
-
-		pool := pools[msg.TargetAsset]
-		if pool.IsEmpty() || !pool.IsEnabled() || pool.BalanceRune.IsZero() || pool.BalanceAsset.IsZero() {
-			items = append(items, item)
-			continue
-		}
-
 		sourceCoin := msg.Tx.Coins[0]
-
-		// Get our X, x, Y values
-		var X, x, Y cosmos.Uint
-		x = sourceCoin.Amount
-		if sourceCoin.Asset.IsRune() {
-			X = pool.BalanceRune
-			Y = pool.BalanceAsset
-		} else {
-			Y = pool.BalanceRune
-			X = pool.BalanceAsset
+		amount := sourceCoin.Amount
+		legs := make([]common.Asset, 0, 2)
+		if !sourceCoin.Asset.IsRune() {
+			legs = append(legs, sourceCoin.Asset)
 		}
-
-		item.fee = calcLiquidityFee(X, x, Y)
-		if sourceCoin.Asset.IsRune() {
-			item.fee = pool.AssetValueInRune(item.fee)
+		if !msg.TargetAsset.IsRune() {
+			legs = append(legs, msg.TargetAsset)
 		}
-		item.slip = calcTradeSlip(X, x)
 
+		fee := cosmos.ZeroUint()
+		slip := cosmos.ZeroUint()
+		scorable := true
+		for i, asset := range legs {
+			pool, err := getPool(asset)
+			if err != nil {
+				return items, err
+			}
+			if pool.IsEmpty() || !pool.IsEnabled() || pool.BalanceRune.IsZero() || pool.BalanceAsset.IsZero() {
+				scorable = false
+				break
+			}
+			// only the first leg of an asset -> asset swap is selling the source asset , the rest are buying with RUNE
+			runeIn := i > 0 || sourceCoin.Asset.IsRune()
+			legFee, legSlip, emit := scoreSwapLeg(pool, amount, runeIn)
+			fee = fee.Add(legFee)
+			slip = slip.Add(legSlip)
+			amount = emit
+		}
+		if scorable {
+			item.fee = fee
+			item.slip = slip
+		}
 		items = append(items, item)
 	}
 
 	return items, nil
 }
 
-func (items swapItems) Sort() swapItems {
-	// sort by liquidity fee , descending
-	byFee := items
-	sort.SliceStable(byFee, func(i, j int) bool {
-		return byFee[i].fee.GT(byFee[j].fee)
-	})
-
-	// sort by slip fee , descending
-	bySlip := items
-	sort.SliceStable(bySlip, func(i, j int) bool {
-		return bySlip[i].slip.GT(bySlip[j].slip)
-	})
-
-	type score struct {
-		msg   MsgSwap
-		score int
+// scoreSwapLeg - calculate the liquidity fee in RUNE, trade slip and emit amount of a single pool swap
+func scoreSwapLeg(pool Pool, x cosmos.Uint, runeIn bool) (cosmos.Uint, cosmos.Uint, cosmos.Uint) {
+	// Get our X, x, Y values
+	var X, Y cosmos.Uint
+	if runeIn {
+		X = pool.BalanceRune
+		Y = pool.BalanceAsset
+	} else {
+		Y = pool.BalanceRune
+		X = pool.BalanceAsset
 	}
 
-	// add liquidity fee score
-	scores := make([]score, len(items))
-	for i, item := range byFee {
-		scores[i] = score{
-			msg:   item.msg,
-			score: i,
-		}
+	fee := calcLiquidityFee(X, x, Y)
+	if runeIn {
+		fee = pool.AssetValueInRune(fee)
 	}
+	return fee, calcTradeSlip(X, x), calcAssetEmission(X, x, Y)
+}
 
-	// add slip score
-	for i, item := range bySlip {
-		for j, score := range scores {
-			if score.msg.Tx.ID.Equals(item.msg.Tx.ID) {
-				scores[j].score += i
-				break
-			}
-		}
+// Sort - order the swap items by trade slip , descending , swaps with the same slip are ordered by liquidity fee , descending ,
+// and every remaining tie is broken by tx id , so the result only depends on the content of the items and every node get the same order
+func (items swapItems) Sort() swapItems {
+	ids := make([]string, len(items))
+	order := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.msg.Tx.ID.String()
+		order[i] = i
 	}
 
-	// This sorted appears to sort twice, but actually the first sort informs
-	// the second. If we have multiple swaps with the same score, it will use
-	// the ID sort to deterministically sort within the same score
-
-	// sort by ID, first
-	sort.SliceStable(scores, func(i, j int) bool {
-		return scores[i].msg.Tx.ID.String() < scores[j].msg.Tx.ID.String()
-	})
-
-	// sort by score, second
-	sort.SliceStable(scores, func(i, j int) bool {
-		return scores[i].score < scores[j].score
+	sort.Slice(order, func(i, j int) bool {
+		a, b := items[order[i]], items[order[j]]
+		if !a.slip.Equal(b.slip) {
+			return a.slip.GT(b.slip)
+		}
+		if !a.fee.Equal(b.fee) {
+			return a.fee.GT(b.fee)
+		}
+		return ids[order[i]] < ids[order[j]]
 	})
 
-	// sort our items by score
 	sorted := make(swapItems, len(items))
-	for i, score := range scores {
-		for _, item := range items {
-			if item.msg.Tx.ID.Equals(score.msg.Tx.ID) {
-				sorted[i] = item
-				break
-			}
-		}
+	for i, idx := range order {
+		sorted[i] = items[idx]
 	}
-
 	return sorted
 }
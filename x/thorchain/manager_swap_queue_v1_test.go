@@ -1,10 +1,14 @@
 package thorchain
 
 import (
+	"math/rand"
+	"testing"
+
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 	keeper "gitlab.com/thorchain/thornode/x/thorchain/keeper"
 )
 
@@ -13,15 +17,36 @@ type SwapQueueSuite struct{}
 var _ = Suite(&SwapQueueSuite{})
 
 func (s SwapQueueSuite) TestGetTodoNum(c *C) {
+	ctx, _ := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
 	queue := NewSwapQv1(keeper.KVStoreDummy{})
 
-	c.Check(queue.getTodoNum(50), Equals, 25)     // halves it
-	c.Check(queue.getTodoNum(11), Equals, 5)      // halves it
-	c.Check(queue.getTodoNum(10), Equals, 10)     // does all of them
-	c.Check(queue.getTodoNum(1), Equals, 1)       // does all of them
-	c.Check(queue.getTodoNum(0), Equals, 0)       // does none
-	c.Check(queue.getTodoNum(10000), Equals, 100) // does max 100
-	c.Check(queue.getTodoNum(200), Equals, 100)   // does max 100
+	c.Check(queue.getTodoNum(ctx, 50, constAccessor), Equals, 25)     // halves it
+	c.Check(queue.getTodoNum(ctx, 11, constAccessor), Equals, 5)      // halves it
+	c.Check(queue.getTodoNum(ctx, 10, constAccessor), Equals, 10)     // does all of them
+	c.Check(queue.getTodoNum(ctx, 1, constAccessor), Equals, 1)       // does all of them
+	c.Check(queue.getTodoNum(ctx, 0, constAccessor), Equals, 0)       // does none
+	c.Check(queue.getTodoNum(ctx, 10000, constAccessor), Equals, 100) // does max 100
+	c.Check(queue.getTodoNum(ctx, 200, constAccessor), Equals, 100)   // does max 100
+}
+
+func (s SwapQueueSuite) TestGetTodoNumMimir(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	queue := NewSwapQv1(k)
+
+	k.SetMimir(ctx, constants.MaxSwapsPerBlock.String(), 300)
+	k.SetMimir(ctx, constants.MinSwapsPerBlock.String(), 20)
+	k.SetMimir(ctx, constants.SwapQueueDivisor.String(), 1)
+	c.Check(queue.getTodoNum(ctx, 50, constAccessor), Equals, 50)
+	c.Check(queue.getTodoNum(ctx, 10000, constAccessor), Equals, 300)
+
+	// invalid mimir values fall back to constants
+	k.SetMimir(ctx, constants.MaxSwapsPerBlock.String(), -1)
+	k.SetMimir(ctx, constants.SwapQueueDivisor.String(), 0)
+	c.Check(queue.getTodoNum(ctx, 50, constAccessor), Equals, 25)
+	c.Check(queue.getTodoNum(ctx, 20, constAccessor), Equals, 20)
+	c.Check(queue.getTodoNum(ctx, 10000, constAccessor), Equals, 100)
 }
 
 func (s SwapQueueSuite) TestScoreMsgs(c *C) {
@@ -153,3 +178,403 @@ func (s SwapQueueSuite) TestScoreMsgs(c *C) {
 	c.Check(swaps[9].msg.Tx.Coins[0].Amount.Equal(cosmos.NewUint(1*common.One)), Equals, true, Commentf("%d", swaps[0].msg.Tx.Coins[0].Amount.Uint64()))
 	c.Check(swaps[9].msg.Tx.Coins[0].Asset.Equals(common.BNBAsset), Equals, true)
 }
+
+func (s SwapQueueSuite) TestStreamingSwap(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	destination := GetRandomBNBAddress()
+	tx := common.NewTx(GetRandomTxHash(), destination, GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(30*common.One))},
+		BNBGasFeeSingleton, "")
+	msg := NewMsgSwap(tx, common.BNBAsset, destination, cosmos.ZeroUint(), GetRandomBech32Addr())
+	msg.StreamInterval = 2
+	msg.StreamQuantity = 3
+	c.Assert(k.SetSwapQueueItem(ctx, msg), IsNil)
+
+	queue := NewSwapQv1(k)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	stream, err := k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(stream.Count, Equals, int64(1))
+	c.Check(stream.LastHeight, Equals, int64(10))
+	c.Check(stream.In.Equal(cosmos.NewUint(10*common.One)), Equals, true)
+	c.Check(stream.Out.Equal(cosmos.NewUint(826446280)), Equals, true, Commentf("%d", stream.Out.Uint64()))
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	// next sub-swap is not due yet
+	ctx = ctx.WithBlockHeight(11)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	stream, err = k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(stream.Count, Equals, int64(1))
+
+	ctx = ctx.WithBlockHeight(12)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	stream, err = k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(stream.Count, Equals, int64(2))
+
+	// last sub-swap, the accumulated output is sent out in one outbound
+	ctx = ctx.WithBlockHeight(14)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.StreamingSwapExists(ctx, tx.ID), Equals, false)
+	_, err = k.GetSwapQueueItem(ctx, tx.ID)
+	c.Check(err, NotNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.Equals(common.BNBAsset), Equals, true)
+	// a single swap of 30 RUNE would only emit 1775147928
+	c.Check(items[0].Coin.Amount.Equal(cosmos.NewUint(2128822516)), Equals, true, Commentf("%d", items[0].Coin.Amount.Uint64()))
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(130*common.One)), Equals, true)
+
+	// every sub-swap fail the price limit , the whole deposit get refunded
+	mgr.txOutStore = NewTxStoreDummy()
+	tx = common.NewTx(GetRandomTxHash(), destination, GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(20*common.One))},
+		BNBGasFeeSingleton, "")
+	msg = NewMsgSwap(tx, common.BNBAsset, destination, cosmos.NewUint(100*common.One), GetRandomBech32Addr())
+	msg.StreamInterval = 1
+	msg.StreamQuantity = 2
+	c.Assert(k.SetSwapQueueItem(ctx, msg), IsNil)
+	ctx = ctx.WithBlockHeight(15)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	stream, err = k.GetStreamingSwap(ctx, tx.ID)
+	c.Assert(err, IsNil)
+	c.Check(stream.FailedSwaps, DeepEquals, []int64{1})
+	ctx = ctx.WithBlockHeight(16)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.StreamingSwapExists(ctx, tx.ID), Equals, false)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.IsRune(), Equals, true)
+	c.Check(items[0].Coin.Amount.Equal(cosmos.NewUint(20*common.One)), Equals, true)
+
+	// streaming swap split into more sub-swaps than mimir allow is refunded
+	mgr.txOutStore = NewTxStoreDummy()
+	k.SetMimir(ctx, constants.MaxStreamingSwapQuantity.String(), 2)
+	tx = common.NewTx(GetRandomTxHash(), destination, GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(20*common.One))},
+		BNBGasFeeSingleton, "")
+	msg = NewMsgSwap(tx, common.BNBAsset, destination, cosmos.ZeroUint(), GetRandomBech32Addr())
+	msg.StreamInterval = 1
+	msg.StreamQuantity = 3
+	c.Assert(k.SetSwapQueueItem(ctx, msg), IsNil)
+	c.Check(validateStreamingSwap(ctx, k, msg, constAccessor), NotNil)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.StreamingSwapExists(ctx, tx.ID), Equals, false)
+	_, err = k.GetSwapQueueItem(ctx, tx.ID)
+	c.Check(err, NotNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Amount.Equal(cosmos.NewUint(20*common.One)), Equals, true)
+
+	// so is a streaming swap with an interval longer than allowed
+	msg.StreamQuantity = 2
+	msg.StreamInterval = constAccessor.GetInt64Value(constants.MaxStreamingSwapInterval) + 1
+	c.Check(validateStreamingSwap(ctx, k, msg, constAccessor), NotNil)
+	msg.StreamInterval = 1
+	c.Check(validateStreamingSwap(ctx, k, msg, constAccessor), IsNil)
+}
+
+func (s SwapQueueSuite) TestLimitOrders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	destination := GetRandomBNBAddress()
+	tx := common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(10*common.One))},
+		BNBGasFeeSingleton, "")
+	order := NewLimitOrder(tx, common.BNBAsset, destination, cosmos.NewUint(20*common.One), 10, 20)
+	k.SetLimitOrder(ctx, order)
+
+	// the pool can't emit the min out , order stays
+	queue := NewSwapQv1(k)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, true)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+
+	// trading halted , order is not filled even though the price is reachable
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	k.SetMimir(ctx, "HaltTrading", 1)
+	ctx = ctx.WithBlockHeight(11)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, true)
+
+	// price is reachable , order is filled
+	k.SetMimir(ctx, "HaltTrading", 0)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, false)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(items[0].Coin.Amount.GTE(cosmos.NewUint(20*common.One)), Equals, true)
+	c.Check(items[0].ToAddress.Equals(destination), Equals, true)
+
+	// order not filled by the expiry height is refunded
+	mgr.txOutStore = NewTxStoreDummy()
+	tx = common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+		common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(10*common.One))},
+		BNBGasFeeSingleton, "")
+	order = NewLimitOrder(tx, common.BNBAsset, destination, cosmos.NewUint(5000*common.One), 11, 20)
+	k.SetLimitOrder(ctx, order)
+	ctx = ctx.WithBlockHeight(19)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, true)
+	ctx = ctx.WithBlockHeight(20)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, tx.ID), Equals, false)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].Coin.Asset.IsRune(), Equals, true)
+	c.Check(items[0].ToAddress.Equals(tx.FromAddress), Equals, true)
+}
+
+func (s SwapQueueSuite) TestLimitOrdersPerBlock(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	k.SetMimir(ctx, constants.MaxLimitOrdersPerBlock.String(), 1)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(1000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(1000 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	newOrder := func(minOut uint64) LimitOrder {
+		tx := common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+			common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(10*common.One))},
+			BNBGasFeeSingleton, "")
+		order := NewLimitOrder(tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(minOut), 10, 100)
+		k.SetLimitOrder(ctx, order)
+		return order
+	}
+	// the order asking for more than the pool price is not evaluated , and doesn't take the place of the others
+	unreachable := newOrder(20 * common.One)
+	first := newOrder(5 * common.One)
+	second := newOrder(6 * common.One)
+
+	queue := NewSwapQv1(k)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, first.Tx.ID), Equals, false)
+	c.Check(k.LimitOrderExists(ctx, second.Tx.ID), Equals, true)
+	c.Check(k.LimitOrderExists(ctx, unreachable.Tx.ID), Equals, true)
+
+	ctx = ctx.WithBlockHeight(11)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, second.Tx.ID), Equals, false)
+	c.Check(k.LimitOrderExists(ctx, unreachable.Tx.ID), Equals, true)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 2)
+
+	// expired orders are refunded at most MaxLimitOrdersPerBlock at a time too
+	expiring := newOrder(20 * common.One)
+	ctx = ctx.WithBlockHeight(100)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, unreachable.Tx.ID) != k.LimitOrderExists(ctx, expiring.Tx.ID), Equals, true)
+	ctx = ctx.WithBlockHeight(101)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, unreachable.Tx.ID), Equals, false)
+	c.Check(k.LimitOrderExists(ctx, expiring.Tx.ID), Equals, false)
+}
+
+func (s SwapQueueSuite) TestLimitOrdersSkipFailed(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(10)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	k.SetMimir(ctx, constants.MaxLimitOrdersPerBlock.String(), 2)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100000 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	newOrder := func(amount, minOut uint64) LimitOrder {
+		tx := common.NewTx(GetRandomTxHash(), GetRandomBNBAddress(), GetRandomBNBAddress(),
+			common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(amount))},
+			BNBGasFeeSingleton, "")
+		order := NewLimitOrder(tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(minOut), 10, 100)
+		k.SetLimitOrder(ctx, order)
+		return order
+	}
+	// the order with the lowest rate pass the pool price but fail on slip , it doesn't take the place of the orders behind it
+	failing := newOrder(10000*common.One, 9500*common.One)
+	first := newOrder(100*common.One, 96*common.One)
+	second := newOrder(100*common.One, 97*common.One)
+
+	queue := NewSwapQv1(k)
+	c.Assert(queue.EndBlock(ctx, mgr, ver, constAccessor), IsNil)
+	c.Check(k.LimitOrderExists(ctx, failing.Tx.ID), Equals, true)
+	c.Check(k.LimitOrderExists(ctx, first.Tx.ID), Equals, false)
+	c.Check(k.LimitOrderExists(ctx, second.Tx.ID), Equals, false)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 2)
+}
+
+func (s SwapQueueSuite) TestScoreMsgsPools(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	pool = NewPool()
+	pool.Asset = common.BTCAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	queue := NewSwapQv1(k)
+	amount := cosmos.NewUint(10 * common.One)
+	msgs := []MsgSwap{
+		// RUNE -> asset , scored against the target pool
+		NewMsgSwap(common.Tx{
+			ID:    GetRandomTxHash(),
+			Coins: common.Coins{common.NewCoin(common.RuneAsset(), amount)},
+		}, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr()),
+		// asset -> RUNE , scored against the source pool
+		NewMsgSwap(common.Tx{
+			ID:    GetRandomTxHash(),
+			Coins: common.Coins{common.NewCoin(common.BNBAsset, amount)},
+		}, common.RuneAsset(), GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr()),
+		// asset -> asset , scored against both pools
+		NewMsgSwap(common.Tx{
+			ID:    GetRandomTxHash(),
+			Coins: common.Coins{common.NewCoin(common.BTCAsset, amount)},
+		}, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr()),
+		// pool doesn't exist
+		NewMsgSwap(common.Tx{
+			ID:    GetRandomTxHash(),
+			Coins: common.Coins{common.NewCoin(common.ETHAsset, amount)},
+		}, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr()),
+	}
+
+	swaps, err := queue.scoreMsgs(ctx, msgs)
+	c.Assert(err, IsNil)
+	c.Assert(swaps, HasLen, 4)
+	c.Check(swaps[0].slip.Uint64(), Equals, uint64(2100))
+	c.Check(swaps[0].fee.Uint64(), Equals, uint64(82644628), Commentf("%d", swaps[0].fee.Uint64()))
+	c.Check(swaps[1].slip.Uint64(), Equals, uint64(2100))
+	c.Check(swaps[1].fee.Uint64(), Equals, uint64(82644628), Commentf("%d", swaps[1].fee.Uint64()))
+	// the second leg swap 826446280 RUNE into the BNB pool
+	c.Check(swaps[2].slip.Uint64(), Equals, uint64(2100+1721), Commentf("%d", swaps[2].slip.Uint64()))
+	c.Check(swaps[2].fee.GT(swaps[0].fee), Equals, true)
+	c.Check(swaps[3].slip.IsZero(), Equals, true)
+	c.Check(swaps[3].fee.IsZero(), Equals, true)
+}
+
+func (s SwapQueueSuite) TestSortDeterministic(c *C) {
+	items := make(swapItems, 0)
+	for i := 0; i < 50; i++ {
+		// plenty of items share the same slip and fee , so the order of those come down to tx id
+		items = append(items, swapItem{
+			msg: NewMsgSwap(common.Tx{
+				ID:    GetRandomTxHash(),
+				Coins: common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(uint64(i%5+1)*common.One))},
+			}, common.RuneAsset(), GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr()),
+			fee:  cosmos.NewUint(uint64(i % 5)),
+			slip: cosmos.NewUint(uint64(i % 3)),
+		})
+	}
+	expected := items.Sort()
+	c.Assert(expected, HasLen, len(items))
+	for i := 1; i < len(expected); i++ {
+		prev, cur := expected[i-1], expected[i]
+		c.Assert(prev.slip.GTE(cur.slip), Equals, true)
+		if prev.slip.Equal(cur.slip) {
+			c.Assert(prev.fee.GTE(cur.fee), Equals, true)
+			if prev.fee.Equal(cur.fee) {
+				c.Assert(prev.msg.Tx.ID.String() < cur.msg.Tx.ID.String(), Equals, true)
+			}
+		}
+	}
+
+	// every node might load the queue in a different order , the result should always be the same
+	rnd := rand.New(rand.NewSource(42))
+	for round := 0; round < 10; round++ {
+		shuffled := make(swapItems, len(items))
+		copy(shuffled, items)
+		rnd.Shuffle(len(shuffled), func(i, j int) {
+			shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+		})
+		sorted := shuffled.Sort()
+		for i := range sorted {
+			c.Assert(sorted[i].msg.Tx.ID.Equals(expected[i].msg.Tx.ID), Equals, true)
+		}
+	}
+	// sort doesn't modify the given items
+	c.Check(items[0].fee.IsZero(), Equals, true)
+	c.Check(items[1].fee.Uint64(), Equals, uint64(1))
+}
+
+func BenchmarkSwapItemsSort(b *testing.B) {
+	items := make(swapItems, 5000)
+	for i := range items {
+		items[i] = swapItem{
+			msg: NewMsgSwap(common.Tx{
+				ID:    GetRandomTxHash(),
+				Coins: common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(uint64(i+1)))},
+			}, common.RuneAsset(), GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr()),
+			fee:  cosmos.NewUint(uint64(i % 97)),
+			slip: cosmos.NewUint(uint64(i % 89)),
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		items.Sort()
+	}
+}
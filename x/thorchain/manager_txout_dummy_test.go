@@ -56,6 +56,28 @@ func (tos *TxOutStoreDummy) UnSafeAddTxOutItem(ctx cosmos.Context, mgr Manager,
 	return nil
 }
 
+func (tos *TxOutStoreDummy) EndBlock(_ cosmos.Context, _ Manager) error {
+	return nil
+}
+
+// TxOutStoreFailDummy is a TxOutStoreDummy that fail to add any outbound item
+type TxOutStoreFailDummy struct {
+	*TxOutStoreDummy
+	err error
+}
+
+// NewTxOutStoreFailDummy will create a new instance of TxOutStoreFailDummy , which return the given error from TryAddTxOutItem
+func NewTxOutStoreFailDummy(err error) *TxOutStoreFailDummy {
+	return &TxOutStoreFailDummy{
+		TxOutStoreDummy: NewTxStoreDummy(),
+		err:             err,
+	}
+}
+
+func (tos *TxOutStoreFailDummy) TryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi *TxOutItem) (bool, error) {
+	return false, tos.err
+}
+
 func (tos *TxOutStoreDummy) addToBlockOut(_ cosmos.Context, toi *TxOutItem) {
 	tos.blockOut.TxArray = append(tos.blockOut.TxArray, toi)
 }
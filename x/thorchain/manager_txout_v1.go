@@ -64,6 +64,14 @@ func (tos *TxOutStorageV1) ClearOutboundItems(ctx cosmos.Context) {
 // return bool indicate whether the transaction had been added successful or not
 // return error indicate error
 func (tos *TxOutStorageV1) TryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi *TxOutItem) (bool, error) {
+	delay, err := tos.getOutboundDelay(ctx, toi)
+	if err != nil {
+		return false, fmt.Errorf("fail to get outbound delay: %w", err)
+	}
+	// outbound on a halted chain is accepted , and held in the schedule till the chain resume
+	if delay <= 0 && isOutboundHalted(ctx, tos.keeper, toi.Chain) {
+		delay = 1
+	}
 	success, err := tos.prepareTxOutItem(ctx, toi)
 	if err != nil {
 		return success, fmt.Errorf("fail to prepare outbound tx: %w", err)
@@ -71,6 +79,14 @@ func (tos *TxOutStorageV1) TryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi
 	if !success {
 		return false, nil
 	}
+	if delay > 0 {
+		// large outbound is held in the keeper , and released by EndBlock later , it had been prepared already , so the item
+		// held is exactly what will be sent out
+		if err := tos.keeper.AppendScheduledOutbound(ctx, common.BlockHeight(ctx)+delay, toi); err != nil {
+			return false, fmt.Errorf("fail to schedule outbound tx: %w", err)
+		}
+		return true, nil
+	}
 	// add tx to block out
 	if err := tos.addToBlockOut(ctx, mgr, toi); err != nil {
 		return false, err
@@ -78,9 +94,148 @@ func (tos *TxOutStorageV1) TryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi
 	return true, nil
 }
 
+// getOutboundDelay return the number of blocks the given outbound should be held before it is sent , in proportion to the RUNE value of it
+// only outbound to users are held , coins moved between vaults are not
+func (tos *TxOutStorageV1) getOutboundDelay(ctx cosmos.Context, toi *TxOutItem) (int64, error) {
+	if len(toi.Memo) > 0 {
+		memo, err := ParseMemo(toi.Memo)
+		if err != nil || !memo.IsOutbound() {
+			return 0, nil
+		}
+	}
+	delayValue, err := tos.keeper.GetMimir(ctx, constants.OutboundDelayValue.String())
+	if delayValue < 0 || err != nil {
+		delayValue = tos.constAccessor.GetInt64Value(constants.OutboundDelayValue)
+	}
+	if delayValue <= 0 {
+		return 0, nil
+	}
+	delayBlocks, err := tos.keeper.GetMimir(ctx, constants.OutboundDelayBlocks.String())
+	if delayBlocks < 0 || err != nil {
+		delayBlocks = tos.constAccessor.GetInt64Value(constants.OutboundDelayBlocks)
+	}
+	maxDelayBlocks, err := tos.keeper.GetMimir(ctx, constants.MaxOutboundDelayBlocks.String())
+	if maxDelayBlocks < 0 || err != nil {
+		maxDelayBlocks = tos.constAccessor.GetInt64Value(constants.MaxOutboundDelayBlocks)
+	}
+
+	value := toi.Coin.Amount
+	if !toi.Coin.Asset.IsRune() {
+		pool, err := tos.keeper.GetPool(ctx, toi.Coin.Asset)
+		if err != nil {
+			return 0, fmt.Errorf("fail to get pool(%s): %w", toi.Coin.Asset, err)
+		}
+		if pool.IsEmpty() {
+			return 0, nil
+		}
+		value = pool.AssetValueInRune(toi.Coin.Amount)
+	}
+	if value.LT(cosmos.NewUint(uint64(delayValue))) {
+		return 0, nil
+	}
+	delay := value.MulUint64(uint64(delayBlocks)).QuoUint64(uint64(delayValue))
+	if delay.GT(cosmos.NewUint(uint64(maxDelayBlocks))) {
+		return maxDelayBlocks, nil
+	}
+	return int64(delay.Uint64()), nil
+}
+
+// EndBlock release the outbounds scheduled for the current block , an outbound that can't be released is held for a later block
+func (tos *TxOutStorageV1) EndBlock(ctx cosmos.Context, mgr Manager) error {
+	height := common.BlockHeight(ctx)
+	scheduled, err := tos.keeper.GetScheduledOutbound(ctx, height)
+	if err != nil {
+		return fmt.Errorf("fail to get scheduled outbound: %w", err)
+	}
+	// clear it first , so the outbounds released in this block are not counted as scheduled when their vault is selected again
+	tos.keeper.ClearScheduledOutbound(ctx, height)
+	signingPeriod := tos.constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
+	for _, toi := range scheduled.TxArray {
+		// hold it till the chain resume
+		if isOutboundHalted(ctx, tos.keeper, toi.Chain) {
+			if err := tos.keeper.AppendScheduledOutbound(ctx, height+1, toi); err != nil {
+				return fmt.Errorf("fail to schedule outbound tx: %w", err)
+			}
+			continue
+		}
+		// release it in a cached context , so nothing is written when it fails half way
+		// release a copy , so the outbound held again is exactly the one scheduled
+		cacheCtx, commit := ctx.CacheContext()
+		item := *toi
+		if err := tos.releaseTxOutItem(cacheCtx, mgr, &item); err != nil {
+			ctx.Logger().Error("fail to release scheduled outbound tx , try again later", "in_hash", toi.InHash, "error", err)
+			if err := tos.keeper.AppendScheduledOutbound(ctx, height+signingPeriod, toi); err != nil {
+				return fmt.Errorf("fail to schedule outbound tx: %w", err)
+			}
+			continue
+		}
+		commit()
+	}
+	return nil
+}
+
+// releaseTxOutItem add the given scheduled outbound , which had been prepared already , to the block out
+// the vault of an outbound to user is selected again , as the vault selected when it was scheduled might have been drained or retired since
+func (tos *TxOutStorageV1) releaseTxOutItem(ctx cosmos.Context, mgr Manager, toi *TxOutItem) error {
+	hasVoter := !toi.InHash.IsEmpty() && !toi.InHash.Equals(common.BlankTxID)
+	var voter ObservedTxVoter
+	if hasVoter {
+		var err error
+		voter, err = tos.keeper.GetObservedTxInVoter(ctx, toi.InHash)
+		if err != nil {
+			return fmt.Errorf("fail to get observed tx voter: %w", err)
+		}
+	}
+	if tos.canReselectVault(toi) {
+		item := *toi
+		item.VaultPubKey = common.EmptyPubKey
+		if err := tos.selectVault(ctx, &item); err != nil {
+			return fmt.Errorf("fail to select vault: %w", err)
+		}
+		// Ensure THORNode are not sending from and to the same address
+		fromAddr, err := item.VaultPubKey.GetAddress(item.Chain)
+		if err != nil || fromAddr.IsEmpty() || item.ToAddress.Equals(fromAddr) {
+			return fmt.Errorf("fail to send from vault(%s) to %s: %w", item.VaultPubKey, item.ToAddress, err)
+		}
+		// outbound handler match the observed outbound against the actions of the voter , which carry the vault
+		if hasVoter && !item.VaultPubKey.Equals(toi.VaultPubKey) {
+			for i, action := range voter.Actions {
+				if action.Equals(*toi) {
+					voter.Actions[i].VaultPubKey = item.VaultPubKey
+					break
+				}
+			}
+		}
+		toi.VaultPubKey = item.VaultPubKey
+	}
+	// outbound handler look for the outbound from the height of the inbound voter , move it to the block the outbound is sent out
+	if hasVoter {
+		voter.Height = common.BlockHeight(ctx)
+		tos.keeper.SetObservedTxInVoter(ctx, voter)
+	}
+	return tos.addToBlockOut(ctx, mgr, toi)
+}
+
+// canReselectVault return true when the vault of the given outbound can be selected again at release , only outbound and refund to user
+// are sent from any vault , coins moved between vaults and ragnarok have to leave the vault they were assigned
+func (tos *TxOutStorageV1) canReselectVault(toi *TxOutItem) bool {
+	if toi.Chain.Equals(common.THORChain) {
+		return false
+	}
+	memo, err := ParseMemo(toi.Memo)
+	if err != nil {
+		return false
+	}
+	return memo.IsType(TxOutbound) || memo.IsType(TxRefund)
+}
+
 // UnSafeAddTxOutItem - blindly adds a tx out, skipping vault selection, transaction
 // fee deduction, etc
 func (tos *TxOutStorageV1) UnSafeAddTxOutItem(ctx cosmos.Context, mgr Manager, toi *TxOutItem) error {
+	// held in the schedule till the chain resume
+	if isOutboundHalted(ctx, tos.keeper, toi.Chain) {
+		return tos.keeper.AppendScheduledOutbound(ctx, common.BlockHeight(ctx)+1, toi)
+	}
 	return tos.addToBlockOut(ctx, mgr, toi)
 }
 
@@ -98,56 +253,9 @@ func (tos *TxOutStorageV1) prepareTxOutItem(ctx cosmos.Context, toi *TxOutItem)
 	if !toi.Chain.Equals(common.THORChain) {
 		// If THORNode don't have a pool already selected to send from, discover one.
 		if toi.VaultPubKey.IsEmpty() {
-			// When deciding which Yggdrasil pool will send out our tx out, we
-			// should consider which ones observed the inbound request tx, as
-			// yggdrasil pools can go offline. Here THORNode get the voter record and
-			// only consider Yggdrasils where their observed saw the "correct"
-			// tx.
-
-			activeNodeAccounts, err := tos.keeper.ListActiveNodeAccounts(ctx)
-			if err != nil {
-				ctx.Logger().Error("fail to get all active node accounts", "error", err)
+			if err := tos.selectVault(ctx, toi); err != nil {
+				return false, err
 			}
-			if len(activeNodeAccounts) > 0 {
-				voter, err := tos.keeper.GetObservedTxInVoter(ctx, toi.InHash)
-				if err != nil {
-					return false, fmt.Errorf("fail to get observed tx voter: %w", err)
-				}
-				tx := voter.GetTx(activeNodeAccounts)
-
-				// collect yggdrasil pools is going to get a list of yggdrasil vault that THORChain can used to send out fund
-				yggs, err := tos.collectYggdrasilPools(ctx, tx, toi.Chain.GetGasAsset())
-				if err != nil {
-					return false, fmt.Errorf("fail to collect yggdrasil pool: %w", err)
-				}
-
-				vault := yggs.SelectByMaxCoin(toi.Coin.Asset)
-				// if none of the ygg vaults have enough funds, don't select one
-				// and we'll select an asgard vault a few lines down
-				if toi.Coin.Amount.LT(vault.GetCoin(toi.Coin.Asset).Amount) {
-					toi.VaultPubKey = vault.PubKey
-				}
-			}
-		}
-
-		// Apparently  couldn't find a yggdrasil vault to send from, so use asgard
-		if toi.VaultPubKey.IsEmpty() {
-			active, err := tos.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
-			if err != nil {
-				ctx.Logger().Error("fail to get active vaults", "error", err)
-			}
-			vault := active.SelectByMaxCoin(toi.Coin.Asset)
-			if vault.IsEmpty() {
-				return false, fmt.Errorf("empty vault, cannot send out fund: %s", toi.Coin)
-			}
-
-			// check that this vault has enough funds to satisfy the request
-			if toi.Coin.Amount.GT(vault.GetCoin(toi.Coin.Asset).Amount) {
-				// not enough funds
-				return false, fmt.Errorf("vault %s, does not have enough funds. Has %s, but requires %s", vault.PubKey, vault.GetCoin(toi.Coin.Asset), toi.Coin)
-			}
-
-			toi.VaultPubKey = vault.PubKey
 		}
 
 		// Ensure THORNode are not sending from and to the same address
@@ -255,6 +363,62 @@ func (tos *TxOutStorageV1) prepareTxOutItem(ctx cosmos.Context, toi *TxOutItem)
 	return true, nil
 }
 
+// selectVault choose the vault to send out the given outbound , prefer a yggdrasil vault that observed the inbound and has enough fund ,
+// otherwise the active asgard vault has the most of the coin
+func (tos *TxOutStorageV1) selectVault(ctx cosmos.Context, toi *TxOutItem) error {
+	// When deciding which Yggdrasil pool will send out our tx out, we
+	// should consider which ones observed the inbound request tx, as
+	// yggdrasil pools can go offline. Here THORNode get the voter record and
+	// only consider Yggdrasils where their observed saw the "correct"
+	// tx.
+
+	activeNodeAccounts, err := tos.keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get all active node accounts", "error", err)
+	}
+	if len(activeNodeAccounts) > 0 {
+		voter, err := tos.keeper.GetObservedTxInVoter(ctx, toi.InHash)
+		if err != nil {
+			return fmt.Errorf("fail to get observed tx voter: %w", err)
+		}
+		tx := voter.GetTx(activeNodeAccounts)
+
+		// collect yggdrasil pools is going to get a list of yggdrasil vault that THORChain can used to send out fund
+		yggs, err := tos.collectYggdrasilPools(ctx, tx, toi.Chain.GetGasAsset())
+		if err != nil {
+			return fmt.Errorf("fail to collect yggdrasil pool: %w", err)
+		}
+
+		vault := yggs.SelectByMaxCoin(toi.Coin.Asset)
+		// if none of the ygg vaults have enough funds, don't select one
+		// and we'll select an asgard vault a few lines down
+		if toi.Coin.Amount.LT(vault.GetCoin(toi.Coin.Asset).Amount) {
+			toi.VaultPubKey = vault.PubKey
+		}
+	}
+
+	// Apparently  couldn't find a yggdrasil vault to send from, so use asgard
+	if toi.VaultPubKey.IsEmpty() {
+		active, err := tos.keeper.GetAsgardVaultsByStatus(ctx, ActiveVault)
+		if err != nil {
+			ctx.Logger().Error("fail to get active vaults", "error", err)
+		}
+		vault := active.SelectByMaxCoin(toi.Coin.Asset)
+		if vault.IsEmpty() {
+			return fmt.Errorf("empty vault, cannot send out fund: %s", toi.Coin)
+		}
+
+		// check that this vault has enough funds to satisfy the request
+		if toi.Coin.Amount.GT(vault.GetCoin(toi.Coin.Asset).Amount) {
+			// not enough funds
+			return fmt.Errorf("vault %s, does not have enough funds. Has %s, but requires %s", vault.PubKey, vault.GetCoin(toi.Coin.Asset), toi.Coin)
+		}
+
+		toi.VaultPubKey = vault.PubKey
+	}
+	return nil
+}
+
 func (tos *TxOutStorageV1) addToBlockOut(ctx cosmos.Context, mgr Manager, toi *TxOutItem) error {
 	// THORChain , native RUNE will not need to forward the txout to bifrost
 	if toi.Chain.Equals(common.THORChain) {
@@ -354,6 +518,10 @@ func (tos *TxOutStorageV1) nativeTxOut(ctx cosmos.Context, mgr Manager, toi *TxO
 
 // collectYggdrasilPools is to get all the yggdrasil vaults , that THORChain can used to send out fund
 func (tos *TxOutStorageV1) collectYggdrasilPools(ctx cosmos.Context, tx ObservedTx, gasAsset common.Asset) (Vaults, error) {
+	scheduled, err := tos.getScheduledOutboundCoins(ctx)
+	if err != nil {
+		return nil, err
+	}
 	// collect yggdrasil pools
 	var vaults Vaults
 	iterator := tos.keeper.GetVaultIterator(ctx)
@@ -395,6 +563,11 @@ func (tos *TxOutStorageV1) collectYggdrasilPools(ctx cosmos.Context, tx Observed
 			continue
 		}
 
+		// node account in maintenance might not be able to sign , don't send fund out from its yggdrasil vault
+		if isNodeInMaintenance(ctx, tos.keeper, addr) {
+			continue
+		}
+
 		block, err := tos.GetBlockOut(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("fail to get block:%w", err)
@@ -416,6 +589,8 @@ func (tos *TxOutStorageV1) collectYggdrasilPools(ctx cosmos.Context, tx Observed
 			}
 			vault = tos.deductYggdrasilVaultOutstandingBalance(vault, blockOut)
 		}
+		// the outbounds held in the schedule had been assigned to the vault already
+		vault.SubFunds(scheduled[vault.PubKey])
 
 		vaults = append(vaults, vault)
 	}
@@ -423,6 +598,38 @@ func (tos *TxOutStorageV1) collectYggdrasilPools(ctx cosmos.Context, tx Observed
 	return vaults, nil
 }
 
+// getScheduledOutboundCoins return the coins each vault has to send out for the outbounds held in the schedule , index by vault pub key
+func (tos *TxOutStorageV1) getScheduledOutboundCoins(ctx cosmos.Context) (map[common.PubKey]common.Coins, error) {
+	result := make(map[common.PubKey]common.Coins)
+	iterator := tos.keeper.GetScheduledOutboundIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var txOut TxOut
+		if err := tos.keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &txOut); err != nil {
+			return nil, fmt.Errorf("fail to unmarshal scheduled outbound: %w", err)
+		}
+		for _, item := range txOut.TxArray {
+			if item.VaultPubKey.IsEmpty() || !item.OutHash.IsEmpty() {
+				continue
+			}
+			coins := result[item.VaultPubKey]
+			found := false
+			for i, coin := range coins {
+				if coin.Asset.Equals(item.Coin.Asset) {
+					coins[i].Amount = coin.Amount.Add(item.Coin.Amount)
+					found = true
+					break
+				}
+			}
+			if !found {
+				coins = append(coins, item.Coin)
+			}
+			result[item.VaultPubKey] = coins
+		}
+	}
+	return result, nil
+}
+
 func (tos *TxOutStorageV1) deductYggdrasilVaultOutstandingBalance(vault Vault, block *TxOut) Vault {
 	for _, txOutItem := range block.TxArray {
 		if !txOutItem.VaultPubKey.Equals(vault.PubKey) {
@@ -5,6 +5,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 )
 
 type TxOutStoreSuite struct{}
@@ -140,3 +141,221 @@ func (s TxOutStoreSuite) TestAddOutTxItemWithoutBFT(c *C) {
 	c.Assert(msgs, HasLen, 1)
 	c.Assert(msgs[0].Coin.Amount.Equal(cosmos.NewUint(19*common.One)), Equals, true)
 }
+
+func (s TxOutStoreSuite) TestScheduledOutbound(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(1000000*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(10000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	pool.PoolUnits = cosmos.NewUint(10000 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(w.keeper.SetPool(w.ctx, pool), IsNil)
+	w.keeper.SetMimir(w.ctx, "OutboundDelayValue", 1000*common.One)
+	w.keeper.SetMimir(w.ctx, "OutboundDelayBlocks", 10)
+	w.keeper.SetMimir(w.ctx, "MaxOutboundDelayBlocks", 50)
+	txOutStore := w.mgr.TxOutStore()
+	height := common.BlockHeight(w.ctx)
+
+	newItem := func(amount uint64, memo string) *TxOutItem {
+		return &TxOutItem{
+			Chain:     common.BNBChain,
+			ToAddress: GetRandomBNBAddress(),
+			InHash:    GetRandomTxHash(),
+			Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(amount*common.One)),
+			Memo:      memo,
+		}
+	}
+
+	// 3000 RUNE worth is held for 30 blocks
+	success, err := txOutStore.TryAddTxOutItem(w.ctx, w.mgr, newItem(3000, ""))
+	c.Assert(err, IsNil)
+	c.Check(success, Equals, true)
+	msgs, err := txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Check(msgs, HasLen, 0)
+	scheduled, err := w.keeper.GetScheduledOutbound(w.ctx, height+30)
+	c.Assert(err, IsNil)
+	c.Assert(scheduled.TxArray, HasLen, 1)
+	// the held item had been prepared , the vault is selected and the fee deducted
+	c.Check(scheduled.TxArray[0].VaultPubKey.Equals(vault.PubKey), Equals, true)
+	c.Check(scheduled.TxArray[0].Coin.Amount.LT(cosmos.NewUint(3000*common.One)), Equals, true)
+	inHash := scheduled.TxArray[0].InHash
+
+	// the delay is capped
+	success, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, newItem(100000, ""))
+	c.Assert(err, IsNil)
+	c.Check(success, Equals, true)
+	scheduled, err = w.keeper.GetScheduledOutbound(w.ctx, height+50)
+	c.Assert(err, IsNil)
+	c.Check(scheduled.TxArray, HasLen, 1)
+
+	// small outbound is sent right away
+	success, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, newItem(20, ""))
+	c.Assert(err, IsNil)
+	c.Check(success, Equals, true)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Check(msgs, HasLen, 1)
+
+	// coins moved between vaults are not held
+	success, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, newItem(3000, NewMigrateMemo(height).String()))
+	c.Assert(err, IsNil)
+	c.Check(success, Equals, true)
+	msgs, err = txOutStore.GetOutboundItems(w.ctx)
+	c.Assert(err, IsNil)
+	c.Check(msgs, HasLen, 2)
+
+	// native RUNE to an address that is not a THORChain address can't be sent
+	native := &TxOutItem{
+		Chain:     common.THORChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.RuneNative, cosmos.NewUint(3000*common.One)),
+	}
+	success, err = txOutStore.TryAddTxOutItem(w.ctx, w.mgr, native)
+	c.Assert(err, IsNil)
+	c.Check(success, Equals, true)
+
+	// released at the scheduled height
+	ctx := w.ctx.WithBlockHeight(height + 30)
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Check(msgs[0].VaultPubKey.Equals(vault.PubKey), Equals, true)
+	scheduled, err = w.keeper.GetScheduledOutbound(ctx, height+30)
+	c.Assert(err, IsNil)
+	c.Check(scheduled.TxArray, HasLen, 0)
+	voter, err := w.keeper.GetObservedTxInVoter(ctx, inHash)
+	c.Assert(err, IsNil)
+	c.Check(voter.Height, Equals, height+30)
+
+	// the outbound that fail to release is held for a later block
+	signingPeriod := constants.GetConstantValues(constants.SWVersion).GetInt64Value(constants.SigningTransactionPeriod)
+	scheduled, err = w.keeper.GetScheduledOutbound(ctx, height+30+signingPeriod)
+	c.Assert(err, IsNil)
+	c.Assert(scheduled.TxArray, HasLen, 1)
+	c.Check(scheduled.TxArray[0].InHash.Equals(native.InHash), Equals, true)
+
+	// held while the chain is halted
+	ctx = w.ctx.WithBlockHeight(height + 50)
+	w.keeper.SetMimir(ctx, "HaltBNBChain", 1)
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	scheduled, err = w.keeper.GetScheduledOutbound(ctx, height+51)
+	c.Assert(err, IsNil)
+	c.Check(scheduled.TxArray, HasLen, 1)
+	w.keeper.SetMimir(ctx, "HaltBNBChain", 0)
+	ctx = w.ctx.WithBlockHeight(height + 51)
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(msgs, HasLen, 1)
+}
+
+func (s TxOutStoreSuite) TestReleaseReselectVault(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(10000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	pool.PoolUnits = cosmos.NewUint(10000 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(w.keeper.SetPool(w.ctx, pool), IsNil)
+	w.keeper.SetMimir(w.ctx, "OutboundDelayValue", 1000*common.One)
+	w.keeper.SetMimir(w.ctx, "OutboundDelayBlocks", 10)
+	w.keeper.SetMimir(w.ctx, "MaxOutboundDelayBlocks", 50)
+	txOutStore := w.mgr.TxOutStore()
+	height := common.BlockHeight(w.ctx)
+
+	toi := &TxOutItem{
+		Chain:     common.BNBChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(3000*common.One)),
+	}
+	success, err := txOutStore.TryAddTxOutItem(w.ctx, w.mgr, toi)
+	c.Assert(err, IsNil)
+	c.Check(success, Equals, true)
+	c.Check(toi.VaultPubKey.Equals(vault.PubKey), Equals, true)
+
+	// the vault selected when scheduled retired in the meantime
+	vault.UpdateStatus(RetiringVault, height+1)
+	c.Assert(w.keeper.SetVault(w.ctx, vault), IsNil)
+	next := GetRandomVault()
+	next.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)),
+	}
+	c.Assert(w.keeper.SetVault(w.ctx, next), IsNil)
+
+	ctx := w.ctx.WithBlockHeight(height + 30)
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err := txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Check(msgs[0].VaultPubKey.Equals(next.PubKey), Equals, true)
+	voter, err := w.keeper.GetObservedTxInVoter(ctx, toi.InHash)
+	c.Assert(err, IsNil)
+	c.Assert(voter.Actions, HasLen, 1)
+	c.Check(voter.Actions[0].VaultPubKey.Equals(next.PubKey), Equals, true)
+
+	// the vault of coins moved between vaults is kept
+	migrate := &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: vault.PubKey,
+		InHash:      common.BlankTxID,
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+		Memo:        NewMigrateMemo(height).String(),
+	}
+	c.Assert(w.keeper.AppendScheduledOutbound(ctx, height+31, migrate), IsNil)
+	ctx = w.ctx.WithBlockHeight(height + 31)
+	c.Assert(txOutStore.EndBlock(ctx, w.mgr), IsNil)
+	msgs, err = txOutStore.GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(msgs, HasLen, 1)
+	c.Check(msgs[0].VaultPubKey.Equals(vault.PubKey), Equals, true)
+}
+
+func (s TxOutStoreSuite) TestCollectYggdrasilPoolsScheduled(c *C) {
+	w := getHandlerTestWrapper(c, 1, true, true)
+	acc := GetRandomNodeAccount(NodeActive)
+	c.Assert(w.keeper.SetNodeAccount(w.ctx, acc), IsNil)
+	ygg := NewVault(common.BlockHeight(w.ctx), ActiveVault, YggdrasilVault, acc.PubKeySet.Secp256k1, common.Chains{common.BNBChain})
+	ygg.AddFunds(common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	})
+	c.Assert(w.keeper.SetVault(w.ctx, ygg), IsNil)
+
+	// an outbound held in the schedule had been assigned to the yggdrasil vault
+	c.Assert(w.keeper.AppendScheduledOutbound(w.ctx, common.BlockHeight(w.ctx)+10, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: ygg.PubKey,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(60*common.One)),
+	}), IsNil)
+
+	txOutStore := NewTxOutStorageV1(w.keeper, constants.GetConstantValues(constants.SWVersion), w.mgr.EventMgr())
+	tx := ObservedTx{Signers: []cosmos.AccAddress{acc.NodeAddress}}
+	vaults, err := txOutStore.collectYggdrasilPools(w.ctx, tx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Assert(vaults, HasLen, 1)
+	c.Check(vaults[0].GetCoin(common.BNBAsset).Amount.Equal(cosmos.NewUint(40*common.One)), Equals, true)
+
+	// the yggdrasil vault of a node account in maintenance is not used
+	c.Assert(w.keeper.SetNodeMaintenance(w.ctx, NewNodeMaintenance(acc.NodeAddress, common.BlockHeight(w.ctx), common.BlockHeight(w.ctx)+100)), IsNil)
+	vaults, err = txOutStore.collectYggdrasilPools(w.ctx, tx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(vaults, HasLen, 0)
+}
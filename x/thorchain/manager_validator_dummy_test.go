@@ -33,3 +33,7 @@ func (vm *ValidatorDummyMgr) processRagnarok(_ cosmos.Context, _ Manager, _ cons
 func (vm *ValidatorDummyMgr) NodeAccountPreflightCheck(ctx cosmos.Context, na NodeAccount, constAccessor constants.ConstantValues) (NodeStatus, error) {
 	return NodeDisabled, kaboom
 }
+
+func (vm *ValidatorDummyMgr) ChurnPreview(ctx cosmos.Context, constAccessor constants.ConstantValues) (QueryChurnPreview, error) {
+	return QueryChurnPreview{}, kaboom
+}
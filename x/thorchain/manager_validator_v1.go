@@ -6,6 +6,7 @@ import (
 	"net"
 	"sort"
 
+	"github.com/blang/semver"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -17,6 +18,18 @@ import (
 
 const (
 	genesisBlockHeight = 1
+	// maximum number of stakers ragnarok unstake in one block
+	maxUnstakesPerBlock = 20
+)
+
+// reasons a node account is churned out
+const (
+	churnReasonBadBehavior      = "bad behavior"
+	churnReasonAge              = "age"
+	churnReasonLowerVersion     = "version lower than minimum join version"
+	churnReasonForcedToLeave    = "banned"
+	churnReasonRequestedToLeave = "requested to leave"
+	churnReasonMarked           = "marked to leave"
 )
 
 // validatorMgrV1 is to manage a list of validators , and rotate them
@@ -82,17 +95,11 @@ func (vm *validatorMgrV1) BeginBlock(ctx cosmos.Context, constAccessor constants
 		}
 	}
 
-	vaults, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	// the last block height we had a successful churn
+	lastHeight, err := vm.getLastChurnHeight(ctx)
 	if err != nil {
 		return err
 	}
-	// calculate last churn block height
-	var lastHeight int64 // the last block height we had a successful churn
-	for _, vault := range vaults {
-		if vault.BlockHeight > lastHeight {
-			lastHeight = vault.BlockHeight
-		}
-	}
 
 	// get constants
 	desireValidatorSet, err := vm.k.GetMimir(ctx, constants.DesireValidatorSet.String())
@@ -113,15 +120,21 @@ func (vm *validatorMgrV1) BeginBlock(ctx cosmos.Context, constAccessor constants
 		}
 
 		// don't churn if we have retiring asgard vaults that still have funds
-		retiringVaults, err := vm.k.GetAsgardVaultsByStatus(ctx, RetiringVault)
+		retiringHasFunds, err := vm.retiringVaultsHaveFunds(ctx)
 		if err != nil {
 			return err
 		}
-		for _, vault := range retiringVaults {
-			if vault.HasFunds() {
-				ctx.Logger().Info("Skipping rotation due to retiring vaults still have funds.")
-				return nil
-			}
+		if retiringHasFunds {
+			ctx.Logger().Info("Skipping rotation due to retiring vaults still have funds.")
+			return nil
+		}
+		retiringHasScheduled, err := vm.retiringVaultsHaveScheduledOutbound(ctx)
+		if err != nil {
+			return err
+		}
+		if retiringHasScheduled {
+			ctx.Logger().Info("Skipping rotation due to retiring vaults still have scheduled outbounds.")
+			return nil
 		}
 
 		next, ok, err := vm.nextVaultNodeAccounts(ctx, int(desireValidatorSet), constAccessor)
@@ -138,6 +151,75 @@ func (vm *validatorMgrV1) BeginBlock(ctx cosmos.Context, constAccessor constants
 	return nil
 }
 
+// getLastChurnHeight return the block height of the last successful churn , which is when the newest active asgard vault was created
+func (vm *validatorMgrV1) getLastChurnHeight(ctx cosmos.Context) (int64, error) {
+	vaults, err := vm.k.GetAsgardVaultsByStatus(ctx, ActiveVault)
+	if err != nil {
+		return 0, err
+	}
+	var lastHeight int64
+	for _, vault := range vaults {
+		if vault.BlockHeight > lastHeight {
+			lastHeight = vault.BlockHeight
+		}
+	}
+	return lastHeight, nil
+}
+
+// retiringVaultsHaveFunds return true when any retiring asgard vault still has funds , churn has to wait for the migration to finish
+func (vm *validatorMgrV1) retiringVaultsHaveFunds(ctx cosmos.Context) (bool, error) {
+	retiringVaults, err := vm.k.GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		return false, err
+	}
+	for _, vault := range retiringVaults {
+		if vault.HasFunds() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// retiringVaultsHaveScheduledOutbound return true when any outbound held in the schedule is going to be sent from a retiring asgard vault ,
+// churn has to wait till they are released
+func (vm *validatorMgrV1) retiringVaultsHaveScheduledOutbound(ctx cosmos.Context) (bool, error) {
+	retiringVaults, err := vm.k.GetAsgardVaultsByStatus(ctx, RetiringVault)
+	if err != nil {
+		return false, err
+	}
+	for _, vault := range retiringVaults {
+		scheduled, err := hasScheduledOutbound(ctx, vm.k, vault.PubKey)
+		if err != nil {
+			return false, err
+		}
+		if scheduled {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getNextChurnHeight return the next block height after the given height that BeginBlock check for node account rotation , it is
+// either the next multiple of rotatePerBlockHeight , or the next retry when the last successful churn is overdue
+func getNextChurnHeight(height, lastHeight, rotatePerBlockHeight, rotateRetryBlocks int64) int64 {
+	if rotatePerBlockHeight <= 0 {
+		return 0
+	}
+	next := (height/rotatePerBlockHeight + 1) * rotatePerBlockHeight
+	if rotateRetryBlocks > 0 {
+		// a retry happen when (h - lastHeight - rotatePerBlockHeight) is a positive multiple of rotateRetryBlocks
+		overdue := lastHeight + rotatePerBlockHeight
+		retry := overdue + rotateRetryBlocks
+		if height >= overdue {
+			retry = overdue + ((height-overdue)/rotateRetryBlocks+1)*rotateRetryBlocks
+		}
+		if retry < next {
+			next = retry
+		}
+	}
+	return next
+}
+
 // EndBlock when block commit
 func (vm *validatorMgrV1) EndBlock(ctx cosmos.Context, mgr Manager, constAccessor constants.ConstantValues) []abci.ValidatorUpdate {
 	height := common.BlockHeight(ctx)
@@ -347,9 +429,22 @@ func (vm *validatorMgrV1) payNodeAccountBondAward(ctx cosmos.Context, na NodeAcc
 	// calc number of rune they are awarded
 	reward := vault.CalcNodeRewards(earnedBlocks)
 
+	bp, err := getBondProviders(ctx, vm.k, na)
+	if err != nil {
+		return fmt.Errorf("fail to get bond providers: %w", err)
+	}
+
 	// Add to their bond the amount rewarded
 	na.Bond = na.Bond.Add(reward)
 
+	// the node operator take its fee out of the reward , the rest is shared by the bond providers pro-rata
+	if len(bp.Providers) > 1 {
+		payNodeOperatorFee(&bp, na.BondAddress, reward, na.Bond)
+		if err := vm.k.SetBondProviders(ctx, bp); err != nil {
+			return fmt.Errorf("fail to save bond providers: %w", err)
+		}
+	}
+
 	// Minus the number of rune THORNode have awarded them
 	vault.BondRewardRune = common.SafeSub(vault.BondRewardRune, reward)
 
@@ -613,37 +708,60 @@ func (vm *validatorMgrV1) ragnarokBond(ctx cosmos.Context, nth int64, mgr Manage
 		}
 		amt := na.Bond.MulUint64(uint64(nth)).QuoUint64(10)
 
-		// refund bond
-		txOutItem := &TxOutItem{
-			Chain:      common.RuneAsset().Chain,
-			ToAddress:  na.BondAddress,
-			InHash:     common.BlankTxID,
-			Coin:       common.NewCoin(common.RuneAsset(), amt),
-			Memo:       NewRagnarokMemo(common.BlockHeight(ctx)).String(),
-			ModuleName: BondName,
-		}
-		ok, err := vm.txOutStore.TryAddTxOutItem(ctx, mgr, txOutItem)
+		// every bond provider get back the same portion of its bond , so their shares of what is left stay the same
+		bp, err := getBondProviders(ctx, vm.k, na)
 		if err != nil {
-			if !errors.Is(err, ErrNotEnoughToPayFee) {
-				return err
-			}
-			ok = true
+			return fmt.Errorf("fail to get bond providers: %w", err)
 		}
-		if !ok {
-			continue
+		providerAmts := make([]cosmos.Uint, len(bp.Providers))
+		for i, p := range bp.Providers {
+			providerAmts[i] = common.GetShare(p.Units, bp.TotalUnits(), amt)
 		}
+		refunded := false
+		for i, p := range bp.Providers {
+			providerAmt := providerAmts[i]
+			if providerAmt.IsZero() {
+				continue
+			}
+			// refund bond
+			txOutItem := &TxOutItem{
+				Chain:      common.RuneAsset().Chain,
+				ToAddress:  p.BondAddress,
+				InHash:     common.BlankTxID,
+				Coin:       common.NewCoin(common.RuneAsset(), providerAmt),
+				Memo:       NewRagnarokMemo(common.BlockHeight(ctx)).String(),
+				ModuleName: BondName,
+			}
+			ok, err := vm.txOutStore.TryAddTxOutItem(ctx, mgr, txOutItem)
+			if err != nil {
+				if !errors.Is(err, ErrNotEnoughToPayFee) {
+					return err
+				}
+				ok = true
+			}
+			if !ok {
+				continue
+			}
 
-		// add a pending rangarok transaction
-		pending, err := vm.k.GetRagnarokPending(ctx)
-		if err != nil {
-			return fmt.Errorf("fail to get ragnarok pending: %w", err)
+			// add a pending rangarok transaction
+			pending, err := vm.k.GetRagnarokPending(ctx)
+			if err != nil {
+				return fmt.Errorf("fail to get ragnarok pending: %w", err)
+			}
+			vm.k.SetRagnarokPending(ctx, pending+1)
+			na.Bond = common.SafeSub(na.Bond, bp.Unbond(p.BondAddress, providerAmt, na.Bond))
+			refunded = true
+		}
+		if !refunded {
+			continue
 		}
-		vm.k.SetRagnarokPending(ctx, pending+1)
 
-		na.Bond = common.SafeSub(na.Bond, amt)
 		if err := vm.k.SetNodeAccount(ctx, na); err != nil {
 			return err
 		}
+		if err := vm.k.SetBondProviders(ctx, bp); err != nil {
+			return fmt.Errorf("fail to save bond providers: %w", err)
+		}
 	}
 
 	return nil
@@ -703,7 +821,6 @@ func (vm *validatorMgrV1) ragnarokPools(ctx cosmos.Context, nth int64, mgr Manag
 	version := vm.k.GetLowestActiveVersion(ctx)
 
 	nextPool := false
-	maxUnstakesPerBlock := 20
 	count := 0
 
 	for i := len(pools) - 1; i >= 0; i-- { // iterate backwards
@@ -725,44 +842,16 @@ func (vm *validatorMgrV1) ragnarokPools(ctx cosmos.Context, nth int64, mgr Manag
 			continue
 		}
 
-		j := int64(-1)
-		iterator := vm.k.GetStakerIterator(ctx, pool.Asset)
-		for ; iterator.Valid(); iterator.Next() {
-			j++
-			if j == position.Number {
-				position.Number++
-				var staker Staker
-				vm.k.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &staker)
-				if staker.Units.IsZero() {
-					continue
-				}
-
-				unstakeMsg := NewMsgUnStake(
-					common.GetRagnarokTx(pool.Asset.Chain, staker.RuneAddress, staker.RuneAddress),
-					staker.RuneAddress,
-					cosmos.NewUint(uint64(basisPoints)),
-					pool.Asset,
-					na.NodeAddress,
-				)
-
-				unstakeHandler := NewUnstakeHandler(vm.k, mgr)
-				_, err := unstakeHandler.Run(ctx, unstakeMsg, version, constAccessor)
-				if err != nil {
-					ctx.Logger().Error("fail to unstake", "staker", staker.RuneAddress, "error", err)
-				} else {
-					count++
-					pending, err := vm.k.GetRagnarokPending(ctx)
-					if err != nil {
-						return fmt.Errorf("fail to get ragnarok pending: %w", err)
-					}
-					vm.k.SetRagnarokPending(ctx, pending+2) // two outbound txs
-					if count >= maxUnstakesPerBlock {
-						break
-					}
-				}
+		var unstaked int
+		unstaked, position.Number = ragnarokPool(ctx, vm.k, mgr, na, pool.Asset, position.Number, basisPoints, maxUnstakesPerBlock-count, version, constAccessor)
+		if unstaked > 0 {
+			count += unstaked
+			pending, err := vm.k.GetRagnarokPending(ctx)
+			if err != nil {
+				return fmt.Errorf("fail to get ragnarok pending: %w", err)
 			}
+			vm.k.SetRagnarokPending(ctx, pending+int64(unstaked*2)) // two outbound txs
 		}
-		iterator.Close()
 		if count >= maxUnstakesPerBlock {
 			break
 		}
@@ -777,6 +866,53 @@ func (vm *validatorMgrV1) ragnarokPools(ctx cosmos.Context, nth int64, mgr Manag
 	return nil
 }
 
+// ragnarokPool unstake basisPoints of the stakers in the given pool , starting from the staker at position , until limit
+// stakers are unstaked , the stakers that fail to unstake don't count. It returns the number of stakers unstaked , and the
+// position to continue from
+func ragnarokPool(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, na NodeAccount, asset common.Asset, position, basisPoints int64, limit int, version semver.Version, constAccessor constants.ConstantValues) (int, int64) {
+	// collect the stakers first , as unstaking could remove them from the store while iterating
+	var stakers []Staker
+	j := int64(-1)
+	iterator := keeper.GetStakerIterator(ctx, asset)
+	for ; iterator.Valid(); iterator.Next() {
+		j++
+		if j < position {
+			continue
+		}
+		var staker Staker
+		keeper.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &staker)
+		stakers = append(stakers, staker)
+	}
+	iterator.Close()
+
+	count := 0
+	for _, staker := range stakers {
+		if count >= limit {
+			break
+		}
+		position++
+		if staker.Units.IsZero() {
+			continue
+		}
+		unstakeMsg := NewMsgUnStake(
+			common.GetRagnarokTx(asset.Chain, staker.RuneAddress, staker.RuneAddress),
+			staker.RuneAddress,
+			cosmos.NewUint(uint64(basisPoints)),
+			asset,
+			na.NodeAddress,
+		)
+
+		unstakeHandler := NewUnstakeHandler(keeper, mgr)
+		_, err := unstakeHandler.Run(ctx, unstakeMsg, version, constAccessor)
+		if err != nil {
+			ctx.Logger().Error("fail to unstake", "staker", staker.RuneAddress, "error", err)
+			continue
+		}
+		count++
+	}
+	return count, position
+}
+
 // RequestYggReturn request the node that had been removed (yggdrasil) to return their fund
 func (vm *validatorMgrV1) RequestYggReturn(ctx cosmos.Context, node NodeAccount, mgr Manager) error {
 	if !vm.k.VaultExists(ctx, node.PubKeySet.Secp256k1) {
@@ -1030,7 +1166,7 @@ func (vm *validatorMgrV1) findOldActor(ctx cosmos.Context) (NodeAccount, error)
 // Mark an old to be churned out
 func (vm *validatorMgrV1) markActor(ctx cosmos.Context, na NodeAccount, reason string) error {
 	if !na.IsEmpty() && na.LeaveHeight == 0 {
-		ctx.Logger().Info(fmt.Sprintf("Marked Validator to be churned out %s: %s", na.NodeAddress, reason))
+		ctx.Logger().Info(fmt.Sprintf("Marked Validator to be churned out %s: for %s", na.NodeAddress, reason))
 		na.LeaveHeight = common.BlockHeight(ctx)
 		return vm.k.SetNodeAccount(ctx, na)
 	}
@@ -1044,7 +1180,7 @@ func (vm *validatorMgrV1) markOldActor(ctx cosmos.Context, rate int64) error {
 		if err != nil {
 			return err
 		}
-		if err := vm.markActor(ctx, na, "for age"); err != nil {
+		if err := vm.markActor(ctx, na, churnReasonAge); err != nil {
 			return err
 		}
 	}
@@ -1059,7 +1195,7 @@ func (vm *validatorMgrV1) markBadActor(ctx cosmos.Context, rate int64) error {
 			return err
 		}
 		for _, na := range nas {
-			if err := vm.markActor(ctx, na, "for bad behavior"); err != nil {
+			if err := vm.markActor(ctx, na, churnReasonBadBehavior); err != nil {
 				return err
 			}
 		}
@@ -1074,7 +1210,7 @@ func (vm *validatorMgrV1) markLowerVersion(ctx cosmos.Context, rate int64) error
 			return err
 		}
 		if !na.IsEmpty() {
-			if err := vm.markActor(ctx, na, "for version lower than minimum join version"); err != nil {
+			if err := vm.markActor(ctx, na, churnReasonLowerVersion); err != nil {
 				return err
 			}
 		}
@@ -1174,10 +1310,169 @@ func (vm *validatorMgrV1) NodeAccountPreflightCheck(ctx cosmos.Context, na NodeA
 	return NodeReady, nil
 }
 
+// ChurnPreview run the same selection logic as the churn , without changing anything , to tell what the next churn would look like
+// if it happened with the current state , node accounts that BeginBlock would mark to leave before the next churn are marked in memory
+func (vm *validatorMgrV1) ChurnPreview(ctx cosmos.Context, constAccessor constants.ConstantValues) (QueryChurnPreview, error) {
+	height := common.BlockHeight(ctx)
+	result := QueryChurnPreview{
+		Height:  height,
+		Removed: make([]QueryChurnNode, 0),
+		Added:   make([]QueryChurnNode, 0),
+	}
+
+	rotatePerBlockHeight, err := vm.k.GetMimir(ctx, constants.RotatePerBlockHeight.String())
+	if rotatePerBlockHeight < 0 || err != nil {
+		rotatePerBlockHeight = constAccessor.GetInt64Value(constants.RotatePerBlockHeight)
+	}
+	lastHeight, err := vm.getLastChurnHeight(ctx)
+	if err != nil {
+		return result, fmt.Errorf("fail to get last churn height: %w", err)
+	}
+	result.LastChurnHeight = lastHeight
+	result.NextChurnHeight = getNextChurnHeight(height, lastHeight, rotatePerBlockHeight, constAccessor.GetInt64Value(constants.RotateRetryBlocks))
+
+	if vm.k.RagnarokInProgress(ctx) {
+		result.Blocked = "ragnarok is in progress"
+		return result, nil
+	}
+	retiringHasFunds, err := vm.retiringVaultsHaveFunds(ctx)
+	if err != nil {
+		return result, fmt.Errorf("fail to get retiring asgard vaults: %w", err)
+	}
+	if retiringHasFunds {
+		result.Blocked = "retiring vaults still have funds"
+	}
+	retiringHasScheduled, err := vm.retiringVaultsHaveScheduledOutbound(ctx)
+	if err != nil {
+		return result, fmt.Errorf("fail to check scheduled outbounds of retiring asgard vaults: %w", err)
+	}
+	if retiringHasScheduled && result.Blocked == "" {
+		result.Blocked = "retiring vaults still have scheduled outbounds"
+	}
+
+	active, err := vm.k.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		return result, fmt.Errorf("fail to get active node accounts: %w", err)
+	}
+	reasons, err := vm.previewMarkedActors(ctx, active, result.NextChurnHeight, rotatePerBlockHeight, constAccessor)
+	if err != nil {
+		return result, err
+	}
+	for i, na := range active {
+		if _, ok := reasons[na.NodeAddress.String()]; ok && na.LeaveHeight == 0 {
+			active[i].LeaveHeight = result.NextChurnHeight
+		}
+	}
+
+	// standby and ready node accounts that pass the preflight check would be ready by the time of the churn
+	standby, err := vm.k.ListNodeAccountsByStatus(ctx, NodeStandby)
+	if err != nil {
+		return result, fmt.Errorf("fail to get standby node accounts: %w", err)
+	}
+	ready, err := vm.k.ListNodeAccountsByStatus(ctx, NodeReady)
+	if err != nil {
+		return result, fmt.Errorf("fail to get ready node accounts: %w", err)
+	}
+	candidates := make(NodeAccounts, 0, len(standby)+len(ready))
+	for _, na := range append(standby, ready...) {
+		if status, _ := vm.NodeAccountPreflightCheck(ctx, na, constAccessor); status == NodeReady {
+			candidates = append(candidates, na)
+		}
+	}
+
+	desireValidatorSet, err := vm.k.GetMimir(ctx, constants.DesireValidatorSet.String())
+	if desireValidatorSet < 0 || err != nil {
+		desireValidatorSet = constAccessor.GetInt64Value(constants.DesireValidatorSet)
+	}
+	minimumNodesForBFT := constAccessor.GetInt64Value(constants.MinimumNodesForBFT)
+	next, removed, added, rotation := selectNextVaultNodeAccounts(height, active, candidates, int(desireValidatorSet), minimumNodesForBFT)
+	result.Rotation = rotation
+	result.VaultMembers = int64(len(next))
+	for _, na := range removed {
+		reason := reasons[na.NodeAddress.String()]
+		switch {
+		case na.ForcedToLeave:
+			reason = churnReasonForcedToLeave
+		case na.RequestedToLeave:
+			reason = churnReasonRequestedToLeave
+		case reason == "":
+			reason = churnReasonMarked
+		}
+		result.Removed = append(result.Removed, QueryChurnNode{
+			NodeAddress: na.NodeAddress,
+			Status:      na.Status,
+			Bond:        na.Bond,
+			Reason:      reason,
+		})
+	}
+	for _, na := range added {
+		result.Added = append(result.Added, QueryChurnNode{
+			NodeAddress: na.NodeAddress,
+			Status:      na.Status,
+			Bond:        na.Bond,
+		})
+	}
+	return result, nil
+}
+
+// previewMarkedActors return the active node accounts BeginBlock would mark to leave up to the given churn height , and why
+// node accounts that are already marked keep their mark , so they are not returned
+func (vm *validatorMgrV1) previewMarkedActors(ctx cosmos.Context, active NodeAccounts, churnHeight, rotatePerBlockHeight int64, constAccessor constants.ConstantValues) (map[string]string, error) {
+	reasons := make(map[string]string)
+	minimumNodesForBFT := constAccessor.GetInt64Value(constants.MinimumNodesForBFT)
+	if minimumNodesForBFT+2 >= int64(len(active)) {
+		return reasons, nil
+	}
+	height := common.BlockHeight(ctx)
+	// whether BeginBlock reach a multiple of the given rate after the current block , up to the churn
+	marks := func(rate int64) bool {
+		return rate > 0 && churnHeight/rate > height/rate
+	}
+	mark := func(na NodeAccount, reason string) {
+		if na.IsEmpty() || na.LeaveHeight > 0 {
+			return
+		}
+		if _, ok := reasons[na.NodeAddress.String()]; !ok {
+			reasons[na.NodeAddress.String()] = reason
+		}
+	}
+
+	badValidatorRate, err := vm.k.GetMimir(ctx, constants.BadValidatorRate.String())
+	if badValidatorRate < 0 || err != nil {
+		badValidatorRate = constAccessor.GetInt64Value(constants.BadValidatorRate)
+	}
+	if marks(badValidatorRate) {
+		nas, err := vm.findBadActors(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to find bad actors: %w", err)
+		}
+		for _, na := range nas {
+			mark(na, churnReasonBadBehavior)
+		}
+	}
+	oldValidatorRate, err := vm.k.GetMimir(ctx, constants.OldValidatorRate.String())
+	if oldValidatorRate < 0 || err != nil {
+		oldValidatorRate = constAccessor.GetInt64Value(constants.OldValidatorRate)
+	}
+	if marks(oldValidatorRate) {
+		na, err := vm.findOldActor(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to find old actor: %w", err)
+		}
+		mark(na, churnReasonAge)
+	}
+	if marks(rotatePerBlockHeight) {
+		na, err := vm.findLowerVersionActor(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to find lower version actor: %w", err)
+		}
+		mark(na, churnReasonLowerVersion)
+	}
+	return reasons, nil
+}
+
 // Returns a list of nodes to include in the next pool
 func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx cosmos.Context, targetCount int, constAccessor constants.ConstantValues) (NodeAccounts, bool, error) {
-	rotation := false // track if are making any changes to the current active node accounts
-
 	// update list of ready actors
 	if err := vm.markReadyActors(ctx, constAccessor); err != nil {
 		return nil, false, err
@@ -1188,15 +1483,29 @@ func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx cosmos.Context, targetCount
 		return nil, false, err
 	}
 
+	active, err := vm.k.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	minimumNodesForBFT := constAccessor.GetInt64Value(constants.MinimumNodesForBFT)
+	next, _, _, rotation := selectNextVaultNodeAccounts(common.BlockHeight(ctx), active, ready, targetCount, minimumNodesForBFT)
+	return next, rotation, nil
+}
+
+// selectNextVaultNodeAccounts choose the node accounts of the next vault from the given active and ready node accounts , it return
+// the next vault node accounts , the active node accounts that are removed , the ready node accounts that are added , and whether
+// there is any change to the active node accounts
+func selectNextVaultNodeAccounts(height int64, active, ready NodeAccounts, targetCount int, minimumNodesForBFT int64) (NodeAccounts, NodeAccounts, NodeAccounts, bool) {
+	rotation := false // track if are making any changes to the current active node accounts
+	removed := make(NodeAccounts, 0)
+	added := make(NodeAccounts, 0)
+
 	// sort by bond size, descending
 	sort.SliceStable(ready, func(i, j int) bool {
 		return ready[i].Bond.GT(ready[j].Bond)
 	})
 
-	active, err := vm.k.ListActiveNodeAccounts(ctx)
-	if err != nil {
-		return nil, false, err
-	}
 	// sort by LeaveHeight ascending
 	// giving preferential treatment to people who are forced to leave
 	//  and then requested to leave
@@ -1217,15 +1526,15 @@ func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx cosmos.Context, targetCount
 		return active[i].LeaveHeight < active[j].LeaveHeight
 	})
 
-	toRemove := findCountToRemove(common.BlockHeight(ctx), active)
+	toRemove := findCountToRemove(height, active)
 	if toRemove > 0 {
 		rotation = true
-		active = active[toRemove:]
+		removed = append(removed, active[:toRemove]...)
+		active = append(NodeAccounts{}, active[toRemove:]...)
 	}
 
 	// add ready nodes to become active
 	limit := toRemove + 1 // Max limit of ready nodes to churn in
-	minimumNodesForBFT := constAccessor.GetInt64Value(constants.MinimumNodesForBFT)
 	if len(active)+limit < int(minimumNodesForBFT) {
 		limit = int(minimumNodesForBFT) - len(active)
 	}
@@ -1233,13 +1542,14 @@ func (vm *validatorMgrV1) nextVaultNodeAccounts(ctx cosmos.Context, targetCount
 		if len(ready) >= i {
 			rotation = true
 			active = append(active, ready[i-1])
+			added = append(added, ready[i-1])
 		}
 		if i == limit { // limit adding ready accounts
 			break
 		}
 	}
 
-	return active, rotation, nil
+	return active, removed, added, rotation
 }
 
 // findCountToRemove - find the number of node accounts to remove
@@ -189,6 +189,47 @@ func (vts *ValidatorMgrV6TestSuite) TestBadActors(c *C) {
 	c.Check(count, Equals, 2)
 }
 
+func (vts *ValidatorMgrV6TestSuite) TestRagnarokPool(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	stakeHandler := NewStakeHandler(k, mgr)
+	for i := 0; i < 3; i++ {
+		c.Assert(stakeHandler.stake(ctx,
+			common.BNBAsset,
+			cosmos.NewUint(common.One*100),
+			cosmos.NewUint(common.One*100),
+			GetRandomRUNEAddress(),
+			GetRandomBNBAddress(),
+			GetRandomTxHash(),
+			constAccessor), IsNil)
+	}
+	// the first staker fail to unstake as its record point to a staker that doesn't exist , it doesn't count toward the limit
+	iterator := k.GetStakerIterator(ctx, common.BNBAsset)
+	var staker Staker
+	k.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &staker)
+	staker.RuneAddress = GetRandomRUNEAddress()
+	ctx.KVStore(keyThorchain).Set(iterator.Key(), k.Cdc().MustMarshalBinaryBare(staker))
+	iterator.Close()
+
+	unstaked, position := ragnarokPool(ctx, k, mgr, na, common.BNBAsset, 0, MaxUnstakeBasisPoints, 2, ver, constAccessor)
+	c.Check(unstaked, Equals, 2)
+	c.Check(position, Equals, int64(3))
+	unstaked, position = ragnarokPool(ctx, k, mgr, na, common.BNBAsset, position, MaxUnstakeBasisPoints, 2, ver, constAccessor)
+	c.Check(unstaked, Equals, 0)
+	c.Check(position, Equals, int64(3))
+}
+
 func (vts *ValidatorMgrV6TestSuite) TestRagnarokBond(c *C) {
 	ctx, k := setupKeeperForTest(c)
 	ctx = ctx.WithBlockHeight(1)
@@ -242,6 +283,198 @@ func (vts *ValidatorMgrV6TestSuite) TestRagnarokBond(c *C) {
 	}
 }
 
+func (vts *ValidatorMgrV6TestSuite) TestRagnarokBondProviders(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1)
+	mgr := NewDummyMgr()
+	vMgr := newValidatorMgrV1(k, mgr.VaultMgr(), mgr.TxOutStore(), mgr.EventMgr())
+
+	activeNode := GetRandomNodeAccount(NodeActive)
+	activeNode.Bond = cosmos.NewUint(100)
+	c.Assert(k.SetNodeAccount(ctx, activeNode), IsNil)
+	provider := GetRandomBNBAddress()
+	bp, err := getBondProviders(ctx, k, activeNode)
+	c.Assert(err, IsNil)
+	bp.Bond(provider, cosmos.NewUint(300), activeNode.Bond)
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	activeNode.Bond = cosmos.NewUint(400)
+	c.Assert(k.SetNodeAccount(ctx, activeNode), IsNil)
+
+	c.Assert(vMgr.ragnarokBond(ctx, 11, mgr), IsNil)
+	activeNode, err = k.GetNodeAccount(ctx, activeNode.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(activeNode.Bond.Equal(cosmos.NewUint(360)), Equals, true, Commentf("%d", activeNode.Bond.Uint64()))
+	if !common.RuneAsset().Chain.Equals(common.THORChain) {
+		items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+		c.Assert(err, IsNil)
+		c.Assert(items, HasLen, 2)
+		c.Check(items[0].ToAddress.Equals(activeNode.BondAddress), Equals, true)
+		c.Check(items[0].Coin.Amount.Equal(cosmos.NewUint(10)), Equals, true)
+		c.Check(items[1].ToAddress.Equals(provider), Equals, true)
+		c.Check(items[1].Coin.Amount.Equal(cosmos.NewUint(30)), Equals, true)
+	}
+	// shares stay the same
+	bp, err = getBondProviders(ctx, k, activeNode)
+	c.Assert(err, IsNil)
+	c.Check(bp.GetBond(activeNode.BondAddress, activeNode.Bond).Equal(cosmos.NewUint(90)), Equals, true)
+	c.Check(bp.GetBond(provider, activeNode.Bond).Equal(cosmos.NewUint(270)), Equals, true)
+}
+
+func (vts *ValidatorMgrV6TestSuite) TestPayNodeAccountBondAwardOperatorFee(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(101)
+	mgr := NewDummyMgr()
+	vMgr := newValidatorMgrV1(k, mgr.VaultMgr(), mgr.TxOutStore(), mgr.EventMgr())
+
+	vaultData := NewVaultData()
+	vaultData.BondRewardRune = cosmos.NewUint(1000 * common.One)
+	vaultData.TotalBondUnits = cosmos.NewUint(100)
+	c.Assert(k.SetVaultData(ctx, vaultData), IsNil)
+
+	na := GetRandomNodeAccount(NodeActive)
+	na.ActiveBlockHeight = 1
+	na.Bond = cosmos.NewUint(100 * common.One)
+	provider := GetRandomBNBAddress()
+	bp, err := getBondProviders(ctx, k, na)
+	c.Assert(err, IsNil)
+	bp.NodeOperatorFee = 2000
+	bp.Bond(provider, cosmos.NewUint(300*common.One), na.Bond)
+	c.Assert(k.SetBondProviders(ctx, bp), IsNil)
+	na.Bond = cosmos.NewUint(400 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+
+	// reward is 1000 RUNE , 200 RUNE goes to the operator , the rest is shared pro-rata
+	c.Assert(vMgr.payNodeAccountBondAward(ctx, na), IsNil)
+	na, err = k.GetNodeAccount(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Bond.Equal(cosmos.NewUint(1400*common.One)), Equals, true, Commentf("%d", na.Bond.Uint64()))
+	bp, err = getBondProviders(ctx, k, na)
+	c.Assert(err, IsNil)
+	// units are rounded , allow 1 unit of difference
+	operatorBond := int64(bp.GetBond(na.BondAddress, na.Bond).Uint64())
+	c.Check(operatorBond >= 500*common.One-1 && operatorBond <= 500*common.One+1, Equals, true, Commentf("%d", operatorBond))
+	providerBond := int64(bp.GetBond(provider, na.Bond).Uint64())
+	c.Check(providerBond >= 900*common.One-1 && providerBond <= 900*common.One+1, Equals, true, Commentf("%d", providerBond))
+}
+
+func (vts *ValidatorMgrV6TestSuite) TestGetNextChurnHeight(c *C) {
+	// the next multiple of rotatePerBlockHeight
+	c.Check(getNextChurnHeight(1000, 900, 256, 720), Equals, int64(1024))
+	c.Check(getNextChurnHeight(1024, 900, 256, 720), Equals, int64(1280))
+	// the last churn is overdue , retry before the next multiple
+	c.Check(getNextChurnHeight(1000, 100, 256, 50), Equals, int64(1006))
+	c.Check(getNextChurnHeight(1006, 100, 256, 50), Equals, int64(1024))
+	c.Check(getNextChurnHeight(300, 100, 256, 50), Equals, int64(406))
+	c.Check(getNextChurnHeight(1000, 100, 0, 50), Equals, int64(0))
+}
+
+func (vts *ValidatorMgrV6TestSuite) TestChurnPreview(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	vMgr := newValidatorMgrV1(k, mgr.VaultMgr(), mgr.TxOutStore(), mgr.EventMgr())
+	constAccessor := constants.NewDummyConstants(map[constants.ConstantName]int64{
+		constants.DesireValidatorSet:   12,
+		constants.BadValidatorRate:     256,
+		constants.OldValidatorRate:     256,
+		constants.MinimumNodesForBFT:   4,
+		constants.RotatePerBlockHeight: 256,
+		constants.RotateRetryBlocks:    720,
+		constants.MinimumBondInRune:    common.One,
+	}, map[constants.ConstantName]bool{}, map[constants.ConstantName]string{})
+
+	vault := NewVault(900, ActiveVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	c.Assert(k.SetVault(ctx, vault), IsNil)
+
+	// no node accounts , nothing to churn
+	preview, err := vMgr.ChurnPreview(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(preview.LastChurnHeight, Equals, int64(900))
+	c.Check(preview.NextChurnHeight, Equals, int64(1024))
+	c.Check(preview.Rotation, Equals, false)
+	c.Check(preview.Removed, HasLen, 0)
+	c.Check(preview.Added, HasLen, 0)
+
+	active := make(NodeAccounts, 10)
+	for i := range active {
+		active[i] = GetRandomNodeAccount(NodeActive)
+		active[i].StatusSince = 100
+	}
+	// requested to leave
+	active[0].RequestedToLeave = true
+	active[0].LeaveHeight = 500
+	// the oldest node account , already marked to leave
+	active[1].StatusSince = 1
+	active[1].LeaveHeight = 600
+	for _, na := range active {
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	}
+	// would be marked for bad behavior before the churn
+	k.SetNodeAccountSlashPoints(ctx, active[2].NodeAddress, 100)
+	standby := GetRandomNodeAccount(NodeStandby)
+	standby.Bond = cosmos.NewUint(200 * common.One)
+	c.Assert(k.SetNodeAccount(ctx, standby), IsNil)
+	ready := GetRandomNodeAccount(NodeReady)
+	c.Assert(k.SetNodeAccount(ctx, ready), IsNil)
+	// doesn't pass the preflight check
+	noIP := GetRandomNodeAccount(NodeStandby)
+	noIP.IPAddress = ""
+	c.Assert(k.SetNodeAccount(ctx, noIP), IsNil)
+
+	preview, err = vMgr.ChurnPreview(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(preview.Height, Equals, int64(1000))
+	c.Check(preview.Blocked, Equals, "")
+	c.Check(preview.Rotation, Equals, true)
+	c.Assert(preview.Removed, HasLen, 3)
+	c.Check(preview.Removed[0].NodeAddress.Equals(active[0].NodeAddress), Equals, true)
+	c.Check(preview.Removed[0].Reason, Equals, churnReasonRequestedToLeave)
+	c.Check(preview.Removed[1].NodeAddress.Equals(active[1].NodeAddress), Equals, true)
+	c.Check(preview.Removed[1].Reason, Equals, churnReasonMarked)
+	c.Check(preview.Removed[2].NodeAddress.Equals(active[2].NodeAddress), Equals, true)
+	c.Check(preview.Removed[2].Reason, Equals, churnReasonBadBehavior)
+	c.Assert(preview.Added, HasLen, 2)
+	c.Check(preview.Added[0].NodeAddress.Equals(standby.NodeAddress), Equals, true)
+	c.Check(preview.Added[0].Status, Equals, NodeStandby)
+	c.Check(preview.Added[1].NodeAddress.Equals(ready.NodeAddress), Equals, true)
+	c.Check(preview.VaultMembers, Equals, int64(9))
+
+	// nothing is changed
+	na, err := k.GetNodeAccount(ctx, active[2].NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.LeaveHeight, Equals, int64(0))
+	na, err = k.GetNodeAccount(ctx, standby.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Status, Equals, NodeStandby)
+
+	// churn wait for the retiring vaults to be empty
+	retiring := NewVault(10, RetiringVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	retiring.AddFunds(common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))})
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+	preview, err = vMgr.ChurnPreview(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(preview.Blocked, Not(Equals), "")
+
+	// and for the outbounds scheduled from the retiring vaults to be released
+	retiring.SubFunds(retiring.Coins)
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+	c.Assert(k.AppendScheduledOutbound(ctx, 1010, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: retiring.PubKey,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+	}), IsNil)
+	preview, err = vMgr.ChurnPreview(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(preview.Blocked, Equals, "retiring vaults still have scheduled outbounds")
+	k.ClearScheduledOutbound(ctx, 1010)
+	preview, err = vMgr.ChurnPreview(ctx, constAccessor)
+	c.Assert(err, IsNil)
+	c.Check(preview.Blocked, Equals, "")
+}
+
 func (vts *ValidatorMgrV6TestSuite) TestFindCounToRemove(c *C) {
 	// remove one
 	c.Check(findCountToRemove(0, NodeAccounts{
@@ -97,6 +97,14 @@ func (vm *VaultMgrV1) EndBlock(ctx cosmos.Context, mgr Manager, constAccessor co
 			ctx.Logger().Info("Skipping the migration of funds while transactions are still pending")
 			return nil
 		}
+		scheduled, err := hasScheduledOutbound(ctx, vm.k, vault.PubKey)
+		if err != nil {
+			return err
+		}
+		if scheduled {
+			ctx.Logger().Info("Skipping the migration of funds while scheduled outbounds are still pending")
+			return nil
+		}
 	}
 
 	for _, vault := range retiring {
@@ -574,3 +574,46 @@ func (*VaultManagerV1TestSuite) TestRecallChainFunds(c *C) {
 	c.Assert(vaultMgr.recallChainFunds(ctx, common.BNBChain, mgr), NotNil)
 	helper.failGetActiveAsgardVault = false
 }
+
+func (*VaultManagerV1TestSuite) TestMigrateWaitScheduledOutbound(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ctx = ctx.WithBlockHeight(1000)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	vaultMgr := NewVaultMgrV1(k, mgr.TxOutStore(), mgr.EventMgr())
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(10000 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(10000 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	active := GetRandomVault()
+	c.Assert(k.SetVault(ctx, active), IsNil)
+	retiring := NewVault(1, RetiringVault, AsgardVault, GetRandomPubKey(), common.Chains{common.BNBChain})
+	retiring.StatusSince = 1000 - constAccessor.GetInt64Value(constants.FundMigrationInterval)
+	retiring.AddFunds(common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One))})
+	c.Assert(k.SetVault(ctx, retiring), IsNil)
+
+	// an outbound scheduled from the retiring vault hold the migration
+	c.Assert(k.AppendScheduledOutbound(ctx, 1010, &TxOutItem{
+		Chain:       common.BNBChain,
+		ToAddress:   GetRandomBNBAddress(),
+		VaultPubKey: retiring.PubKey,
+		InHash:      GetRandomTxHash(),
+		Coin:        common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+	}), IsNil)
+	c.Assert(vaultMgr.EndBlock(ctx, mgr, constAccessor), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Check(items, HasLen, 0)
+
+	// migrate once it is released
+	k.ClearScheduledOutbound(ctx, 1010)
+	c.Assert(vaultMgr.EndBlock(ctx, mgr, constAccessor), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 1)
+	c.Check(items[0].VaultPubKey.Equals(retiring.PubKey), Equals, true)
+}
@@ -79,6 +79,11 @@ func (ymgr YggMgrV1) Fund(ctx cosmos.Context, mgr Manager, constAccessor constan
 		return nil
 	}
 
+	// node account in maintenance might not be able to sign , don't give it more funds
+	if isNodeInMaintenance(ctx, ymgr.keeper, na.NodeAddress) {
+		return nil
+	}
+
 	// figure out if THORNode need to send them assets.
 	// get a list of coin/amounts this yggdrasil pool should have, ideally.
 	// TODO: We are assuming here that the pub key is Secp256K1
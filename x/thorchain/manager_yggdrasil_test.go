@@ -183,3 +183,42 @@ func (s YggdrasilSuite) TestFund(c *C) {
 		c.Assert(items, HasLen, 2)
 	}
 }
+
+func (s YggdrasilSuite) TestFundMaintenance(c *C) {
+	ctx, k := setupKeeperForTest(c)
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(10000*common.One)),
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(10000*common.One)),
+	}
+	k.SetVault(ctx, vault)
+	bnbPool := NewPool()
+	bnbPool.Asset = common.BNBAsset
+	bnbPool.BalanceAsset = cosmos.NewUint(100000 * common.One)
+	bnbPool.BalanceRune = cosmos.NewUint(100000 * common.One)
+	c.Assert(k.SetPool(ctx, bnbPool), IsNil)
+	mgr := NewDummyMgr()
+
+	// node accounts in maintenance don't get funded
+	height := common.BlockHeight(ctx)
+	for i := 0; i < 7; i++ {
+		na := GetRandomNodeAccount(NodeActive)
+		na.Bond = cosmos.NewUint(common.One * 1000000)
+		c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+		c.Assert(k.SetNodeMaintenance(ctx, NewNodeMaintenance(na.NodeAddress, height, height+10)), IsNil)
+	}
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	ymgr := NewYggMgrV1(k)
+	c.Assert(ymgr.Fund(ctx, mgr, constAccessor), IsNil)
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(items, HasLen, 0)
+
+	// maintenance is over
+	ctx = ctx.WithBlockHeight(height + 10)
+	c.Assert(ymgr.Fund(ctx, mgr, constAccessor), IsNil)
+	items, err = mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	c.Assert(len(items) > 0, Equals, true)
+}
@@ -51,6 +51,7 @@ type TxOutStore interface {
 	TryAddTxOutItem(ctx cosmos.Context, mgr Manager, toi *TxOutItem) (bool, error)
 	UnSafeAddTxOutItem(ctx cosmos.Context, mgr Manager, toi *TxOutItem) error
 	GetOutboundItemByToAddress(_ cosmos.Context, _ common.Address) []TxOutItem
+	EndBlock(ctx cosmos.Context, mgr Manager) error
 }
 
 // ObserverManager define the method to manage observes
@@ -68,6 +69,7 @@ type ValidatorManager interface {
 	RequestYggReturn(ctx cosmos.Context, node NodeAccount, mgr Manager) error
 	processRagnarok(ctx cosmos.Context, mgr Manager, constAccessor constants.ConstantValues) error
 	NodeAccountPreflightCheck(ctx cosmos.Context, na NodeAccount, constAccessor constants.ConstantValues) (NodeStatus, error)
+	ChurnPreview(ctx cosmos.Context, constAccessor constants.ConstantValues) (QueryChurnPreview, error)
 }
 
 // VaultManager interface define the contract of Vault Manager
@@ -90,8 +92,8 @@ type Slasher interface {
 	LackObserving(ctx cosmos.Context, constAccessor constants.ConstantValues) error
 	LackSigning(ctx cosmos.Context, constAccessor constants.ConstantValues, mgr Manager) error
 	SlashNodeAccount(ctx cosmos.Context, observedPubKey common.PubKey, asset common.Asset, slashAmount cosmos.Uint, mgr Manager) error
-	IncSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress)
-	DecSlashPoints(ctx cosmos.Context, point int64, addresses ...cosmos.AccAddress)
+	IncSlashPoints(ctx cosmos.Context, point int64, txID common.TxID, addresses ...cosmos.AccAddress)
+	DecSlashPoints(ctx cosmos.Context, point int64, txID common.TxID, addresses ...cosmos.AccAddress)
 }
 
 // YggManager define method to fund yggdrasil
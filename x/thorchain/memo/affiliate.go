@@ -0,0 +1,41 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// maxAffiliateBasisPoints affiliate fee can't be more than 100% , the actual cap is enforced by the handler
+const maxAffiliateBasisPoints = 10000
+
+// parseAffiliate parse the optional AFFILIATE:FEE parameters start from the given index of the memo parts
+// the affiliate address must be on the RUNE chain , as the affiliate fee is always paid in RUNE
+func parseAffiliate(parts []string, idx int) (common.Address, cosmos.Uint, error) {
+	affiliate := common.NoAddress
+	basisPoints := cosmos.ZeroUint()
+	var err error
+	if len(parts) > idx && len(parts[idx]) > 0 {
+		affiliate, err = common.NewAddress(parts[idx])
+		if err != nil {
+			return common.NoAddress, cosmos.ZeroUint(), err
+		}
+		if !affiliate.IsChain(common.RuneAsset().Chain) {
+			return common.NoAddress, cosmos.ZeroUint(), fmt.Errorf("affiliate address:%s is not a %s address", affiliate, common.RuneAsset().Chain)
+		}
+	}
+	if len(parts) > idx+1 && len(parts[idx+1]) > 0 {
+		basisPoints, err = cosmos.ParseUint(parts[idx+1])
+		if err != nil {
+			return common.NoAddress, cosmos.ZeroUint(), fmt.Errorf("affiliate fee:%s is invalid", parts[idx+1])
+		}
+		if basisPoints.GT(cosmos.NewUint(maxAffiliateBasisPoints)) {
+			return common.NoAddress, cosmos.ZeroUint(), fmt.Errorf("affiliate fee:%s is more than %d basis points", parts[idx+1], maxAffiliateBasisPoints)
+		}
+	}
+	if affiliate.IsEmpty() && !basisPoints.IsZero() {
+		return common.NoAddress, cosmos.ZeroUint(), fmt.Errorf("affiliate fee:%s without affiliate address", basisPoints)
+	}
+	return affiliate, basisPoints, nil
+}
@@ -2,21 +2,30 @@ package thorchain
 
 import (
 	"fmt"
+	"strconv"
 
+	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
 )
 
+// BondMemo BOND:<node address>:<bond provider address>:<node operator fee>
+// bond provider address and node operator fee are optional , only the node operator can use them to whitelist a bond provider
+// and set the fee , NodeOperatorFee is -1 when it is not set
 type BondMemo struct {
 	MemoBase
-	NodeAddress cosmos.AccAddress
+	NodeAddress         cosmos.AccAddress
+	BondProviderAddress common.Address
+	NodeOperatorFee     int64
 }
 
 func (m BondMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
 
-func NewBondMemo(addr cosmos.AccAddress) BondMemo {
+func NewBondMemo(addr cosmos.AccAddress, provider common.Address, operatorFee int64) BondMemo {
 	return BondMemo{
-		MemoBase:    MemoBase{TxType: TxBond},
-		NodeAddress: addr,
+		MemoBase:            MemoBase{TxType: TxBond},
+		NodeAddress:         addr,
+		BondProviderAddress: provider,
+		NodeOperatorFee:     operatorFee,
 	}
 }
 
@@ -28,5 +37,22 @@ func ParseBondMemo(parts []string) (BondMemo, error) {
 	if err != nil {
 		return BondMemo{}, fmt.Errorf("%s is an invalid thorchain address: %w", parts[1], err)
 	}
-	return NewBondMemo(addr), nil
+	provider := common.NoAddress
+	if len(parts) > 2 && len(parts[2]) > 0 {
+		provider, err = common.NewAddress(parts[2])
+		if err != nil {
+			return BondMemo{}, fmt.Errorf("%s is an invalid bond provider address: %w", parts[2], err)
+		}
+	}
+	operatorFee := int64(-1)
+	if len(parts) > 3 && len(parts[3]) > 0 {
+		operatorFee, err = strconv.ParseInt(parts[3], 10, 64)
+		if err != nil {
+			return BondMemo{}, fmt.Errorf("fail to parse node operator fee (%s): %w", parts[3], err)
+		}
+		if operatorFee < 0 || operatorFee > 10000 {
+			return BondMemo{}, fmt.Errorf("node operator fee (%d) must be between 0 and 10000", operatorFee)
+		}
+	}
+	return NewBondMemo(addr, provider, operatorFee), nil
 }
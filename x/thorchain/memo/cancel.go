@@ -0,0 +1,36 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type CancelMemo struct {
+	MemoBase
+	TxID common.TxID
+}
+
+func (m CancelMemo) GetTxID() common.TxID { return m.TxID }
+
+// String implement fmt.Stringer
+func (m CancelMemo) String() string {
+	return fmt.Sprintf("CANCEL:%s", m.TxID.String())
+}
+
+// NewCancelMemo create a new CancelMemo
+func NewCancelMemo(txID common.TxID) CancelMemo {
+	return CancelMemo{
+		MemoBase: MemoBase{TxType: TxCancel},
+		TxID:     txID,
+	}
+}
+
+// ParseCancelMemo parse a memo in the format of CANCEL:TXID , TXID is the tx id of the limit order to cancel
+func ParseCancelMemo(parts []string) (CancelMemo, error) {
+	if len(parts) < 2 {
+		return CancelMemo{}, fmt.Errorf("not enough parameters")
+	}
+	txID, err := common.NewTxID(parts[1])
+	return NewCancelMemo(txID), err
+}
@@ -0,0 +1,56 @@
+package thorchain
+
+import (
+	"fmt"
+	"strconv"
+
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type LimitMemo struct {
+	MemoBase
+	Destination  common.Address
+	MinOut       cosmos.Uint
+	ExpiryHeight int64
+}
+
+func (m LimitMemo) GetDestination() common.Address { return m.Destination }
+func (m LimitMemo) GetSlipLimit() cosmos.Uint      { return m.MinOut }
+func (m LimitMemo) GetBlockHeight() int64          { return m.ExpiryHeight }
+
+func NewLimitMemo(asset common.Asset, dest common.Address, minOut cosmos.Uint, expiryHeight int64) LimitMemo {
+	return LimitMemo{
+		MemoBase:     MemoBase{TxType: TxLimit, Asset: asset},
+		Destination:  dest,
+		MinOut:       minOut,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// ParseLimitMemo parse a limit order memo in the format of LIMIT:ASSET:DESTADDR:MINOUT:EXPIRY
+// DESTADDR can be empty , the target asset will be sent to the sender address
+// MINOUT is the minimum amount of target asset the order should emit , EXPIRY is the block height the order get refunded
+// if it is not filled by then
+func ParseLimitMemo(asset common.Asset, parts []string) (LimitMemo, error) {
+	var err error
+	if len(parts) < 5 {
+		return LimitMemo{}, fmt.Errorf("not enough parameters")
+	}
+	destination := common.NoAddress
+	if len(parts[2]) > 0 {
+		destination, err = common.NewAddress(parts[2])
+		if err != nil {
+			return LimitMemo{}, err
+		}
+	}
+	minOut, err := cosmos.ParseUint(parts[3])
+	if err != nil || minOut.IsZero() {
+		return LimitMemo{}, fmt.Errorf("limit order min out:%s is invalid", parts[3])
+	}
+	expiryHeight, err := strconv.ParseInt(parts[4], 10, 64)
+	if err != nil || expiryHeight <= 0 {
+		return LimitMemo{}, fmt.Errorf("limit order expiry:%s is invalid", parts[4])
+	}
+	return NewLimitMemo(asset, destination, minOut, expiryHeight), nil
+}
@@ -29,6 +29,8 @@ const (
 	TxMigrate
 	TxRagnarok
 	TxSwitch
+	TxLimit
+	TxCancel
 )
 
 var stringToTxTypeMap = map[string]TxType{
@@ -56,6 +58,8 @@ var stringToTxTypeMap = map[string]TxType{
 	"migrate":    TxMigrate,
 	"ragnarok":   TxRagnarok,
 	"switch":     TxSwitch,
+	"limit":      TxLimit,
+	"cancel":     TxCancel,
 }
 
 var txToStringMap = map[TxType]string{
@@ -74,6 +78,8 @@ var txToStringMap = map[TxType]string{
 	TxMigrate:         "migrate",
 	TxRagnarok:        "ragnarok",
 	TxSwitch:          "switch",
+	TxLimit:           "limit",
+	TxCancel:          "cancel",
 }
 
 // converts a string into a txType
@@ -88,7 +94,7 @@ func StringToTxType(s string) (TxType, error) {
 
 func (tx TxType) IsInbound() bool {
 	switch tx {
-	case TxStake, TxUnstake, TxSwap, TxAdd, TxBond, TxUnbond, TxLeave, TxSwitch, TxReserve:
+	case TxStake, TxUnstake, TxSwap, TxAdd, TxBond, TxUnbond, TxLeave, TxSwitch, TxReserve, TxLimit, TxCancel:
 		return true
 	default:
 		return false
@@ -179,7 +185,7 @@ func ParseMemo(memo string) (Memo, error) {
 
 	var asset common.Asset
 	switch tx {
-	case TxAdd, TxStake, TxSwap, TxUnstake:
+	case TxAdd, TxStake, TxSwap, TxUnstake, TxLimit:
 		if len(parts) < 2 {
 			return noMemo, fmt.Errorf("cannot parse given memo: length %d", len(parts))
 		}
@@ -220,6 +226,10 @@ func ParseMemo(memo string) (Memo, error) {
 		return ParseRagnarokMemo(parts)
 	case TxSwitch:
 		return ParseSwitchMemo(parts)
+	case TxLimit:
+		return ParseLimitMemo(asset, parts)
+	case TxCancel:
+		return ParseCancelMemo(parts)
 	default:
 		return noMemo, fmt.Errorf("TxType not supported: %s", tx.String())
 	}
@@ -22,7 +22,7 @@ func (s *MemoSuite) SetUpSuite(c *C) {
 }
 
 func (s *MemoSuite) TestTxType(c *C) {
-	for _, trans := range []TxType{TxStake, TxUnstake, TxSwap, TxOutbound, TxAdd, TxBond, TxUnbond, TxLeave, TxSwitch} {
+	for _, trans := range []TxType{TxStake, TxUnstake, TxSwap, TxOutbound, TxAdd, TxBond, TxUnbond, TxLeave, TxSwitch, TxLimit, TxCancel} {
 		tx, err := StringToTxType(trans.String())
 		c.Assert(err, IsNil)
 		c.Check(tx, Equals, trans)
@@ -195,6 +195,18 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Check(memo.IsType(TxUnstake), Equals, true, Commentf("MEMO: %+v", memo))
 	c.Check(memo.GetAmount().Equal(cosmos.NewUint(25)), Equals, true, Commentf("%d", memo.GetAmount().Uint64()))
 
+	memo, err = ParseMemo("WITHDRAW:BNB.BNB:25:" + common.RuneAsset().String())
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxUnstake), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetAmount().Equal(cosmos.NewUint(25)), Equals, true)
+	c.Check(memo.(UnstakeMemo).GetTargetAsset().Equals(common.RuneAsset()), Equals, true)
+	memo, err = ParseMemo("WITHDRAW:BNB.BNB::BNB.BNB")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetAmount().IsZero(), Equals, true)
+	c.Check(memo.(UnstakeMemo).GetTargetAsset().Equals(common.BNBAsset), Equals, true)
+	_, err = ParseMemo("WITHDRAW:BNB.BNB:25:BTC.BTC")
+	c.Assert(err, NotNil)
+
 	memo, err = ParseMemo("SWAP:" + common.RuneAsset().String() + ":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000")
 	c.Assert(err, IsNil)
 	c.Check(memo.GetAsset().String(), Equals, common.RuneAsset().String())
@@ -217,6 +229,106 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
 	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
 
+	memo, err = ParseMemo("SWAP:" + common.RuneAsset().String() + ":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:300bp")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
+	swapMemo, ok := memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.MaxSlip.Uint64(), Equals, uint64(300))
+	c.Check(swapMemo.MaxLiquidityFee.Uint64(), Equals, uint64(0))
+
+	memo, err = ParseMemo("SWAP:" + common.RuneAsset().String() + ":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000,300BP,100000000fee")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(870000000))
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.MaxSlip.Uint64(), Equals, uint64(300))
+	c.Check(swapMemo.MaxLiquidityFee.Uint64(), Equals, uint64(100000000))
+	c.Check(swapMemo.StreamQuantity, Equals, int64(0))
+
+	memo, err = ParseMemo("SWAP:" + common.RuneAsset().String() + ":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000,300bp/3/10")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(870000000))
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.MaxSlip.Uint64(), Equals, uint64(300))
+	c.Check(swapMemo.StreamInterval, Equals, int64(3))
+	c.Check(swapMemo.StreamQuantity, Equals, int64(10))
+
+	memo, err = ParseMemo("SWAP:" + common.RuneAsset().String() + ":bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:/1/5")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(0))
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.StreamInterval, Equals, int64(1))
+	c.Check(swapMemo.StreamQuantity, Equals, int64(5))
+	c.Check(swapMemo.AffiliateAddress.IsEmpty(), Equals, true)
+	c.Check(swapMemo.AffiliateBasisPoints.IsZero(), Equals, true)
+
+	memo, err = ParseMemo("SWAP:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38:50")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(870000000))
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.AffiliateAddress.String(), Equals, "bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38")
+	c.Check(swapMemo.AffiliateBasisPoints.Uint64(), Equals, uint64(50))
+
+	memo, err = ParseMemo("SWAP:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38")
+	c.Assert(err, IsNil)
+	swapMemo, ok = memo.(SwapMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(swapMemo.AffiliateAddress.String(), Equals, "bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38")
+	c.Check(swapMemo.AffiliateBasisPoints.IsZero(), Equals, true)
+
+	// affiliate fee without affiliate address
+	_, err = ParseMemo("SWAP:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:::50")
+	c.Assert(err, NotNil)
+	// affiliate fee more than 100%
+	_, err = ParseMemo("SWAP:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38:10001")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("SWAP:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38:abc")
+	c.Assert(err, NotNil)
+	// affiliate address is not on RUNE chain
+	_, err = ParseMemo("SWAP:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6::bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej:50")
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemo("STAKE:BTC.BTC:bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej:bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38:100")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetDestination().String(), Equals, "bc1qwqdg6squsna38e46795at95yu9atm8azzmyvckulcc7kytlcckxswvvzej")
+	stakeMemo, ok := memo.(StakeMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(stakeMemo.AffiliateAddress.String(), Equals, "bnb1xlvns0n2mxh77mzaspn2hgav4rr4m8eerfju38")
+	c.Check(stakeMemo.AffiliateBasisPoints.Uint64(), Equals, uint64(100))
+	_, err = ParseMemo("STAKE:BNB.BNB:::100")
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemo("LIMIT:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:1000")
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxLimit), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.IsInbound(), Equals, true)
+	c.Check(memo.GetAsset().String(), Equals, "BNB.BNB")
+	c.Check(memo.GetDestination().String(), Equals, "bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6")
+	c.Check(memo.GetSlipLimit().Uint64(), Equals, uint64(870000000))
+	c.Check(memo.GetBlockHeight(), Equals, int64(1000))
+	memo, err = ParseMemo("limit:BNB.BNB::870000000:1000")
+	c.Assert(err, IsNil)
+	c.Check(memo.GetDestination().IsEmpty(), Equals, true)
+	_, err = ParseMemo("limit:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("limit:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:0:1000")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("limit:BNB.BNB:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:870000000:abc")
+	c.Assert(err, NotNil)
+
+	orderTxID := types.GetRandomTxHash()
+	memo, err = ParseMemo("CANCEL:" + orderTxID.String())
+	c.Assert(err, IsNil)
+	c.Check(memo.IsType(TxCancel), Equals, true, Commentf("MEMO: %+v", memo))
+	c.Check(memo.GetTxID(), Equals, orderTxID)
+	c.Check(memo.String(), Equals, "CANCEL:"+orderTxID.String())
+	_, err = ParseMemo("cancel:")
+	c.Assert(err, NotNil)
+
 	whiteListAddr := types.GetRandomBech32Addr()
 	memo, err = ParseMemo("bond:" + whiteListAddr.String())
 	c.Assert(err, IsNil)
@@ -233,6 +345,33 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Assert(memo.GetAccAddress().String(), Equals, whiteListAddr.String())
 	c.Assert(memo.GetAmount().Equal(cosmos.NewUint(300)), Equals, true)
 
+	providerAddr := types.GetRandomBNBAddress()
+	memo, err = ParseMemo("bond:" + whiteListAddr.String() + ":" + providerAddr.String() + ":2000")
+	c.Assert(err, IsNil)
+	bondMemo, ok := memo.(BondMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(bondMemo.BondProviderAddress.Equals(providerAddr), Equals, true)
+	c.Check(bondMemo.NodeOperatorFee, Equals, int64(2000))
+	memo, err = ParseMemo("bond:" + whiteListAddr.String() + "::500")
+	c.Assert(err, IsNil)
+	bondMemo, ok = memo.(BondMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(bondMemo.BondProviderAddress.IsEmpty(), Equals, true)
+	c.Check(bondMemo.NodeOperatorFee, Equals, int64(500))
+	memo, err = ParseMemo("bond:" + whiteListAddr.String())
+	c.Assert(err, IsNil)
+	c.Check(memo.(BondMemo).NodeOperatorFee, Equals, int64(-1))
+	_, err = ParseMemo("bond:" + whiteListAddr.String() + "::10001")
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("bond:" + whiteListAddr.String() + "::abc")
+	c.Assert(err, NotNil)
+
+	memo, err = ParseMemo("unbond:" + whiteListAddr.String() + ":300:" + providerAddr.String())
+	c.Assert(err, IsNil)
+	unbondMemo, ok := memo.(UnbondMemo)
+	c.Assert(ok, Equals, true)
+	c.Check(unbondMemo.BondProviderAddress.Equals(providerAddr), Equals, true)
+
 	memo, err = ParseMemo("migrate:100")
 	c.Assert(err, IsNil)
 	c.Check(memo.IsType(TxMigrate), Equals, true)
@@ -304,6 +443,16 @@ func (s *MemoSuite) TestParse(c *C) {
 	c.Assert(err, NotNil)
 	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:five") // bad slip limit
 	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:fivebp") // bad slip tolerance
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:100,-1fee") // bad liquidity fee limit
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:100/x/10") // bad stream interval
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:100/1/-10") // bad stream quantity
+	c.Assert(err, NotNil)
+	_, err = ParseMemo("swap:bnb:bnb1lejrrtta9cgr49fuh7ktu3sddhe0ff7wenlpn6:100/1/10/1") // too many stream parameters
+	c.Assert(err, NotNil)
 	_, err = ParseMemo("admin:key:val") // not enough arguments
 	c.Assert(err, NotNil)
 	_, err = ParseMemo("admin:bogus:key:value") // bogus admin command type
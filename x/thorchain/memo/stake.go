@@ -4,22 +4,32 @@ import (
 	"fmt"
 
 	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
 )
 
 type StakeMemo struct {
 	MemoBase
 	Address common.Address
+	// AffiliateAddress is the RUNE address of the affiliate that will be paid a fee
+	AffiliateAddress common.Address
+	// AffiliateBasisPoints is the affiliate fee in basis points of the staked amount
+	AffiliateBasisPoints cosmos.Uint
 }
 
 func (m StakeMemo) GetDestination() common.Address { return m.Address }
 
 func NewStakeMemo(asset common.Asset, addr common.Address) StakeMemo {
 	return StakeMemo{
-		MemoBase: MemoBase{TxType: TxStake, Asset: asset},
-		Address:  addr,
+		MemoBase:             MemoBase{TxType: TxStake, Asset: asset},
+		Address:              addr,
+		AffiliateBasisPoints: cosmos.ZeroUint(),
 	}
 }
 
+// ParseStakeMemo parse a stake memo in the format of STAKE:ASSET:ADDR:AFFILIATE:FEE
+// ADDR is mandatory when staking into a non THOR-based pool
+// AFFILIATE and FEE are optional , FEE is the affiliate fee in basis points skimmed from the staked amount and paid
+// to the AFFILIATE address in RUNE
 func ParseStakeMemo(asset common.Asset, parts []string) (StakeMemo, error) {
 	var addr common.Address
 	var err error
@@ -34,5 +44,12 @@ func ParseStakeMemo(asset common.Asset, parts []string) (StakeMemo, error) {
 			return StakeMemo{}, err
 		}
 	}
-	return NewStakeMemo(asset, addr), nil
+	affiliate, affiliateBasisPoints, err := parseAffiliate(parts, 3)
+	if err != nil {
+		return StakeMemo{}, err
+	}
+	m := NewStakeMemo(asset, addr)
+	m.AffiliateAddress = affiliate
+	m.AffiliateBasisPoints = affiliateBasisPoints
+	return m, nil
 }
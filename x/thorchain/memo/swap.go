@@ -2,15 +2,32 @@ package thorchain
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
 )
 
+const (
+	// suffix of a slip tolerance limit in basis points , e.g 300bp
+	slipLimitSuffix = "bp"
+	// suffix of a max liquidity fee limit in RUNE , e.g 100000000fee
+	liquidityFeeLimitSuffix = "fee"
+)
+
 type SwapMemo struct {
 	MemoBase
-	Destination common.Address
-	SlipLimit   cosmos.Uint
+	Destination     common.Address
+	SlipLimit       cosmos.Uint
+	MaxSlip         cosmos.Uint
+	MaxLiquidityFee cosmos.Uint
+	StreamInterval  int64
+	StreamQuantity  int64
+	// AffiliateAddress is the RUNE address of the affiliate that will be paid a fee
+	AffiliateAddress common.Address
+	// AffiliateBasisPoints is the affiliate fee in basis points of the inbound amount
+	AffiliateBasisPoints cosmos.Uint
 }
 
 func (m SwapMemo) GetDestination() common.Address { return m.Destination }
@@ -18,12 +35,24 @@ func (m SwapMemo) GetSlipLimit() cosmos.Uint      { return m.SlipLimit }
 
 func NewSwapMemo(asset common.Asset, dest common.Address, slip cosmos.Uint) SwapMemo {
 	return SwapMemo{
-		MemoBase:    MemoBase{TxType: TxSwap, Asset: asset},
-		Destination: dest,
-		SlipLimit:   slip,
+		MemoBase:             MemoBase{TxType: TxSwap, Asset: asset},
+		Destination:          dest,
+		SlipLimit:            slip,
+		MaxSlip:              cosmos.ZeroUint(),
+		MaxLiquidityFee:      cosmos.ZeroUint(),
+		AffiliateBasisPoints: cosmos.ZeroUint(),
 	}
 }
 
+// ParseSwapMemo parse a swap memo in the format of SWAP:ASSET:DESTADDR:LIM/INTERVAL/QUANTITY:AFFILIATE:FEE
+// LIM is a comma separated list of limits , each of them can be empty
+// 1. a plain amount is the price limit , the minimum amount of target asset to emit
+// 2. an amount with "bp" suffix is the maximum trade slip in basis points , for double swap it is the combined slip
+// 3. an amount with "fee" suffix is the maximum liquidity fee in RUNE
+// INTERVAL and QUANTITY are optional , when QUANTITY is more than one , the swap will be streamed , split into QUANTITY
+// sub-swaps , one sub-swap every INTERVAL blocks
+// AFFILIATE and FEE are optional , FEE is the affiliate fee in basis points skimmed from the inbound amount and paid
+// to the AFFILIATE address in RUNE
 func ParseSwapMemo(asset common.Asset, parts []string) (SwapMemo, error) {
 	var err error
 	if len(parts) < 2 {
@@ -41,13 +70,58 @@ func ParseSwapMemo(asset common.Asset, parts []string) (SwapMemo, error) {
 	}
 	// price limit can be empty , when it is empty , there is no price protection
 	slip := cosmos.ZeroUint()
+	maxSlip := cosmos.ZeroUint()
+	maxLiquidityFee := cosmos.ZeroUint()
+	var interval, quantity int64
 	if len(parts) > 3 && len(parts[3]) > 0 {
-		amount, err := cosmos.ParseUint(parts[3])
-		if err != nil {
-			return SwapMemo{}, fmt.Errorf("swap price limit:%s is invalid", parts[3])
+		streamParts := strings.Split(parts[3], "/")
+		if len(streamParts) > 3 {
+			return SwapMemo{}, fmt.Errorf("swap limit:%s is invalid", parts[3])
 		}
-
-		slip = amount
+		if len(streamParts) > 1 && len(streamParts[1]) > 0 {
+			interval, err = strconv.ParseInt(streamParts[1], 10, 64)
+			if err != nil || interval < 0 {
+				return SwapMemo{}, fmt.Errorf("swap stream interval:%s is invalid", streamParts[1])
+			}
+		}
+		if len(streamParts) > 2 && len(streamParts[2]) > 0 {
+			quantity, err = strconv.ParseInt(streamParts[2], 10, 64)
+			if err != nil || quantity < 0 {
+				return SwapMemo{}, fmt.Errorf("swap stream quantity:%s is invalid", streamParts[2])
+			}
+		}
+		for _, limit := range strings.Split(streamParts[0], ",") {
+			switch {
+			case len(limit) == 0:
+				continue
+			case strings.HasSuffix(strings.ToLower(limit), slipLimitSuffix):
+				maxSlip, err = cosmos.ParseUint(limit[:len(limit)-len(slipLimitSuffix)])
+				if err != nil {
+					return SwapMemo{}, fmt.Errorf("swap slip limit:%s is invalid", limit)
+				}
+			case strings.HasSuffix(strings.ToLower(limit), liquidityFeeLimitSuffix):
+				maxLiquidityFee, err = cosmos.ParseUint(limit[:len(limit)-len(liquidityFeeLimitSuffix)])
+				if err != nil {
+					return SwapMemo{}, fmt.Errorf("swap liquidity fee limit:%s is invalid", limit)
+				}
+			default:
+				slip, err = cosmos.ParseUint(limit)
+				if err != nil {
+					return SwapMemo{}, fmt.Errorf("swap price limit:%s is invalid", limit)
+				}
+			}
+		}
+	}
+	affiliate, affiliateBasisPoints, err := parseAffiliate(parts, 4)
+	if err != nil {
+		return SwapMemo{}, err
 	}
-	return NewSwapMemo(asset, destination, slip), nil
+	m := NewSwapMemo(asset, destination, slip)
+	m.MaxSlip = maxSlip
+	m.MaxLiquidityFee = maxLiquidityFee
+	m.StreamInterval = interval
+	m.StreamQuantity = quantity
+	m.AffiliateAddress = affiliate
+	m.AffiliateBasisPoints = affiliateBasisPoints
+	return m, nil
 }
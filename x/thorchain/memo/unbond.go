@@ -3,23 +3,28 @@ package thorchain
 import (
 	"fmt"
 
+	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
 )
 
+// UnbondMemo UNBOND:<node address>:<amount>:<bond provider address>
+// bond provider address is optional , only the node operator can use it to unbond on behalf of a bond provider
 type UnbondMemo struct {
 	MemoBase
-	NodeAddress cosmos.AccAddress
-	Amount      cosmos.Uint
+	NodeAddress         cosmos.AccAddress
+	Amount              cosmos.Uint
+	BondProviderAddress common.Address
 }
 
 func (m UnbondMemo) GetAccAddress() cosmos.AccAddress { return m.NodeAddress }
 func (m UnbondMemo) GetAmount() cosmos.Uint           { return m.Amount }
 
-func NewUnbondMemo(addr cosmos.AccAddress, amt cosmos.Uint) UnbondMemo {
+func NewUnbondMemo(addr cosmos.AccAddress, amt cosmos.Uint, provider common.Address) UnbondMemo {
 	return UnbondMemo{
-		MemoBase:    MemoBase{TxType: TxUnbond},
-		NodeAddress: addr,
-		Amount:      amt,
+		MemoBase:            MemoBase{TxType: TxUnbond},
+		NodeAddress:         addr,
+		Amount:              amt,
+		BondProviderAddress: provider,
 	}
 }
 
@@ -35,5 +40,12 @@ func ParseUnbondMemo(parts []string) (UnbondMemo, error) {
 	if err != nil {
 		return UnbondMemo{}, fmt.Errorf("fail to parse amount (%s): %w", parts[2], err)
 	}
-	return NewUnbondMemo(addr, amt), nil
+	provider := common.NoAddress
+	if len(parts) > 3 && len(parts[3]) > 0 {
+		provider, err = common.NewAddress(parts[3])
+		if err != nil {
+			return UnbondMemo{}, fmt.Errorf("%s is an invalid bond provider address: %w", parts[3], err)
+		}
+	}
+	return NewUnbondMemo(addr, amt, provider), nil
 }
@@ -10,11 +10,15 @@ import (
 
 type UnstakeMemo struct {
 	MemoBase
-	Amount cosmos.Uint
+	Amount      cosmos.Uint
+	TargetAsset common.Asset
 }
 
 func (m UnstakeMemo) GetAmount() cosmos.Uint { return m.Amount }
 
+// GetTargetAsset return the asset the staker wants to withdraw to , empty means withdraw both RUNE and asset
+func (m UnstakeMemo) GetTargetAsset() common.Asset { return m.TargetAsset }
+
 func NewUnstakeMemo(asset common.Asset, amt cosmos.Uint) UnstakeMemo {
 	return UnstakeMemo{
 		MemoBase: MemoBase{TxType: TxUnstake, Asset: asset},
@@ -28,7 +32,7 @@ func ParseUnstakeMemo(asset common.Asset, parts []string) (UnstakeMemo, error) {
 		return UnstakeMemo{}, fmt.Errorf("not enough parameters")
 	}
 	withdrawlBasisPts := cosmos.ZeroUint()
-	if len(parts) > 2 {
+	if len(parts) > 2 && len(parts[2]) > 0 {
 		withdrawlBasisPts, err = cosmos.ParseUint(parts[2])
 		if err != nil {
 			return UnstakeMemo{}, err
@@ -37,5 +41,16 @@ func ParseUnstakeMemo(asset common.Asset, parts []string) (UnstakeMemo, error) {
 			return UnstakeMemo{}, fmt.Errorf("withdraw amount %s is invalid", parts[2])
 		}
 	}
-	return NewUnstakeMemo(asset, withdrawlBasisPts), nil
+	m := NewUnstakeMemo(asset, withdrawlBasisPts)
+	// optional target asset , withdraw everything to either RUNE or the pool asset
+	if len(parts) > 3 && len(parts[3]) > 0 {
+		m.TargetAsset, err = common.NewAsset(parts[3])
+		if err != nil {
+			return UnstakeMemo{}, err
+		}
+		if !m.TargetAsset.IsRune() && !m.TargetAsset.Equals(asset) {
+			return UnstakeMemo{}, fmt.Errorf("target asset %s is neither RUNE nor %s", parts[3], asset)
+		}
+	}
+	return m, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/blang/semver"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -162,10 +163,20 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 		ctx.Logger().Error("fail to process swap queue", "error", err)
 	}
 
-	// slash node accounts for not observing any accepted inbound tx
+	// from 0.7.0 the observers of this block are saved first , so the slasher can tell who didn't observe the chains reached consensus
+	// before that the slasher only saw the observers cleared at the begin of the block , and never slashed for lack of observing
+	observersFirst := version.GTE(semver.MustParse("0.7.0"))
+	if observersFirst {
+		am.mgr.ObMgr().EndBlock(ctx, am.keeper)
+	}
+
+	// slash node accounts for not observing the accepted txs of a chain
 	if err := am.mgr.Slasher().LackObserving(ctx, constantValues); err != nil {
 		ctx.Logger().Error("Unable to slash for lack of observing:", "error", err)
 	}
+	if !observersFirst {
+		am.mgr.ObMgr().EndBlock(ctx, am.keeper)
+	}
 	if err := am.mgr.Slasher().LackSigning(ctx, constantValues, am.mgr); err != nil {
 		ctx.Logger().Error("Unable to slash for lack of signing:", "error", err)
 	}
@@ -176,12 +187,20 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	}
 	// Enable a pool every newPoolCycle
 	if common.BlockHeight(ctx)%newPoolCycle == 0 && !am.keeper.RagnarokInProgress(ctx) {
-		if err := enableNextPool(ctx, am.keeper, am.mgr.EventMgr()); err != nil {
+		policy := getPoolEnablePolicy(ctx, am.keeper, constantValues)
+		if err := enableNextPool(ctx, am.keeper, am.mgr.EventMgr(), policy); err != nil {
 			ctx.Logger().Error("Unable to enable a pool", "error", err)
 		}
 	}
 
-	am.mgr.ObMgr().EndBlock(ctx, am.keeper)
+	if !am.keeper.RagnarokInProgress(ctx) {
+		if err := processPoolSuspensions(ctx, am.keeper, am.mgr, constantValues); err != nil {
+			ctx.Logger().Error("fail to process pool suspensions", "error", err)
+		}
+		if err := expirePendingRune(ctx, am.keeper, am.mgr, constantValues); err != nil {
+			ctx.Logger().Error("fail to expire pending rune", "error", err)
+		}
+	}
 
 	// update vault data to account for block rewards and reward units
 	if err := am.mgr.VaultMgr().UpdateVaultData(ctx, constantValues, am.mgr.GasMgr(), am.mgr.EventMgr()); err != nil {
@@ -201,8 +220,27 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 		ctx.Logger().Error("unable to fund yggdrasil", "error", err)
 	}
 
+	if err := am.mgr.TxOutStore().EndBlock(ctx, am.mgr); err != nil {
+		ctx.Logger().Error("fail to release scheduled outbound", "error", err)
+	}
+
 	am.mgr.GasMgr().EndBlock(ctx, am.keeper, am.mgr.EventMgr())
 
+	if !am.keeper.RagnarokInProgress(ctx) {
+		if err := processChainHalts(ctx, am.keeper, am.mgr, constantValues); err != nil {
+			ctx.Logger().Error("fail to process chain halts", "error", err)
+		}
+	}
+
+	pruneSlashRecords(ctx, am.keeper, constantValues)
+
+	if err := updatePoolPriceAccumulators(ctx, am.keeper, constantValues); err != nil {
+		ctx.Logger().Error("fail to update pool price accumulators", "error", err)
+	}
+	if err := recordPoolSnapshots(ctx, am.keeper, constantValues); err != nil {
+		ctx.Logger().Error("fail to record pool snapshots", "error", err)
+	}
+
 	return validators
 }
 
@@ -0,0 +1,76 @@
+package thorchain
+
+import (
+	"fmt"
+	"sort"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// PoolEnablePolicy decide the order bootstrap pools get enabled in , and whether a pool can be enabled at all
+// the first eligible candidate is enabled at the end of every new pool cycle
+type PoolEnablePolicy interface {
+	Rank(pools Pools) []PoolCandidate
+}
+
+// PoolCandidate is a bootstrap pool ranked by a PoolEnablePolicy , Reason explain why a pool is not eligible
+type PoolCandidate struct {
+	Pool     Pool
+	Eligible bool
+	Reason   string
+}
+
+// depthPoolEnablePolicy rank the bootstrap pools by RUNE depth , deepest first , pools of the same depth are ordered by asset
+// a pool needs to have both RUNE and asset , and at least minRuneDepth RUNE to be eligible
+type depthPoolEnablePolicy struct {
+	minRuneDepth cosmos.Uint
+}
+
+// newDepthPoolEnablePolicy create a new instance of depthPoolEnablePolicy
+func newDepthPoolEnablePolicy(minRuneDepth cosmos.Uint) depthPoolEnablePolicy {
+	return depthPoolEnablePolicy{
+		minRuneDepth: minRuneDepth,
+	}
+}
+
+// getPoolEnablePolicy return the policy used to enable pools , MinRunePoolDepth mimir takes precedence over the constant
+func getPoolEnablePolicy(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) PoolEnablePolicy {
+	minRuneDepth, err := keeper.GetMimir(ctx, constants.MinRunePoolDepth.String())
+	if minRuneDepth < 0 || err != nil {
+		minRuneDepth = constAccessor.GetInt64Value(constants.MinRunePoolDepth)
+	}
+	return newDepthPoolEnablePolicy(cosmos.NewUint(uint64(minRuneDepth)))
+}
+
+// Rank implement PoolEnablePolicy
+func (p depthPoolEnablePolicy) Rank(pools Pools) []PoolCandidate {
+	candidates := make([]PoolCandidate, 0)
+	for _, pool := range pools {
+		if pool.Status != PoolBootstrap {
+			continue
+		}
+		candidate := PoolCandidate{
+			Pool:     pool,
+			Eligible: true,
+		}
+		switch {
+		case pool.BalanceRune.IsZero() || pool.BalanceAsset.IsZero():
+			candidate.Eligible = false
+			candidate.Reason = "pool doesn't have both RUNE and asset"
+		case pool.BalanceRune.LT(p.minRuneDepth):
+			candidate.Eligible = false
+			candidate.Reason = fmt.Sprintf("RUNE depth is less than the minimum of %s", p.minRuneDepth)
+		}
+		candidates = append(candidates, candidate)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		left, right := candidates[i].Pool, candidates[j].Pool
+		if !left.BalanceRune.Equal(right.BalanceRune) {
+			return left.BalanceRune.GT(right.BalanceRune)
+		}
+		return left.Asset.String() < right.Asset.String()
+	})
+	return candidates
+}
@@ -0,0 +1,73 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type PoolEnablePolicySuite struct{}
+
+var _ = Suite(&PoolEnablePolicySuite{})
+
+func (s *PoolEnablePolicySuite) TestDepthPoolEnablePolicy(c *C) {
+	policy := newDepthPoolEnablePolicy(cosmos.NewUint(100 * common.One))
+	newBootstrapPool := func(asset common.Asset, rune, ast uint64) Pool {
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolBootstrap
+		pool.BalanceRune = cosmos.NewUint(rune * common.One)
+		pool.BalanceAsset = cosmos.NewUint(ast * common.One)
+		return pool
+	}
+	// the only candidate is still not eligible when it is shallower than the minimum
+	candidates := policy.Rank(Pools{newBootstrapPool(common.BNBAsset, 10, 10)})
+	c.Assert(candidates, HasLen, 1)
+	c.Check(candidates[0].Eligible, Equals, false)
+	c.Check(candidates[0].Reason, Not(Equals), "")
+
+	tusdAsset, err := common.NewAsset("BNB.TUSDB")
+	c.Assert(err, IsNil)
+	xmrAsset, err := common.NewAsset("XMR.XMR")
+	c.Assert(err, IsNil)
+	enabled := newBootstrapPool(common.ETHAsset, 1000, 1000)
+	enabled.Status = PoolEnabled
+	candidates = policy.Rank(Pools{
+		enabled,
+		newBootstrapPool(common.BNBAsset, 10, 10),
+		newBootstrapPool(common.BTCAsset, 200, 200),
+		newBootstrapPool(tusdAsset, 200, 200),
+		newBootstrapPool(xmrAsset, 500, 0),
+	})
+	c.Assert(candidates, HasLen, 4)
+	c.Check(candidates[0].Pool.Asset.Equals(xmrAsset), Equals, true)
+	c.Check(candidates[0].Eligible, Equals, false)
+	// same depth , ordered by asset
+	c.Check(candidates[1].Pool.Asset.Equals(tusdAsset), Equals, true)
+	c.Check(candidates[1].Eligible, Equals, true)
+	c.Check(candidates[2].Pool.Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(candidates[2].Eligible, Equals, true)
+	c.Check(candidates[3].Pool.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(candidates[3].Eligible, Equals, false)
+}
+
+func (s *PoolEnablePolicySuite) TestGetPoolEnablePolicy(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	pools := Pools{NewPool()}
+	pools[0].Asset = common.BNBAsset
+	pools[0].Status = PoolBootstrap
+	pools[0].BalanceRune = cosmos.NewUint(common.One)
+	pools[0].BalanceAsset = cosmos.NewUint(common.One)
+
+	candidates := getPoolEnablePolicy(ctx, k, constAccessor).Rank(pools)
+	c.Assert(candidates, HasLen, 1)
+	c.Check(candidates[0].Eligible, Equals, true)
+
+	k.SetMimir(ctx, constants.MinRunePoolDepth.String(), 2*common.One)
+	candidates = getPoolEnablePolicy(ctx, k, constAccessor).Rank(pools)
+	c.Assert(candidates, HasLen, 1)
+	c.Check(candidates[0].Eligible, Equals, false)
+}
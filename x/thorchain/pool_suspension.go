@@ -0,0 +1,226 @@
+package thorchain
+
+import (
+	"fmt"
+	"strings"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// mimir keys that govern the suspension of a single pool , the pool asset is appended to the key , e.g. SuspendPool-BNB.BNB
+const (
+	mimirSuspendPool = `SuspendPool-`
+	mimirDelistPool  = `DelistPool-`
+)
+
+// isPoolMimirSet check whether the given per pool mimir key is set to a positive value
+func isPoolMimirSet(ctx cosmos.Context, keeper keeper.Keeper, prefix string, asset common.Asset) bool {
+	value, err := keeper.GetMimir(ctx, prefix+strings.ToUpper(asset.String()))
+	if err != nil {
+		ctx.Logger().Error("fail to get mimir", "key", prefix+asset.String(), "error", err)
+		return false
+	}
+	return value > 0
+}
+
+// isPoolDelisting check whether the delist of the given pool started
+func isPoolDelisting(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset) bool {
+	suspension, err := keeper.GetPoolSuspension(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool suspension", "pool", asset, "error", err)
+		return false
+	}
+	return suspension.IsDelisting()
+}
+
+// processPoolSuspensions move pools in and out of suspension as governed by mimir
+// SuspendPool-{asset} suspend the pool , swaps and stakes are refunded until mimir is cleared , which restores the previous status
+// DelistPool-{asset} suspend the pool and unstake all of its stakers , once started the delist can't be reverted
+func processPoolSuspensions(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, constAccessor constants.ConstantValues) error {
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		return fmt.Errorf("fail to get pools: %w", err)
+	}
+	height := common.BlockHeight(ctx)
+	for _, pool := range pools {
+		suspension, err := keeper.GetPoolSuspension(ctx, pool.Asset)
+		if err != nil {
+			return fmt.Errorf("fail to get pool suspension: %w", err)
+		}
+		suspend := isPoolMimirSet(ctx, keeper, mimirSuspendPool, pool.Asset)
+		delist := isPoolMimirSet(ctx, keeper, mimirDelistPool, pool.Asset)
+
+		switch {
+		case suspension.IsEmpty() && (suspend || delist):
+			suspension = NewPoolSuspension(pool.Asset, pool.Status, height)
+			if err := setPoolStatus(ctx, keeper, mgr, pool, PoolSuspended); err != nil {
+				return err
+			}
+			keeper.SetPoolSuspension(ctx, suspension)
+			ctx.Logger().Info("pool suspended", "pool", pool.Asset)
+		case suspension.IsEmpty():
+			continue
+		case !suspension.IsDelisting() && !suspend && !delist:
+			if err := setPoolStatus(ctx, keeper, mgr, pool, suspension.PreviousStatus); err != nil {
+				return err
+			}
+			keeper.RemovePoolSuspension(ctx, pool.Asset)
+			ctx.Logger().Info("pool resumed", "pool", pool.Asset, "status", suspension.PreviousStatus)
+			continue
+		}
+
+		if !suspension.IsDelisting() && delist {
+			suspension.DelistHeight = height
+			keeper.SetPoolSuspension(ctx, suspension)
+			if err := mgr.EventMgr().EmitEvent(ctx, NewEventPoolDelist(pool.Asset, PoolDelistStarted)); err != nil {
+				return fmt.Errorf("fail to emit pool delist event: %w", err)
+			}
+			ctx.Logger().Info("pool delist started", "pool", pool.Asset)
+		}
+		if suspension.IsDelisting() {
+			if err := delistPool(ctx, keeper, mgr, suspension, constAccessor); err != nil {
+				ctx.Logger().Error("fail to delist pool", "pool", pool.Asset, "error", err)
+			}
+		}
+	}
+	return nil
+}
+
+// setPoolStatus save the pool with the given status and emit a pool event
+func setPoolStatus(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, pool Pool, status PoolStatus) error {
+	pool.Status = status
+	if err := keeper.SetPool(ctx, pool); err != nil {
+		return fmt.Errorf("fail to save pool: %w", err)
+	}
+	if err := mgr.EventMgr().EmitEvent(ctx, NewEventPool(pool.Asset, status)); err != nil {
+		return fmt.Errorf("fail to emit pool event: %w", err)
+	}
+	return nil
+}
+
+// delistPool unstake the stakers of a delisting pool the same way ragnarok does , a batch every block ,
+// stakers still within the stake lock up are picked up once it passes. Once no staker is left the pending RUNE is refunded ,
+// and the stakers are not looked at again. The pool is then kept for up to PoolDelistAssetWaitBlocks while it still hold
+// asset , such as the gas kept for the outbounds of the last unstake , before the remaining RUNE goes to the reserve and
+// the pool is removed
+func delistPool(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, suspension PoolSuspension, constAccessor constants.ConstantValues) error {
+	height := common.BlockHeight(ctx)
+	if suspension.UnstakedHeight == 0 {
+		done, err := unstakeDelistingPool(ctx, keeper, mgr, suspension, constAccessor)
+		if err != nil || !done {
+			return err
+		}
+		suspension.Position = 0
+		suspension.UnstakedHeight = height
+		keeper.SetPoolSuspension(ctx, suspension)
+	}
+
+	pool, err := keeper.GetPool(ctx, suspension.Asset)
+	if err != nil {
+		return fmt.Errorf("fail to get pool: %w", err)
+	}
+	wait := constAccessor.GetInt64Value(constants.PoolDelistAssetWaitBlocks)
+	if !pool.BalanceAsset.IsZero() && height < suspension.UnstakedHeight+wait {
+		return nil
+	}
+	evt := NewEventPoolDelist(suspension.Asset, PoolDelistCompleted)
+	evt.Rune = pool.BalanceRune
+	evt.Asset = pool.BalanceAsset
+	if !pool.BalanceRune.IsZero() {
+		if err := keeper.AddFeeToReserve(ctx, pool.BalanceRune); err != nil {
+			return fmt.Errorf("fail to add remaining RUNE to reserve: %w", err)
+		}
+	}
+	keeper.RemovePool(ctx, suspension.Asset)
+	keeper.RemovePoolSuspension(ctx, suspension.Asset)
+	ctx.Logger().Info("pool delisted", "pool", suspension.Asset, "rune", pool.BalanceRune, "asset", pool.BalanceAsset)
+	if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+		return fmt.Errorf("fail to emit pool delist event: %w", err)
+	}
+	return nil
+}
+
+// unstakeDelistingPool unstake a batch of the stakers of a delisting pool , and refund the pending RUNE once no staker is left
+// it return true when all the stakers are out of the pool
+func unstakeDelistingPool(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, suspension PoolSuspension, constAccessor constants.ConstantValues) (bool, error) {
+	nas, err := keeper.ListActiveNodeAccounts(ctx)
+	if err != nil {
+		return false, fmt.Errorf("fail to get active node accounts: %w", err)
+	}
+	if len(nas) == 0 {
+		return false, fmt.Errorf("can't find any active nodes")
+	}
+	version := keeper.GetLowestActiveVersion(ctx)
+	unstaked, position := ragnarokPool(ctx, keeper, mgr, nas[0], suspension.Asset, suspension.Position, MaxUnstakeBasisPoints, maxUnstakesPerBlock, version, constAccessor)
+	if unstaked >= maxUnstakesPerBlock {
+		suspension.Position = position
+		keeper.SetPoolSuspension(ctx, suspension)
+		return false, nil
+	}
+	// went through all the stakers , start over next block if some of them are still left
+	suspension.Position = 0
+	keeper.SetPoolSuspension(ctx, suspension)
+
+	pool, err := keeper.GetPool(ctx, suspension.Asset)
+	if err != nil {
+		return false, fmt.Errorf("fail to get pool: %w", err)
+	}
+	// unstaking the last of the pool set it to bootstrap
+	if pool.Status != PoolSuspended {
+		if err := setPoolStatus(ctx, keeper, mgr, pool, PoolSuspended); err != nil {
+			return false, err
+		}
+	}
+	stakers, pendingStakers, err := getPoolStakerCount(ctx, keeper, suspension.Asset)
+	if err != nil {
+		return false, err
+	}
+	if stakers > 0 {
+		return false, nil
+	}
+	if pendingStakers == 0 {
+		return true, nil
+	}
+	refunded := true
+	iterator := keeper.GetStakerIterator(ctx, suspension.Asset)
+	var pending []Staker
+	for ; iterator.Valid(); iterator.Next() {
+		var staker Staker
+		keeper.Cdc().MustUnmarshalBinaryBare(iterator.Value(), &staker)
+		if staker.PendingRune != (cosmos.Uint{}) && !staker.PendingRune.IsZero() {
+			pending = append(pending, staker)
+		}
+	}
+	iterator.Close()
+	for _, staker := range pending {
+		if err := refundPendingRune(ctx, keeper, mgr, staker); err != nil {
+			ctx.Logger().Error("fail to refund pending rune", "staker", staker.RuneAddress, "error", err)
+			refunded = false
+		}
+	}
+	// the pool is kept until all the pending RUNE is refunded , the failed refunds are tried again next block
+	return refunded, nil
+}
+
+// getPoolStakerCount return the number of stakers that still have units in the pool , and the number of stakers only have pending RUNE
+func getPoolStakerCount(ctx cosmos.Context, keeper keeper.Keeper, asset common.Asset) (int64, int64, error) {
+	var stakers, pendingStakers int64
+	iterator := keeper.GetStakerIterator(ctx, asset)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var staker Staker
+		if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &staker); err != nil {
+			return 0, 0, fmt.Errorf("fail to unmarshal staker: %w", err)
+		}
+		switch {
+		case staker.Units != (cosmos.Uint{}) && !staker.Units.IsZero():
+			stakers++
+		case staker.PendingRune != (cosmos.Uint{}) && !staker.PendingRune.IsZero():
+			pendingStakers++
+		}
+	}
+	return stakers, pendingStakers, nil
+}
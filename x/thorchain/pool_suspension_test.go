@@ -0,0 +1,230 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type PoolSuspensionSuite struct{}
+
+var _ = Suite(&PoolSuspensionSuite{})
+
+func (s *PoolSuspensionSuite) TestSuspendAndResumePool(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+
+	// nothing to do
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err := k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.Status, Equals, PoolEnabled)
+
+	k.SetMimir(ctx, "SuspendPool-BNB.BNB", 1)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.Status, Equals, PoolSuspended)
+	suspension, err := k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.PreviousStatus, Equals, PoolEnabled)
+	c.Check(suspension.SuspendedHeight, Equals, common.BlockHeight(ctx))
+	c.Check(suspension.IsDelisting(), Equals, false)
+
+	// swaps and stakes are rejected while the pool is suspended
+	c.Check(pool.EnsureValidPoolStatus(MsgSwap{}), NotNil)
+	c.Check(pool.EnsureValidPoolStatus(MsgStake{}), NotNil)
+
+	// still suspended
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.Status, Equals, PoolSuspended)
+
+	// clearing mimir restores the previous status
+	k.SetMimir(ctx, "SuspendPool-BNB.BNB", 0)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.Status, Equals, PoolEnabled)
+	suspension, err = k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.IsEmpty(), Equals, true)
+}
+
+func (s *PoolSuspensionSuite) TestDelistPool(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	stakeHandler := NewStakeHandler(k, mgr)
+	var runeAddrs []common.Address
+	for i := 0; i < 3; i++ {
+		runeAddr := GetRandomRUNEAddress()
+		runeAddrs = append(runeAddrs, runeAddr)
+		c.Assert(stakeHandler.stake(ctx,
+			common.BNBAsset,
+			cosmos.NewUint(common.One*100),
+			cosmos.NewUint(common.One*100),
+			runeAddr,
+			GetRandomBNBAddress(),
+			GetRandomTxHash(),
+			constAccessor), IsNil)
+	}
+	// a staker which only has pending RUNE
+	pendingStaker := Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		LastStakeHeight: common.BlockHeight(ctx),
+		Units:           cosmos.ZeroUint(),
+		PendingRune:     cosmos.NewUint(common.One),
+		PendingTxID:     GetRandomTxHash(),
+		RuneDeposit:     cosmos.ZeroUint(),
+		AssetDeposit:    cosmos.ZeroUint(),
+	}
+	k.SetStaker(ctx, pendingStaker)
+
+	stakers, pendingStakers, err := getPoolStakerCount(ctx, k, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(stakers, Equals, int64(3))
+	c.Check(pendingStakers, Equals, int64(1))
+
+	// all the stakers fit in one batch , the pool is kept while it hold the gas of the outbounds
+	k.SetMimir(ctx, "DelistPool-BNB.BNB", 1)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.IsEmpty(), Equals, false)
+	c.Check(pool.Status, Equals, PoolSuspended)
+	c.Check(pool.BalanceRune.IsZero(), Equals, true)
+	c.Check(pool.BalanceAsset.IsZero(), Equals, false)
+	suspension, err := k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.IsDelisting(), Equals, true)
+	c.Check(suspension.UnstakedHeight, Equals, common.BlockHeight(ctx))
+
+	// the gas is paid , the pool is removed
+	pool.BalanceAsset = cosmos.ZeroUint()
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.IsEmpty(), Equals, true)
+	suspension, err = k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.IsEmpty(), Equals, true)
+	stakers, pendingStakers, err = getPoolStakerCount(ctx, k, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(stakers, Equals, int64(0))
+	c.Check(pendingStakers, Equals, int64(0))
+	for _, runeAddr := range runeAddrs {
+		staker, err := k.GetStaker(ctx, common.BNBAsset, runeAddr)
+		c.Assert(err, IsNil)
+		c.Check(staker.Units.IsZero(), Equals, true)
+	}
+	items, err := mgr.TxOutStore().GetOutboundItems(ctx)
+	c.Assert(err, IsNil)
+	// two outbounds for every staker , and the pending RUNE refund
+	c.Check(items, HasLen, 7)
+}
+
+func (s *PoolSuspensionSuite) TestDelistPoolRemainder(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	ver := constants.SWVersion
+	constAccessor := constants.GetConstantValues(ver)
+	mgr := NewManagers(k)
+	c.Assert(mgr.BeginBlock(ctx), IsNil)
+	mgr.txOutStore = NewTxStoreDummy()
+	c.Assert(k.SetNodeAccount(ctx, GetRandomNodeAccount(NodeActive)), IsNil)
+
+	// no staker left , but the pool still hold some RUNE and asset
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(common.One)
+	pool.BalanceAsset = cosmos.NewUint(2 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(k.SetPool(ctx, pool), IsNil)
+	// a staker with pending RUNE that can't be refunded yet
+	pendingStaker := Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		LastStakeHeight: common.BlockHeight(ctx),
+		Units:           cosmos.ZeroUint(),
+		PendingRune:     cosmos.NewUint(common.One),
+		PendingTxID:     GetRandomTxHash(),
+		RuneDeposit:     cosmos.ZeroUint(),
+		AssetDeposit:    cosmos.ZeroUint(),
+	}
+	k.SetStaker(ctx, pendingStaker)
+	reserve, err := k.GetVaultData(ctx)
+	c.Assert(err, IsNil)
+	totalReserve := reserve.TotalReserve
+
+	k.SetMimir(ctx, "DelistPool-BNB.BNB", 1)
+	mgr.txOutStore = NewTxOutStoreFailDummy(nil)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(common.One)), Equals, true)
+	_, pendingStakers, err := getPoolStakerCount(ctx, k, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pendingStakers, Equals, int64(1))
+
+	// the pending RUNE is refunded , the pool is kept while it still hold asset
+	mgr.txOutStore = NewTxStoreDummy()
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.IsEmpty(), Equals, false)
+	c.Check(pool.Status, Equals, PoolSuspended)
+	c.Check(pool.BalanceRune.Equal(cosmos.NewUint(common.One)), Equals, true)
+	c.Check(pool.BalanceAsset.Equal(cosmos.NewUint(2*common.One)), Equals, true)
+	suspension, err := k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.UnstakedHeight, Equals, common.BlockHeight(ctx))
+	_, pendingStakers, err = getPoolStakerCount(ctx, k, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pendingStakers, Equals, int64(0))
+
+	// the stakers are not looked at again , a refund failing now doesn't hold the delist
+	mgr.txOutStore = NewTxOutStoreFailDummy(nil)
+	wait := constAccessor.GetInt64Value(constants.PoolDelistAssetWaitBlocks)
+	ctx = ctx.WithBlockHeight(suspension.UnstakedHeight + wait - 1)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.IsEmpty(), Equals, false)
+
+	// the asset is not spent in time , the pool is removed with it
+	ctx = ctx.WithBlockHeight(suspension.UnstakedHeight + wait)
+	c.Assert(processPoolSuspensions(ctx, k, mgr, constAccessor), IsNil)
+	pool, err = k.GetPool(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.IsEmpty(), Equals, true)
+	suspension, err = k.GetPoolSuspension(ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(suspension.IsEmpty(), Equals, true)
+	reserve, err = k.GetVaultData(ctx)
+	c.Assert(err, IsNil)
+	c.Check(reserve.TotalReserve.Equal(totalReserve.Add(cosmos.NewUint(common.One))), Equals, true)
+}
@@ -2,11 +2,14 @@ package thorchain
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -22,10 +25,24 @@ func NewQuerier(keeper keeper.Keeper, kbs KeybaseStore) cosmos.Querier {
 		switch path[0] {
 		case q.QueryPool.Key:
 			return queryPool(ctx, path[1:], req, keeper)
+		case q.QueryPoolHistory.Key:
+			return queryPoolHistory(ctx, path[1:], req, keeper)
+		case q.QueryPoolTWAP.Key:
+			return queryPoolTWAP(ctx, path[1:], req, keeper)
+		case q.QueryPoolSuspension.Key:
+			return queryPoolSuspension(ctx, path[1:], req, keeper)
 		case q.QueryPools.Key:
 			return queryPools(ctx, req, keeper)
+		case q.QueryBootstrapPools.Key:
+			return queryBootstrapPools(ctx, req, keeper)
 		case q.QueryStakers.Key:
 			return queryStakers(ctx, path[1:], req, keeper)
+		case q.QueryStaker.Key:
+			return queryStaker(ctx, path[1:], req, keeper)
+		case q.QueryStakerPositions.Key:
+			return queryStakerPositions(ctx, path[1:], req, keeper)
+		case q.QueryPendingStakes.Key:
+			return queryPendingStakes(ctx, req, keeper)
 		case q.QueryTxInVoter.Key:
 			return queryTxInVoter(ctx, path[1:], req, keeper)
 		case q.QueryTxIn.Key:
@@ -38,6 +55,10 @@ func NewQuerier(keeper keeper.Keeper, kbs KeybaseStore) cosmos.Querier {
 			return queryKeygen(ctx, kbs, path[1:], req, keeper)
 		case q.QueryQueue.Key:
 			return queryQueue(ctx, path[1:], req, keeper)
+		case q.QueryQueueSwap.Key:
+			return querySwapQueue(ctx, keeper)
+		case q.QueryQueueScheduled.Key:
+			return queryScheduledQueue(ctx, keeper)
 		case q.QueryHeights.Key:
 			return queryHeights(ctx, path[1:], req, keeper)
 		case q.QueryChainHeights.Key:
@@ -50,6 +71,10 @@ func NewQuerier(keeper keeper.Keeper, kbs KeybaseStore) cosmos.Querier {
 			return queryNodeAccount(ctx, path[1:], req, keeper)
 		case q.QueryNodeAccountCheck.Key:
 			return queryNodeAccountCheck(ctx, path[1:], req, keeper)
+		case q.QueryNodeAccountSlashes.Key:
+			return queryNodeAccountSlashes(ctx, path[1:], req, keeper)
+		case q.QueryNodeObservations.Key:
+			return queryNodeObservations(ctx, path[1:], req, keeper)
 		case q.QueryNodeAccounts.Key:
 			return queryNodeAccounts(ctx, path[1:], req, keeper)
 		case q.QueryPoolAddresses.Key:
@@ -66,6 +91,8 @@ func NewQuerier(keeper keeper.Keeper, kbs KeybaseStore) cosmos.Querier {
 			return queryVault(ctx, path[1:], keeper)
 		case q.QueryVaultPubkeys.Key:
 			return queryVaultsPubkeys(ctx, keeper)
+		case q.QueryVaultsSolvency.Key:
+			return queryVaultsSolvency(ctx, keeper)
 		case q.QueryTSSSigners.Key:
 			return queryTSSSigners(ctx, path[1:], req, keeper)
 		case q.QueryConstantValues.Key:
@@ -78,6 +105,22 @@ func NewQuerier(keeper keeper.Keeper, kbs KeybaseStore) cosmos.Querier {
 			return queryBan(ctx, path[1:], req, keeper)
 		case q.QueryRagnarok.Key:
 			return queryRagnarok(ctx, keeper)
+		case q.QueryChainHalts.Key:
+			return queryChainHalts(ctx, keeper)
+		case q.QueryChurnPreview.Key:
+			return queryChurnPreview(ctx, keeper)
+		case q.QueryNodesMaintenance.Key:
+			return queryNodesMaintenance(ctx, keeper)
+		case q.QuerySwapQuote.Key:
+			return querySwapQuote(ctx, path[1:], req, keeper)
+		case q.QueryStreamingSwaps.Key:
+			return queryStreamingSwaps(ctx, keeper)
+		case q.QueryStreamingSwap.Key:
+			return queryStreamingSwap(ctx, path[1:], req, keeper)
+		case q.QueryLimitOrders.Key:
+			return queryLimitOrders(ctx, keeper)
+		case q.QueryLimitOrder.Key:
+			return queryLimitOrder(ctx, path[1:], req, keeper)
 		default:
 			return nil, cosmos.ErrUnknownRequest(
 				fmt.Sprintf("unknown thorchain query endpoint: %s", path[0]),
@@ -86,6 +129,45 @@ func NewQuerier(keeper keeper.Keeper, kbs KeybaseStore) cosmos.Querier {
 	}
 }
 
+// queryChurnPreview report what the next churn would look like , who would be churned out and why , and who would be churned in
+func queryChurnPreview(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	version := keeper.GetLowestActiveVersion(ctx)
+	constAccessor := constants.GetConstantValues(version)
+	if constAccessor == nil {
+		return nil, fmt.Errorf("constants for version(%s) is not available", version)
+	}
+	mgr := NewManagers(keeper)
+	if err := mgr.BeginBlock(ctx); err != nil {
+		return nil, fmt.Errorf("fail to build manager: %w", err)
+	}
+	preview, err := mgr.ValidatorMgr().ChurnPreview(ctx, constAccessor)
+	if err != nil {
+		ctx.Logger().Error("fail to preview churn", "error", err)
+		return nil, fmt.Errorf("fail to preview churn: %w", err)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), preview)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal churn preview to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal churn preview to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryNodesMaintenance list the node accounts that are in maintenance at the current block height
+func queryNodesMaintenance(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	nodes, err := getNodesInMaintenance(ctx, keeper)
+	if err != nil {
+		ctx.Logger().Error("fail to get node accounts in maintenance", "error", err)
+		return nil, fmt.Errorf("fail to get node accounts in maintenance: %w", err)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), nodes)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal node maintenance to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal node maintenance to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryRagnarok(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
 	ragnarokInProgress := keeper.RagnarokInProgress(ctx)
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), ragnarokInProgress)
@@ -95,6 +177,54 @@ func queryRagnarok(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
 	return res, nil
 }
 
+// queryChainHalts report whether trading and signing on each chain is halted , either by mimir or automatically
+func queryChainHalts(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	vaults, err := getChainHaltVaults(ctx, keeper)
+	if err != nil {
+		ctx.Logger().Error("fail to get asgard vaults", "error", err)
+		return nil, fmt.Errorf("fail to get asgard vaults: %w", err)
+	}
+	chains := getVaultChains(vaults)
+	iter := keeper.GetChainHaltIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var halt ChainHalt
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &halt); err != nil {
+			ctx.Logger().Error("fail to unmarshal chain halt", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal chain halt: %w", err)
+		}
+		if !chains.Has(halt.Chain) {
+			chains = append(chains, halt.Chain)
+		}
+	}
+	result := make([]QueryChainHalt, 0)
+	for _, chain := range chains {
+		if chain.Equals(common.THORChain) {
+			continue
+		}
+		halt, err := keeper.GetChainHalt(ctx, chain)
+		if err != nil {
+			ctx.Logger().Error("fail to get chain halt", "error", err)
+			return nil, fmt.Errorf("fail to get chain halt: %w", err)
+		}
+		result = append(result, QueryChainHalt{
+			Chain:             chain,
+			TradingHalted:     isTradingHalted(ctx, keeper, chain),
+			ChainHalted:       isOutboundHalted(ctx, keeper, chain),
+			HaltTradingHeight: getMimirHaltHeight(ctx, keeper, mimirHaltTrading, chain),
+			HaltChainHeight:   getMimirHaltHeight(ctx, keeper, mimirHaltChain, chain),
+			AutoHaltHeight:    halt.Height,
+			AutoHaltReason:    halt.Reason,
+		})
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal chain halts to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal chain halts to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryBalanceModule(ctx cosmos.Context, path []string, keeper keeper.Keeper) ([]byte, error) {
 	supplier := keeper.Supply()
 	mod := supplier.GetModuleAccount(ctx, AsgardName)
@@ -264,6 +394,58 @@ func queryVaultsPubkeys(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error
 	return res, nil
 }
 
+// queryVaultsSolvency compare the latest balance of every vault that reached consensus with what THORChain expect the vault to hold
+func queryVaultsSolvency(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	threshold := getSolvencyThreshold(ctx, keeper, constAccessor)
+	result := make([]QueryVaultSolvency, 0)
+	iter := keeper.GetVaultSolvencyIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var solvency VaultSolvency
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &solvency); err != nil {
+			ctx.Logger().Error("fail to unmarshal vault solvency", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal vault solvency: %w", err)
+		}
+		// the vault might be gone since
+		if !keeper.VaultExists(ctx, solvency.PubKey) {
+			continue
+		}
+		vault, err := keeper.GetVault(ctx, solvency.PubKey)
+		if err != nil {
+			ctx.Logger().Error("fail to get vault", "error", err)
+			return nil, fmt.Errorf("fail to get vault: %w", err)
+		}
+		item := QueryVaultSolvency{
+			PubKey:      solvency.PubKey,
+			Chain:       solvency.Chain,
+			Type:        vault.Type,
+			Status:      vault.Status,
+			Height:      solvency.Height,
+			BlockHeight: solvency.BlockHeight,
+			Solvent:     true,
+			Coins:       make([]QuerySolvencyCoin, 0),
+		}
+		for _, check := range getSolvencyChecks(vault, solvency) {
+			solvent := !check.isInsolvent(threshold)
+			item.Coins = append(item.Coins, QuerySolvencyCoin{
+				Asset:    check.Asset,
+				Expected: check.Expected,
+				Observed: check.Observed,
+				Solvent:  solvent,
+			})
+			item.Solvent = item.Solvent && solvent
+		}
+		result = append(result, item)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal vaults solvency to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal vaults solvency to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryVaultData(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
 	data, err := keeper.GetVaultData(ctx)
 	if err != nil {
@@ -382,6 +564,9 @@ func queryNodeAccount(ctx cosmos.Context, path []string, req abci.RequestQuery,
 	result.SlashPoints = slashPts
 	result.Jail = jail
 	result.CurrentAward = vaultData.CalcNodeRewards(earnedBlocks)
+	if err := setQueryBondProviders(ctx, keeper, nodeAcc, &result); err != nil {
+		return nil, err
+	}
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
 	if err != nil {
 		return nil, fmt.Errorf("fail to marshal node account to json: %w", err)
@@ -390,6 +575,23 @@ func queryNodeAccount(ctx cosmos.Context, path []string, req abci.RequestQuery,
 	return res, nil
 }
 
+// setQueryBondProviders fill in the bond providers of the given node account , and their share of the bond
+func setQueryBondProviders(ctx cosmos.Context, keeper keeper.Keeper, na NodeAccount, result *QueryNodeAccount) error {
+	bp, err := getBondProviders(ctx, keeper, na)
+	if err != nil {
+		return fmt.Errorf("fail to get bond providers: %w", err)
+	}
+	result.NodeOperatorFee = bp.NodeOperatorFee
+	result.BondProviders = make([]QueryBondProvider, 0, len(bp.Providers))
+	for _, p := range bp.Providers {
+		result.BondProviders = append(result.BondProviders, QueryBondProvider{
+			BondAddress: p.BondAddress,
+			Bond:        bp.GetBond(p.BondAddress, na.Bond),
+		})
+	}
+	return nil
+}
+
 func queryNodeAccountCheck(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	if len(path) == 0 {
 		return nil, errors.New("node address not provided")
@@ -438,6 +640,80 @@ func queryNodeAccountCheck(ctx cosmos.Context, path []string, req abci.RequestQu
 	return res, nil
 }
 
+// queryNodeAccountSlashes return the slash records of the given node account within the retention window
+func queryNodeAccountSlashes(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("node address not provided")
+	}
+	addr, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
+	}
+	slashPoints, err := keeper.GetNodeAccountSlashPoints(ctx, addr)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get node slash points: %w", err)
+	}
+
+	version := keeper.GetLowestActiveVersion(ctx)
+	constAccessor := constants.GetConstantValues(version)
+	if constAccessor == nil {
+		return nil, fmt.Errorf("constants for version(%s) is not available", version)
+	}
+	from := common.BlockHeight(ctx) - getSlashRecordRetention(ctx, keeper, constAccessor) + 1
+	records, err := keeper.GetSlashRecords(ctx, addr, from)
+	if err != nil {
+		ctx.Logger().Error("fail to get slash records", "error", err)
+		return nil, fmt.Errorf("fail to get slash records: %w", err)
+	}
+
+	result := QueryNodeSlashes{
+		NodeAddress: addr,
+		SlashPoints: slashPoints,
+		Records:     records,
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal slash records to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal slash records to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryNodeObservations return how many times the given node account missed observing each chain , and the miss rate
+func queryNodeObservations(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("node address not provided")
+	}
+	addr, err := cosmos.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, cosmos.ErrUnknownRequest("invalid account address")
+	}
+	observations, err := keeper.GetChainObservations(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get chain observations", "error", err)
+		return nil, fmt.Errorf("fail to get chain observations: %w", err)
+	}
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	window := getChainObservationWindow(ctx, keeper, constAccessor)
+	result := make([]QueryNodeObservation, 0, len(observations))
+	for _, observation := range observations {
+		// the counters are only rolled when the chain reach consensus , roll them here so stale counters are not reported
+		observation.Roll(common.BlockHeight(ctx), window)
+		result = append(result, QueryNodeObservation{
+			Chain:    observation.Chain,
+			Total:    observation.GetTotal(),
+			Missed:   observation.GetMissed(),
+			MissRate: observation.MissRate(),
+		})
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal chain observations to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal chain observations to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryNodeAccounts(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	nodeAccounts, err := keeper.ListNodeAccountsWithBond(ctx)
 	if err != nil {
@@ -467,6 +743,9 @@ func queryNodeAccounts(ctx cosmos.Context, path []string, req abci.RequestQuery,
 			return nil, fmt.Errorf("fail to get node jail: %w", err)
 		}
 		result[i].Jail = jail
+		if err := setQueryBondProviders(ctx, keeper, na, &result[i]); err != nil {
+			return nil, err
+		}
 	}
 
 	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
@@ -549,6 +828,127 @@ func queryStakers(ctx cosmos.Context, path []string, req abci.RequestQuery, keep
 	return res, nil
 }
 
+// queryStaker return the staker records of all the pools the given rune address or asset address staked in
+func queryStaker(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	addr, err := common.NewAddress(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse address", "error", err)
+		return nil, fmt.Errorf("fail to parse address: %w", err)
+	}
+	stakers, err := keeper.GetStakersByAddress(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get stakers", "error", err)
+		return nil, fmt.Errorf("fail to get stakers: %w", err)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), stakers)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal stakers to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal stakers to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryStakerPositions return the position of the given rune address or asset address in every pool it staked in
+func queryStakerPositions(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("address not provided")
+	}
+	addr, err := common.NewAddress(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse address", "error", err)
+		return nil, fmt.Errorf("fail to parse address: %w", err)
+	}
+	stakers, err := keeper.GetStakersByAddress(ctx, addr)
+	if err != nil {
+		ctx.Logger().Error("fail to get stakers", "error", err)
+		return nil, fmt.Errorf("fail to get stakers: %w", err)
+	}
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	lockUpBlocks := constAccessor.GetInt64Value(constants.StakeLockUpBlocks)
+	positions := make([]QueryStakerPosition, 0)
+	for _, staker := range stakers {
+		if staker.Units.IsZero() && staker.PendingRune.IsZero() {
+			continue
+		}
+		pool, err := keeper.GetPool(ctx, staker.Asset)
+		if err != nil {
+			ctx.Logger().Error("fail to get pool", "error", err)
+			return nil, fmt.Errorf("fail to get pool: %w", err)
+		}
+		positions = append(positions, getStakerPosition(pool, staker, lockUpBlocks, common.BlockHeight(ctx)))
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), positions)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal staker positions to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal staker positions to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryPendingStakes return all the stakers that have RUNE waiting for the asset side , with how long they have been waiting
+func queryPendingStakes(ctx cosmos.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	stakers, err := keeper.GetStakersWithPendingRune(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get stakers with pending rune", "error", err)
+		return nil, fmt.Errorf("fail to get stakers with pending rune: %w", err)
+	}
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	expiry := getPendingRuneExpiryBlocks(ctx, keeper, constAccessor)
+	height := common.BlockHeight(ctx)
+	pending := make([]QueryPendingStake, 0, len(stakers))
+	for _, staker := range stakers {
+		item := QueryPendingStake{
+			Staker: staker,
+			Age:    height - staker.LastStakeHeight,
+		}
+		if expiry > 0 {
+			item.ExpiryHeight = staker.LastStakeHeight + expiry
+		}
+		pending = append(pending, item)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), pending)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pending stakes to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal pending stakes to json: %w", err)
+	}
+	return res, nil
+}
+
+// getStakerPosition value the staker's units with the current pool depths , and compare it with holding the deposits
+func getStakerPosition(pool Pool, staker Staker, lockUpBlocks, height int64) QueryStakerPosition {
+	position := QueryStakerPosition{
+		Asset:           pool.Asset,
+		Units:           staker.Units,
+		PendingRune:     staker.PendingRune,
+		RuneDeposit:     staker.RuneDeposit,
+		AssetDeposit:    staker.AssetDeposit,
+		RuneRedeemable:  staker.PendingRune,
+		AssetRedeemable: cosmos.ZeroUint(),
+		LastStakeHeight: staker.LastStakeHeight,
+		UnlockHeight:    staker.LastStakeHeight + lockUpBlocks,
+	}
+	position.Locked = height < position.UnlockHeight
+	if !staker.Units.IsZero() {
+		runeAmt, assetAmt, _, err := calculateUnstake(pool.PoolUnits, pool.BalanceRune, pool.BalanceAsset, staker.Units, cosmos.NewUint(MaxUnstakeBasisPoints))
+		if err == nil {
+			position.RuneRedeemable = position.RuneRedeemable.Add(runeAmt)
+			position.AssetRedeemable = assetAmt
+		}
+	}
+	// pending rune is not part of the deposits until the asset side arrives
+	position.HoldValue = staker.PendingRune.Add(staker.RuneDeposit).Add(pool.AssetValueInRune(staker.AssetDeposit))
+	position.RedeemableValue = position.RuneRedeemable.Add(pool.AssetValueInRune(position.AssetRedeemable))
+	if !position.HoldValue.IsZero() {
+		holdValue := cosmos.NewDecFromBigInt(position.HoldValue.BigInt())
+		redeemableValue := cosmos.NewDecFromBigInt(position.RedeemableValue.BigInt())
+		position.Gain = redeemableValue.Sub(holdValue).MulInt64(10000).Quo(holdValue).RoundInt64()
+	}
+	return position
+}
+
 // nolint: unparam
 func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	if len(path) == 0 {
@@ -576,6 +976,208 @@ func queryPool(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper
 	return res, nil
 }
 
+// queryPoolHistory return the snapshots of the given pool between the from and to height ,
+// when interval is provided the snapshots are down sampled to at most one every interval blocks
+func queryPoolHistory(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+	params, err := getQueryParams(req)
+	if err != nil {
+		return nil, err
+	}
+	heights := map[string]int64{
+		"from":     0,
+		"to":       common.BlockHeight(ctx),
+		"interval": 0,
+	}
+	for key := range heights {
+		if len(params.Get(key)) == 0 {
+			continue
+		}
+		heights[key], err = strconv.ParseInt(params.Get(key), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse %s: %w", key, err)
+		}
+		if heights[key] < 0 {
+			return nil, fmt.Errorf("%s cannot be negative", key)
+		}
+	}
+	if heights["from"] > heights["to"] {
+		return nil, errors.New("from cannot be greater than to")
+	}
+
+	snapshots, err := keeper.GetPoolSnapshots(ctx, asset, heights["from"], heights["to"])
+	if err != nil {
+		ctx.Logger().Error("fail to get pool snapshots", "error", err)
+		return nil, fmt.Errorf("fail to get pool snapshots: %w", err)
+	}
+	history := make([]PoolSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if len(history) > 0 && snapshot.Height < history[len(history)-1].Height+heights["interval"] {
+			continue
+		}
+		history = append(history, snapshot)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), history)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pool history to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal pool history to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryPoolTWAP return the time weighted average price of the given pool , over the window given in the url or TWAPWindowBlocks
+func queryPoolTWAP(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+	params, err := getQueryParams(req)
+	if err != nil {
+		return nil, err
+	}
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	window := constAccessor.GetInt64Value(constants.TWAPWindowBlocks)
+	if len(params.Get("window")) > 0 {
+		window, err = strconv.ParseInt(params.Get("window"), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("fail to parse window: %w", err)
+		}
+		if window <= 0 {
+			return nil, errors.New("window must be greater than zero")
+		}
+	}
+
+	pool, err := keeper.GetPool(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "error", err)
+		return nil, fmt.Errorf("could not get pool: %w", err)
+	}
+	if pool.IsEmpty() {
+		return nil, fmt.Errorf("pool: %s doesn't exist", path[0])
+	}
+	twap, err := keeper.GetPoolTWAP(ctx, asset, window)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool twap", "error", err)
+		return nil, fmt.Errorf("fail to get pool twap: %w", err)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), QueryPoolTWAP{
+		Asset:     asset,
+		Height:    common.BlockHeight(ctx),
+		Window:    window,
+		SpotPrice: pool.AssetValueInRune(cosmos.NewUint(common.One)),
+		TWAP:      twap,
+	})
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pool twap to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal pool twap to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryPoolSuspension return the suspension of the given pool , and the progress of its delist
+func queryPoolSuspension(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("asset not provided")
+	}
+	asset, err := common.NewAsset(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse asset", "error", err)
+		return nil, fmt.Errorf("could not parse asset: %w", err)
+	}
+	suspension, err := keeper.GetPoolSuspension(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool suspension", "error", err)
+		return nil, fmt.Errorf("fail to get pool suspension: %w", err)
+	}
+	if suspension.IsEmpty() {
+		return nil, fmt.Errorf("pool: %s is not suspended", path[0])
+	}
+	pool, err := keeper.GetPool(ctx, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to get pool", "error", err)
+		return nil, fmt.Errorf("could not get pool: %w", err)
+	}
+	stakers, pendingStakers, err := getPoolStakerCount(ctx, keeper, asset)
+	if err != nil {
+		ctx.Logger().Error("fail to count stakers", "error", err)
+		return nil, fmt.Errorf("fail to count stakers: %w", err)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), QueryPoolSuspension{
+		Asset:           asset,
+		Status:          pool.Status,
+		PreviousStatus:  suspension.PreviousStatus,
+		SuspendedHeight: suspension.SuspendedHeight,
+		Delisting:       suspension.IsDelisting(),
+		DelistHeight:    suspension.DelistHeight,
+		UnstakedHeight:  suspension.UnstakedHeight,
+		Stakers:         stakers,
+		PendingStakers:  pendingStakers,
+		BalanceRune:     pool.BalanceRune,
+		BalanceAsset:    pool.BalanceAsset,
+		PoolUnits:       pool.PoolUnits,
+	})
+	if err != nil {
+		ctx.Logger().Error("fail to marshal pool suspension to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal pool suspension to json: %w", err)
+	}
+	return res, nil
+}
+
+// queryBootstrapPools rank the bootstrap pools the same way they are enabled , and report when the next pool cycle is
+func queryBootstrapPools(ctx cosmos.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	newPoolCycle, err := keeper.GetMimir(ctx, constants.NewPoolCycle.String())
+	if newPoolCycle < 0 || err != nil {
+		newPoolCycle = constAccessor.GetInt64Value(constants.NewPoolCycle)
+	}
+	pools, err := keeper.GetPools(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to get pools", "error", err)
+		return nil, fmt.Errorf("fail to get pools: %w", err)
+	}
+	height := common.BlockHeight(ctx)
+	result := QueryBootstrapPools{
+		NewPoolCycle: newPoolCycle,
+		Pools:        make([]QueryBootstrapPool, 0),
+	}
+	if newPoolCycle > 0 {
+		result.NextCycleHeight = height - height%newPoolCycle + newPoolCycle
+	}
+	next := false
+	for i, candidate := range getPoolEnablePolicy(ctx, keeper, constAccessor).Rank(pools) {
+		item := QueryBootstrapPool{
+			Rank:         i + 1,
+			Asset:        candidate.Pool.Asset,
+			BalanceRune:  candidate.Pool.BalanceRune,
+			BalanceAsset: candidate.Pool.BalanceAsset,
+			Eligible:     candidate.Eligible,
+			Reason:       candidate.Reason,
+		}
+		if candidate.Eligible && !next {
+			item.Next = true
+			next = true
+		}
+		result.Pools = append(result.Pools, item)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal bootstrap pools to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal bootstrap pools to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryPools(ctx cosmos.Context, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	pools := Pools{}
 	iterator := keeper.GetPoolIterator(ctx)
@@ -818,6 +1420,93 @@ func queryQueue(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper
 	return res, nil
 }
 
+// queryScheduledQueue list the outbounds held by the outbound delay , grouped by the height they will be released at
+func queryScheduledQueue(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	result := make([]TxOut, 0)
+	iter := keeper.GetScheduledOutboundIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var txOut TxOut
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &txOut); err != nil {
+			ctx.Logger().Error("fail to unmarshal scheduled outbound", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal scheduled outbound: %w", err)
+		}
+		result = append(result, txOut)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal scheduled outbound to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal scheduled outbound to json: %w", err)
+	}
+	return res, nil
+}
+
+// querySwapQueue list the swaps in the swap queue in the order they will be processed , assuming no new swap arrive
+func querySwapQueue(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	constAccessor := constants.GetConstantValues(keeper.GetLowestActiveVersion(ctx))
+	queue := NewSwapQv1(keeper)
+	msgs, err := queue.FetchQueue(ctx)
+	if err != nil {
+		ctx.Logger().Error("fail to fetch swap queue", "error", err)
+		return nil, fmt.Errorf("fail to fetch swap queue: %w", err)
+	}
+	ready, waiting := queue.splitReady(ctx, msgs)
+	swaps, err := queue.scoreMsgs(ctx, ready)
+	if err != nil {
+		ctx.Logger().Error("fail to score swap queue", "error", err)
+		return nil, fmt.Errorf("fail to score swap queue: %w", err)
+	}
+	swaps = swaps.Sort()
+
+	result := make([]QuerySwapQueueItem, 0, len(msgs))
+	// the next EndBlock is the one of the next block
+	height := common.BlockHeight(ctx) + 1
+	remaining := len(swaps)
+	todo := queue.getTodoNum(ctx, remaining, constAccessor)
+	for i, item := range swaps {
+		// an EndBlock handle todo swaps , then the rest wait for the next block
+		if todo == 0 {
+			height++
+			todo = queue.getTodoNum(ctx, remaining, constAccessor)
+		}
+		estimatedBlock := height
+		if todo <= 0 {
+			// swap queue is halted
+			estimatedBlock = 0
+		} else {
+			todo--
+			remaining--
+		}
+		result = append(result, QuerySwapQueueItem{
+			Msg:            item.msg,
+			LiquidityFee:   item.fee,
+			TradeSlip:      item.slip,
+			Position:       int64(i + 1),
+			EstimatedBlock: estimatedBlock,
+		})
+	}
+	for _, msg := range waiting {
+		stream, err := keeper.GetStreamingSwap(ctx, msg.Tx.ID)
+		if err != nil {
+			ctx.Logger().Error("fail to get streaming swap", "error", err)
+			return nil, fmt.Errorf("fail to get streaming swap: %w", err)
+		}
+		result = append(result, QuerySwapQueueItem{
+			Msg:            msg,
+			LiquidityFee:   cosmos.ZeroUint(),
+			TradeSlip:      cosmos.ZeroUint(),
+			EstimatedBlock: stream.LastHeight + stream.Interval,
+		})
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal swap queue to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal swap queue to json: %w", err)
+	}
+	return res, nil
+}
+
 func queryHeights(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	chain := common.BNBChain
 	if len(path) > 0 && len(path[0]) > 0 {
@@ -883,7 +1572,25 @@ func queryTSSSigners(ctx cosmos.Context, path []string, req abci.RequestQuery, k
 func queryConstantValues(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	ver := keeper.GetLowestActiveVersion(ctx)
 	constAccessor := constants.GetConstantValues(ver)
-	res, err := codec.MarshalJSONIndent(keeper.Cdc(), constAccessor)
+	buf, err := json.Marshal(constAccessor)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal constant values to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal constant values to json: %w", err)
+	}
+	var values struct {
+		Int64Values  map[string]int64  `json:"int_64_values"`
+		BoolValues   map[string]bool   `json:"bool_values"`
+		StringValues map[string]string `json:"string_values"`
+	}
+	if err := json.Unmarshal(buf, &values); err != nil {
+		return nil, fmt.Errorf("fail to unmarshal constant values: %w", err)
+	}
+	// swap queue throughput can be tuned by mimir , report the effective values
+	minSwaps, maxSwaps, divisor := NewSwapQv1(keeper).getSwapQueueLimits(ctx, constAccessor)
+	values.Int64Values[constants.MinSwapsPerBlock.String()] = minSwaps
+	values.Int64Values[constants.MaxSwapsPerBlock.String()] = maxSwaps
+	values.Int64Values[constants.SwapQueueDivisor.String()] = divisor
+	res, err := json.MarshalIndent(values, "", "	")
 	if err != nil {
 		ctx.Logger().Error("fail to marshal constant values to json", "error", err)
 		return nil, fmt.Errorf("fail to marshal constant values to json: %w", err)
@@ -947,3 +1654,216 @@ func queryBan(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper k
 	}
 	return res, nil
 }
+
+// getQueryParams parse the url query parameters the REST handler forward as request data
+func getQueryParams(req abci.RequestQuery) (url.Values, error) {
+	if len(req.Data) == 0 {
+		return url.Values{}, nil
+	}
+	u, err := url.Parse(string(req.Data))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse request url: %w", err)
+	}
+	return u.Query(), nil
+}
+
+// querySwapQuote run the swap logic against a cached context , and report the expected result without committing anything
+func querySwapQuote(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	params, err := getQueryParams(req)
+	if err != nil {
+		return nil, err
+	}
+	fromAsset, err := common.NewAsset(params.Get("from_asset"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse from_asset: %w", err)
+	}
+	toAsset, err := common.NewAsset(params.Get("to_asset"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse to_asset: %w", err)
+	}
+	amount, err := cosmos.ParseUint(params.Get("amount"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse amount: %w", err)
+	}
+	destination, err := common.NewAddress(params.Get("destination"))
+	if err != nil {
+		return nil, fmt.Errorf("fail to parse destination: %w", err)
+	}
+	if destination.IsEmpty() {
+		return nil, errors.New("destination not provided")
+	}
+	limits := make(map[string]cosmos.Uint)
+	for _, key := range []string{"price_limit", "max_slip", "max_liquidity_fee"} {
+		limits[key] = cosmos.ZeroUint()
+		if len(params.Get(key)) > 0 {
+			limits[key], err = cosmos.ParseUint(params.Get(key))
+			if err != nil {
+				return nil, fmt.Errorf("fail to parse %s: %w", key, err)
+			}
+		}
+	}
+	priceLimit := limits["price_limit"]
+
+	version := keeper.GetLowestActiveVersion(ctx)
+	constAccessor := constants.GetConstantValues(version)
+	if constAccessor == nil {
+		return nil, fmt.Errorf("constants for version(%s) is not available", version)
+	}
+	transactionFee := cosmos.NewUint(uint64(constAccessor.GetInt64Value(constants.TransactionFee)))
+
+	tx := common.NewTx(
+		common.BlankTxID,
+		destination,
+		destination,
+		common.Coins{common.NewCoin(fromAsset, amount)},
+		common.Gas{common.NewCoin(fromAsset.Chain.GetGasAsset(), cosmos.OneUint())},
+		"",
+	)
+
+	// swaps write pool changes , use a cached context and never write it back
+	cacheCtx, _ := ctx.CacheContext()
+	result := NewQuerySwapQuote(fromAsset, toAsset, amount)
+	// price limit is checked below, so the emit amount is still reported when the limit can't be met
+	emitAmount, swapEvents, swapErr := swap(cacheCtx, keeper, tx, toAsset, destination, cosmos.ZeroUint(), limits["max_slip"], limits["max_liquidity_fee"], transactionFee)
+	for i, evt := range swapEvents {
+		leg := QuerySwapQuoteLeg{
+			Pool:               evt.Pool,
+			EmitAmount:         emitAmount,
+			TradeSlip:          evt.TradeSlip,
+			LiquidityFee:       evt.LiquidityFee,
+			LiquidityFeeInRune: evt.LiquidityFeeInRune,
+		}
+		// the emit of the first leg in a double swap is the input of the next one
+		if i < len(swapEvents)-1 {
+			leg.EmitAmount = swapEvents[i+1].InTx.Coins[0].Amount
+		}
+		result.Legs = append(result.Legs, leg)
+		result.LiquidityFeeInRune = result.LiquidityFeeInRune.Add(evt.LiquidityFeeInRune)
+		result.TradeSlip = result.TradeSlip.Add(evt.TradeSlip)
+	}
+	if swapErr == nil && !priceLimit.IsZero() && emitAmount.LT(priceLimit) {
+		swapErr = fmt.Errorf("emit asset %s less than price limit %s", emitAmount, priceLimit)
+	}
+	if swapErr == nil {
+		result.EmitAmount = emitAmount
+		result.OutboundFee, swapErr = quoteOutboundFee(cacheCtx, keeper, common.NewCoin(toAsset, emitAmount), transactionFee)
+		result.ExpectedAmountOut = common.SafeSub(emitAmount, result.OutboundFee)
+	}
+	if swapErr != nil {
+		_, result.Code, _ = se.ABCIInfo(swapErr, false)
+		result.Reason = swapErr.Error()
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), result)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal swap quote to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal swap quote to json: %w", err)
+	}
+	return res, nil
+}
+
+// quoteOutboundFee calculate the transaction fee TxOutStore will deduct from the given outbound coin
+func quoteOutboundFee(ctx cosmos.Context, keeper keeper.Keeper, coin common.Coin, transactionFee cosmos.Uint) (cosmos.Uint, error) {
+	fee := transactionFee
+	if !coin.Asset.IsRune() {
+		pool, err := keeper.GetPool(ctx, coin.Asset)
+		if err != nil {
+			return cosmos.ZeroUint(), fmt.Errorf("fail to get pool: %w", err)
+		}
+		fee = pool.RuneValueInAsset(transactionFee)
+	}
+	if coin.Amount.LTE(fee) {
+		return coin.Amount, ErrNotEnoughToPayFee
+	}
+	return fee, nil
+}
+
+func queryStreamingSwaps(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	streams := make(StreamingSwaps, 0)
+	iter := keeper.GetStreamingSwapIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var stream StreamingSwap
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &stream); err != nil {
+			ctx.Logger().Error("fail to unmarshal streaming swap", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal streaming swap: %w", err)
+		}
+		streams = append(streams, stream)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), streams)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal streaming swaps to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal streaming swaps to json: %w", err)
+	}
+	return res, nil
+}
+
+func queryStreamingSwap(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	hash, err := common.NewTxID(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse tx id", "error", err)
+		return nil, fmt.Errorf("fail to parse tx id: %w", err)
+	}
+	stream, err := keeper.GetStreamingSwap(ctx, hash)
+	if err != nil {
+		ctx.Logger().Error("fail to get streaming swap", "error", err)
+		return nil, fmt.Errorf("fail to get streaming swap: %w", err)
+	}
+	if stream.IsEmpty() {
+		return nil, fmt.Errorf("streaming swap: %s doesn't exist", hash)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), stream)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal streaming swap to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal streaming swap to json: %w", err)
+	}
+	return res, nil
+}
+
+func queryLimitOrders(ctx cosmos.Context, keeper keeper.Keeper) ([]byte, error) {
+	orders := make(LimitOrders, 0)
+	iter := keeper.GetLimitOrderIterator(ctx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var order LimitOrder
+		if err := keeper.Cdc().UnmarshalBinaryBare(iter.Value(), &order); err != nil {
+			ctx.Logger().Error("fail to unmarshal limit order", "error", err)
+			return nil, fmt.Errorf("fail to unmarshal limit order: %w", err)
+		}
+		orders = append(orders, order)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), orders)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal limit orders to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal limit orders to json: %w", err)
+	}
+	return res, nil
+}
+
+func queryLimitOrder(ctx cosmos.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, errors.New("tx id not provided")
+	}
+	hash, err := common.NewTxID(path[0])
+	if err != nil {
+		ctx.Logger().Error("fail to parse tx id", "error", err)
+		return nil, fmt.Errorf("fail to parse tx id: %w", err)
+	}
+	order, err := keeper.GetLimitOrder(ctx, hash)
+	if err != nil {
+		ctx.Logger().Error("fail to get limit order", "error", err)
+		return nil, fmt.Errorf("fail to get limit order: %w", err)
+	}
+	if order.IsEmpty() {
+		return nil, fmt.Errorf("limit order: %s doesn't exist", hash)
+	}
+	res, err := codec.MarshalJSONIndent(keeper.Cdc(), order)
+	if err != nil {
+		ctx.Logger().Error("fail to marshal limit order to json", "error", err)
+		return nil, fmt.Errorf("fail to marshal limit order to json: %w", err)
+	}
+	return res, nil
+}
@@ -1,6 +1,7 @@
 package thorchain
 
 import (
+	"encoding/json"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,7 @@ import (
 
 	"gitlab.com/thorchain/thornode/common"
 	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
 	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
 	"gitlab.com/thorchain/thornode/x/thorchain/query"
 	"gitlab.com/thorchain/thornode/x/thorchain/types"
@@ -222,6 +224,395 @@ func (s *QuerierSuite) TestQueryStakers(c *C) {
 	c.Assert(stakers, HasLen, 1)
 }
 
+func (s *QuerierSuite) TestQueryStaker(c *C) {
+	// address not provided
+	result, err := s.querier(s.ctx, []string{query.QueryStaker.Key}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	runeAddr := GetRandomRUNEAddress()
+	btcAddr := GetRandomBTCAddress()
+	for _, asset := range []common.Asset{common.BNBAsset, common.BTCAsset} {
+		s.k.SetStaker(s.ctx, Staker{
+			Asset:           asset,
+			RuneAddress:     runeAddr,
+			AssetAddress:    btcAddr,
+			LastStakeHeight: 10,
+			Units:           cosmos.NewUint(50 * common.One),
+			PendingRune:     cosmos.ZeroUint(),
+			RuneDeposit:     cosmos.ZeroUint(),
+			AssetDeposit:    cosmos.ZeroUint(),
+		})
+	}
+	var stakers []Staker
+	result, err = s.querier(s.ctx, []string{query.QueryStaker.Key, runeAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &stakers), IsNil)
+	c.Check(stakers, HasLen, 2)
+	result, err = s.querier(s.ctx, []string{query.QueryStaker.Key, btcAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &stakers), IsNil)
+	c.Check(stakers, HasLen, 2)
+	result, err = s.querier(s.ctx, []string{query.QueryStaker.Key, GetRandomRUNEAddress().String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &stakers), IsNil)
+	c.Check(stakers, HasLen, 0)
+}
+
+func (s *QuerierSuite) TestQueryPendingStakes(c *C) {
+	ctx := s.ctx.WithBlockHeight(50)
+	s.k.SetMimir(ctx, constants.PendingRuneExpiryBlocks.String(), 100)
+	result, err := s.querier(ctx, []string{query.QueryPendingStakes.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var pending []QueryPendingStake
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &pending), IsNil)
+	c.Check(pending, HasLen, 0)
+
+	staker := Staker{
+		Asset:           common.BTCAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		LastStakeHeight: 10,
+		Units:           cosmos.ZeroUint(),
+		PendingRune:     cosmos.NewUint(common.One),
+		PendingTxID:     GetRandomTxHash(),
+		RuneDeposit:     cosmos.ZeroUint(),
+		AssetDeposit:    cosmos.ZeroUint(),
+	}
+	s.k.SetStaker(ctx, staker)
+	result, err = s.querier(ctx, []string{query.QueryPendingStakes.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &pending), IsNil)
+	c.Assert(pending, HasLen, 1)
+	c.Check(pending[0].Staker.RuneAddress.Equals(staker.RuneAddress), Equals, true)
+	c.Check(pending[0].Staker.PendingRune.Equal(cosmos.NewUint(common.One)), Equals, true)
+	c.Check(pending[0].Age, Equals, int64(40))
+	c.Check(pending[0].ExpiryHeight, Equals, int64(110))
+}
+
+func (s *QuerierSuite) TestQueryPoolHistory(c *C) {
+	// asset not provided
+	result, err := s.querier(s.ctx, []string{query.QueryPoolHistory.Key}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	ctx := s.ctx.WithBlockHeight(100)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	for height := int64(10); height <= 100; height += 10 {
+		s.k.SetPoolSnapshot(ctx, NewPoolSnapshot(pool, height, cosmos.ZeroUint()))
+	}
+
+	var history []PoolSnapshot
+	result, err = s.querier(ctx, []string{query.QueryPoolHistory.Key, common.BNBAsset.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &history), IsNil)
+	c.Check(history, HasLen, 10)
+
+	data := "/thorchain/pool/BNB.BNB/history?from=20&to=80&interval=30"
+	result, err = s.querier(ctx, []string{query.QueryPoolHistory.Key, common.BNBAsset.String()}, abci.RequestQuery{Data: []byte(data)})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &history), IsNil)
+	c.Assert(history, HasLen, 3)
+	c.Check(history[0].Height, Equals, int64(20))
+	c.Check(history[1].Height, Equals, int64(50))
+	c.Check(history[2].Height, Equals, int64(80))
+	c.Check(history[0].BalanceRune.Equal(pool.BalanceRune), Equals, true)
+
+	for _, data := range []string{
+		"/thorchain/pool/BNB.BNB/history?from=80&to=20",
+		"/thorchain/pool/BNB.BNB/history?from=abc",
+		"/thorchain/pool/BNB.BNB/history?interval=-1",
+	} {
+		result, err = s.querier(ctx, []string{query.QueryPoolHistory.Key, common.BNBAsset.String()}, abci.RequestQuery{Data: []byte(data)})
+		c.Check(result, IsNil)
+		c.Check(err, NotNil)
+	}
+}
+
+func (s *QuerierSuite) TestQueryPoolTWAP(c *C) {
+	// asset not provided
+	result, err := s.querier(s.ctx, []string{query.QueryPoolTWAP.Key}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+	// pool doesn't exist
+	result, err = s.querier(s.ctx, []string{query.QueryPoolTWAP.Key, common.BTCAsset.String()}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	ctx := s.ctx.WithBlockHeight(100)
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(300 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(s.k.SetPool(ctx, pool), IsNil)
+	acc := NewPoolPriceAccumulator(common.BNBAsset)
+	acc.Update(cosmos.NewUint(common.One), 10)
+	s.k.SetPoolPriceCheckpoint(ctx, NewPoolPriceCheckpoint(acc))
+	acc.Update(cosmos.NewUint(3*common.One), 55)
+	s.k.SetPoolPriceCheckpoint(ctx, NewPoolPriceCheckpoint(acc))
+	acc.Update(cosmos.NewUint(3*common.One), 100)
+	s.k.SetPoolPriceAccumulator(ctx, acc)
+
+	// the pool is younger than the default window
+	var twap QueryPoolTWAP
+	result, err = s.querier(ctx, []string{query.QueryPoolTWAP.Key, common.BNBAsset.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &twap), IsNil)
+	c.Check(twap.Window, Equals, int64(720))
+	c.Check(twap.SpotPrice.Equal(cosmos.NewUint(3*common.One)), Equals, true)
+	c.Check(twap.TWAP.IsZero(), Equals, true)
+
+	data := "/thorchain/pool/BNB.BNB/twap?window=90"
+	result, err = s.querier(ctx, []string{query.QueryPoolTWAP.Key, common.BNBAsset.String()}, abci.RequestQuery{Data: []byte(data)})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &twap), IsNil)
+	c.Check(twap.Window, Equals, int64(90))
+	c.Check(twap.TWAP.Equal(cosmos.NewUint(2*common.One)), Equals, true)
+
+	data = "/thorchain/pool/BNB.BNB/twap?window=40"
+	result, err = s.querier(ctx, []string{query.QueryPoolTWAP.Key, common.BNBAsset.String()}, abci.RequestQuery{Data: []byte(data)})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &twap), IsNil)
+	c.Check(twap.Window, Equals, int64(40))
+	c.Check(twap.TWAP.Equal(cosmos.NewUint(3*common.One)), Equals, true)
+
+	data = "/thorchain/pool/BNB.BNB/twap?window=0"
+	result, err = s.querier(ctx, []string{query.QueryPoolTWAP.Key, common.BNBAsset.String()}, abci.RequestQuery{Data: []byte(data)})
+	c.Check(result, IsNil)
+	c.Check(err, NotNil)
+}
+
+func (s *QuerierSuite) TestQueryPoolSuspension(c *C) {
+	// asset not provided
+	result, err := s.querier(s.ctx, []string{query.QueryPoolSuspension.Key}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+	// pool not suspended
+	result, err = s.querier(s.ctx, []string{query.QueryPoolSuspension.Key, common.BNBAsset.String()}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolSuspended
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	s.k.SetStaker(s.ctx, Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     GetRandomRUNEAddress(),
+		AssetAddress:    GetRandomBNBAddress(),
+		LastStakeHeight: 10,
+		Units:           cosmos.NewUint(100 * common.One),
+		PendingRune:     cosmos.ZeroUint(),
+		RuneDeposit:     cosmos.ZeroUint(),
+		AssetDeposit:    cosmos.ZeroUint(),
+	})
+	suspension := NewPoolSuspension(common.BNBAsset, PoolEnabled, 10)
+	suspension.DelistHeight = 20
+	s.k.SetPoolSuspension(s.ctx, suspension)
+
+	var res QueryPoolSuspension
+	result, err = s.querier(s.ctx, []string{query.QueryPoolSuspension.Key, common.BNBAsset.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Check(res.Status, Equals, PoolSuspended)
+	c.Check(res.PreviousStatus, Equals, PoolEnabled)
+	c.Check(res.SuspendedHeight, Equals, int64(10))
+	c.Check(res.Delisting, Equals, true)
+	c.Check(res.DelistHeight, Equals, int64(20))
+	c.Check(res.Stakers, Equals, int64(1))
+	c.Check(res.PendingStakers, Equals, int64(0))
+	c.Check(res.BalanceRune.Equal(pool.BalanceRune), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryBootstrapPools(c *C) {
+	ctx := s.ctx.WithBlockHeight(250)
+	for i, symbol := range []string{"BNB.BNB", "BTC.BTC", "ETH.ETH"} {
+		asset, err := common.NewAsset(symbol)
+		c.Assert(err, IsNil)
+		pool := NewPool()
+		pool.Asset = asset
+		pool.Status = PoolBootstrap
+		pool.BalanceRune = cosmos.NewUint(uint64(i) * common.One)
+		pool.BalanceAsset = cosmos.NewUint(uint64(i) * common.One)
+		c.Assert(s.k.SetPool(ctx, pool), IsNil)
+	}
+	s.k.SetMimir(ctx, constants.NewPoolCycle.String(), 100)
+
+	var res QueryBootstrapPools
+	result, err := s.querier(ctx, []string{query.QueryBootstrapPools.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Check(res.NewPoolCycle, Equals, int64(100))
+	c.Check(res.NextCycleHeight, Equals, int64(300))
+	c.Assert(res.Pools, HasLen, 3)
+	c.Check(res.Pools[0].Asset.Equals(common.ETHAsset), Equals, true)
+	c.Check(res.Pools[0].Rank, Equals, 1)
+	c.Check(res.Pools[0].Next, Equals, true)
+	c.Check(res.Pools[1].Asset.Equals(common.BTCAsset), Equals, true)
+	c.Check(res.Pools[1].Next, Equals, false)
+	c.Check(res.Pools[2].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(res.Pools[2].Eligible, Equals, false)
+}
+
+func (s *QuerierSuite) TestQueryVaultsSolvency(c *C) {
+	var res []QueryVaultSolvency
+	result, err := s.querier(s.ctx, []string{query.QueryVaultsSolvency.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Check(res, HasLen, 0)
+
+	vault := GetRandomVault()
+	vault.Coins = common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	s.k.SetVaultSolvency(s.ctx, NewVaultSolvency(vault.PubKey, common.BNBChain, common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(50*common.One)),
+	}, 1024, 10))
+	// vault doesn't exist anymore
+	s.k.SetVaultSolvency(s.ctx, NewVaultSolvency(GetRandomPubKey(), common.BNBChain, common.Coins{}, 1024, 10))
+
+	result, err = s.querier(s.ctx, []string{query.QueryVaultsSolvency.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Assert(res, HasLen, 1)
+	c.Check(res[0].PubKey.Equals(vault.PubKey), Equals, true)
+	c.Check(res[0].Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(res[0].Height, Equals, int64(1024))
+	c.Check(res[0].Solvent, Equals, false)
+	c.Assert(res[0].Coins, HasLen, 1)
+	c.Check(res[0].Coins[0].Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(res[0].Coins[0].Expected.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+	c.Check(res[0].Coins[0].Observed.Equal(cosmos.NewUint(50*common.One)), Equals, true)
+	c.Check(res[0].Coins[0].Solvent, Equals, false)
+}
+
+func (s *QuerierSuite) TestQueryChainHalts(c *C) {
+	var res []QueryChainHalt
+	result, err := s.querier(s.ctx, []string{query.QueryChainHalts.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Check(res, HasLen, 0)
+
+	vault := GetRandomVault()
+	vault.Chains = common.Chains{common.BNBChain, common.BTCChain}
+	c.Assert(s.k.SetVault(s.ctx, vault), IsNil)
+	s.k.SetMimir(s.ctx, "HaltBTCTrading", 1)
+	s.k.SetMimir(s.ctx, "HaltBNBChain", common.BlockHeight(s.ctx)+10)
+	s.k.SetChainHalt(s.ctx, NewChainHalt(common.ETHChain, 5, ChainHaltInsolvency))
+
+	result, err = s.querier(s.ctx, []string{query.QueryChainHalts.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Assert(res, HasLen, 3)
+	c.Check(res[0].Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(res[0].TradingHalted, Equals, false)
+	c.Check(res[0].ChainHalted, Equals, false)
+	c.Check(res[0].HaltChainHeight, Equals, common.BlockHeight(s.ctx)+10)
+	c.Check(res[1].Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(res[1].TradingHalted, Equals, true)
+	c.Check(res[1].ChainHalted, Equals, false)
+	c.Check(res[1].HaltTradingHeight, Equals, int64(1))
+	c.Check(res[2].Chain.Equals(common.ETHChain), Equals, true)
+	c.Check(res[2].TradingHalted, Equals, true)
+	c.Check(res[2].AutoHaltHeight, Equals, int64(5))
+	c.Check(res[2].AutoHaltReason, Equals, ChainHaltInsolvency)
+}
+
+func (s *QuerierSuite) TestQueryChurnPreview(c *C) {
+	na := GetRandomNodeAccount(NodeReady)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+
+	var res QueryChurnPreview
+	result, err := s.querier(s.ctx, []string{query.QueryChurnPreview.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Check(res.Height, Equals, common.BlockHeight(s.ctx))
+	c.Check(res.NextChurnHeight > res.Height, Equals, true)
+	c.Check(res.Removed, HasLen, 0)
+	c.Assert(res.Added, HasLen, 1)
+	c.Check(res.Added[0].NodeAddress.Equals(na.NodeAddress), Equals, true)
+
+	// the query doesn't change anything
+	na, err = s.k.GetNodeAccount(s.ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(na.Status, Equals, NodeReady)
+}
+
+func (s *QuerierSuite) TestQueryNodesMaintenance(c *C) {
+	var res []NodeMaintenance
+	result, err := s.querier(s.ctx, []string{query.QueryNodesMaintenance.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Check(res, HasLen, 0)
+
+	height := common.BlockHeight(s.ctx)
+	addr := GetRandomBech32Addr()
+	c.Assert(s.k.SetNodeMaintenance(s.ctx, NewNodeMaintenance(addr, height, height+10)), IsNil)
+	// maintenance that is over is not listed
+	c.Assert(s.k.SetNodeMaintenance(s.ctx, NewNodeMaintenance(GetRandomBech32Addr(), height, height)), IsNil)
+
+	result, err = s.querier(s.ctx, []string{query.QueryNodesMaintenance.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &res), IsNil)
+	c.Assert(res, HasLen, 1)
+	c.Check(res[0].NodeAddress.Equals(addr), Equals, true)
+	c.Check(res[0].EndHeight, Equals, height+10)
+}
+
+func (s *QuerierSuite) TestQueryStakerPositions(c *C) {
+	// address not provided
+	result, err := s.querier(s.ctx, []string{query.QueryStakerPositions.Key}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(200 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	runeAddr := GetRandomRUNEAddress()
+	result, err = s.querier(s.ctx, []string{query.QueryStakerPositions.Key, runeAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var positions []QueryStakerPosition
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &positions), IsNil)
+	c.Check(positions, HasLen, 0)
+
+	s.k.SetStaker(s.ctx, Staker{
+		Asset:           common.BNBAsset,
+		RuneAddress:     runeAddr,
+		AssetAddress:    GetRandomBNBAddress(),
+		LastStakeHeight: 10,
+		Units:           cosmos.NewUint(50 * common.One),
+		PendingRune:     cosmos.ZeroUint(),
+		RuneDeposit:     cosmos.NewUint(80 * common.One),
+		AssetDeposit:    cosmos.NewUint(50 * common.One),
+	})
+	result, err = s.querier(s.ctx, []string{query.QueryStakerPositions.Key, runeAddr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &positions), IsNil)
+	c.Assert(positions, HasLen, 1)
+	position := positions[0]
+	c.Check(position.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(position.RuneRedeemable.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+	c.Check(position.AssetRedeemable.Equal(cosmos.NewUint(50*common.One)), Equals, true)
+	c.Check(position.HoldValue.Equal(cosmos.NewUint(180*common.One)), Equals, true)
+	c.Check(position.RedeemableValue.Equal(cosmos.NewUint(200*common.One)), Equals, true)
+	c.Check(position.Gain, Equals, int64(1111))
+	constAccessor := constants.GetConstantValues(s.k.GetLowestActiveVersion(s.ctx))
+	c.Check(position.UnlockHeight, Equals, 10+constAccessor.GetInt64Value(constants.StakeLockUpBlocks))
+	c.Check(position.Locked, Equals, s.ctx.BlockHeight() < position.UnlockHeight)
+}
+
 func (s *QuerierSuite) TestQueryTxInVoter(c *C) {
 	req := abci.RequestQuery{
 		Data:   nil,
@@ -320,6 +711,145 @@ func (s *QuerierSuite) TestQueryQueue(c *C) {
 	c.Assert(s.k.Cdc().UnmarshalJSON(result, &q), IsNil)
 }
 
+func (s *QuerierSuite) TestQueryScheduledQueue(c *C) {
+	result, err := s.querier(s.ctx, []string{query.QueryQueueScheduled.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var scheduled []TxOut
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &scheduled), IsNil)
+	c.Check(scheduled, HasLen, 0)
+
+	item := &TxOutItem{
+		Chain:     common.BNBChain,
+		ToAddress: GetRandomBNBAddress(),
+		InHash:    GetRandomTxHash(),
+		Coin:      common.NewCoin(common.BNBAsset, cosmos.NewUint(100*common.One)),
+	}
+	c.Assert(s.k.AppendScheduledOutbound(s.ctx, 200, item), IsNil)
+	c.Assert(s.k.AppendScheduledOutbound(s.ctx, 100, item), IsNil)
+	c.Assert(s.k.AppendScheduledOutbound(s.ctx, 100, item), IsNil)
+
+	result, err = s.querier(s.ctx, []string{query.QueryQueueScheduled.Key}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &scheduled), IsNil)
+	c.Assert(scheduled, HasLen, 2)
+	c.Check(scheduled[0].Height, Equals, int64(100))
+	c.Check(scheduled[0].TxArray, HasLen, 2)
+	c.Check(scheduled[1].Height, Equals, int64(200))
+	c.Check(scheduled[1].TxArray[0].InHash.Equals(item.InHash), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryNodeAccountSlashes(c *C) {
+	_, err := s.querier(s.ctx, []string{query.QueryNodeAccountSlashes.Key}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryNodeAccountSlashes.Key, "whatever"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+	txID := GetRandomTxHash()
+	record := NewSlashRecord(na.NodeAddress, common.BlockHeight(s.ctx), 2, SlashReasonObserve, txID, common.EmptyPubKey)
+	c.Assert(addSlashPoints(s.ctx, s.k, record), IsNil)
+
+	result, err := s.querier(s.ctx, []string{query.QueryNodeAccountSlashes.Key, na.NodeAddress.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var slashes QueryNodeSlashes
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &slashes), IsNil)
+	c.Check(slashes.NodeAddress.Equals(na.NodeAddress), Equals, true)
+	c.Check(slashes.SlashPoints, Equals, int64(2))
+	c.Assert(slashes.Records, HasLen, 1)
+	c.Check(slashes.Records[0].Reason, Equals, SlashReasonObserve)
+	c.Check(slashes.Records[0].TxID.Equals(txID), Equals, true)
+}
+
+func (s *QuerierSuite) TestQueryNodeObservations(c *C) {
+	_, err := s.querier(s.ctx, []string{query.QueryNodeObservations.Key}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+	_, err = s.querier(s.ctx, []string{query.QueryNodeObservations.Key, "whatever"}, abci.RequestQuery{})
+	c.Assert(err, NotNil)
+
+	addr := GetRandomBech32Addr()
+	observation := NewChainObservation(addr, common.BTCChain)
+	observation.Total = 10
+	observation.Missed = 3
+	c.Assert(s.k.SetChainObservation(s.ctx, observation), IsNil)
+
+	result, err := s.querier(s.ctx, []string{query.QueryNodeObservations.Key, addr.String()}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var observations []QueryNodeObservation
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &observations), IsNil)
+	c.Assert(observations, HasLen, 1)
+	c.Check(observations[0].Chain.Equals(common.BTCChain), Equals, true)
+	c.Check(observations[0].Total, Equals, int64(10))
+	c.Check(observations[0].Missed, Equals, int64(3))
+	c.Check(observations[0].MissRate, Equals, int64(3000))
+}
+
+func (s *QuerierSuite) TestQuerySwapQueue(c *C) {
+	result, err := s.querier(s.ctx, []string{
+		query.QueryQueueSwap.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var items []QuerySwapQueueItem
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &items), IsNil)
+	c.Check(items, HasLen, 0)
+
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+	for i := 1; i <= 12; i++ {
+		msg := NewMsgSwap(common.Tx{
+			ID:    GetRandomTxHash(),
+			Coins: common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(uint64(i)*common.One))},
+		}, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr())
+		c.Assert(s.k.SetSwapQueueItem(s.ctx, msg), IsNil)
+	}
+	// streaming swap waiting for the next sub-swap
+	streamMsg := NewMsgSwap(common.Tx{
+		ID:    GetRandomTxHash(),
+		Coins: common.Coins{common.NewCoin(common.RuneAsset(), cosmos.NewUint(50*common.One))},
+	}, common.BNBAsset, GetRandomBNBAddress(), cosmos.ZeroUint(), GetRandomBech32Addr())
+	streamMsg.StreamInterval = 10
+	streamMsg.StreamQuantity = 5
+	c.Assert(s.k.SetSwapQueueItem(s.ctx, streamMsg), IsNil)
+	stream := NewStreamingSwap(streamMsg.Tx.ID, 10, 5, cosmos.NewUint(50*common.One))
+	stream.Count = 1
+	stream.LastHeight = s.ctx.BlockHeight()
+	s.k.SetStreamingSwap(s.ctx, stream)
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryQueueSwap.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &items), IsNil)
+	c.Assert(items, HasLen, 13)
+	// biggest swap first
+	c.Check(items[0].Msg.Tx.Coins[0].Amount.Equal(cosmos.NewUint(12*common.One)), Equals, true)
+	c.Check(items[0].TradeSlip.IsZero(), Equals, false)
+	for i := 0; i < 12; i++ {
+		c.Check(items[i].Position, Equals, int64(i+1))
+		// half of the queue is done in the next block , the rest in the block after
+		if i < 6 {
+			c.Check(items[i].EstimatedBlock, Equals, s.ctx.BlockHeight()+1)
+		} else {
+			c.Check(items[i].EstimatedBlock, Equals, s.ctx.BlockHeight()+2)
+		}
+	}
+	c.Check(items[12].Msg.Tx.ID.Equals(streamMsg.Tx.ID), Equals, true)
+	c.Check(items[12].Position, Equals, int64(0))
+	c.Check(items[12].EstimatedBlock, Equals, s.ctx.BlockHeight()+10)
+
+	// swap queue halted by mimir
+	s.k.SetMimir(s.ctx, constants.MaxSwapsPerBlock.String(), 0)
+	result, err = s.querier(s.ctx, []string{
+		query.QueryQueueSwap.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &items), IsNil)
+	c.Check(items[0].EstimatedBlock, Equals, int64(0))
+}
+
 func (s *QuerierSuite) TestQueryHeights(c *C) {
 	result, err := s.querier(s.ctx, []string{
 		query.QueryHeights.Key,
@@ -436,6 +966,22 @@ func (s *QuerierSuite) TestQueryConstantValues(c *C) {
 	}, abci.RequestQuery{})
 	c.Assert(result, NotNil)
 	c.Assert(err, IsNil)
+
+	var values struct {
+		Int64Values map[string]int64 `json:"int_64_values"`
+	}
+	c.Assert(json.Unmarshal(result, &values), IsNil)
+	c.Check(values.Int64Values[constants.MaxSwapsPerBlock.String()], Equals, int64(100))
+
+	// mimir override is reported as the effective value
+	s.k.SetMimir(s.ctx, constants.MaxSwapsPerBlock.String(), 250)
+	result, err = s.querier(s.ctx, []string{
+		query.QueryConstantValues.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(json.Unmarshal(result, &values), IsNil)
+	c.Check(values.Int64Values[constants.MaxSwapsPerBlock.String()], Equals, int64(250))
+	c.Check(values.Int64Values[constants.MinSwapsPerBlock.String()], Equals, int64(10))
 }
 
 func (s *QuerierSuite) TestQueryMimir(c *C) {
@@ -495,6 +1041,28 @@ func (s *QuerierSuite) TestQueryNodeAccount(c *C) {
 	c.Assert(err, IsNil)
 	var r QueryNodeAccount
 	c.Assert(s.k.Cdc().UnmarshalJSON(result, &r), IsNil)
+	c.Assert(r.BondProviders, HasLen, 1)
+	c.Check(r.BondProviders[0].BondAddress.Equals(na.BondAddress), Equals, true)
+	c.Check(r.BondProviders[0].Bond.Equal(na.Bond), Equals, true)
+
+	provider := GetRandomBNBAddress()
+	bp, err := getBondProviders(s.ctx, s.k, na)
+	c.Assert(err, IsNil)
+	bp.NodeOperatorFee = 500
+	bp.Bond(provider, na.Bond, na.Bond)
+	c.Assert(s.k.SetBondProviders(s.ctx, bp), IsNil)
+	na.Bond = na.Bond.MulUint64(2)
+	c.Assert(s.k.SetNodeAccount(s.ctx, na), IsNil)
+	result, err = s.querier(s.ctx, []string{
+		query.QueryNodeAccount.Key,
+		na.NodeAddress.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &r), IsNil)
+	c.Check(r.NodeOperatorFee, Equals, int64(500))
+	c.Assert(r.BondProviders, HasLen, 2)
+	c.Check(r.BondProviders[1].BondAddress.Equals(provider), Equals, true)
+	c.Check(r.BondProviders[1].Bond.Equal(na.Bond.QuoUint64(2)), Equals, true)
 }
 
 func (s *QuerierSuite) TestQueryNodeAccountCheck(c *C) {
@@ -686,3 +1254,173 @@ func (s *QuerierSuite) TestQueryVault(c *C) {
 	c.Assert(vault.Status, Equals, returnVault.Status)
 	c.Assert(vault.BlockHeight, Equals, returnVault.BlockHeight)
 }
+
+func (s *QuerierSuite) TestQuerySwapQuote(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100 * common.One)
+	pool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	pool.PoolUnits = cosmos.NewUint(100 * common.One)
+	pool.Status = PoolEnabled
+	c.Assert(s.k.SetPool(s.ctx, pool), IsNil)
+
+	btcPool := NewPool()
+	btcPool.Asset = common.BTCAsset
+	btcPool.BalanceRune = cosmos.NewUint(100 * common.One)
+	btcPool.BalanceAsset = cosmos.NewUint(100 * common.One)
+	btcPool.PoolUnits = cosmos.NewUint(100 * common.One)
+	btcPool.Status = PoolBootstrap
+	c.Assert(s.k.SetPool(s.ctx, btcPool), IsNil)
+
+	dest := GetRandomBNBAddress()
+	quote := func(data string) (QuerySwapQuote, error) {
+		var q QuerySwapQuote
+		result, err := s.querier(s.ctx, []string{query.QuerySwapQuote.Key}, abci.RequestQuery{Data: []byte(data)})
+		if err != nil {
+			return q, err
+		}
+		c.Assert(s.k.Cdc().UnmarshalJSON(result, &q), IsNil)
+		return q, nil
+	}
+
+	// missing parameters
+	_, err := quote("/thorchain/quote/swap")
+	c.Assert(err, NotNil)
+	_, err = quote("/thorchain/quote/swap?from_asset=" + common.RuneAsset().String() + "&to_asset=BNB.BNB&amount=100000000")
+	c.Assert(err, NotNil)
+
+	// single swap
+	q, err := quote("/thorchain/quote/swap?from_asset=" + common.RuneAsset().String() + "&to_asset=BNB.BNB&amount=1000000000&destination=" + dest.String())
+	c.Assert(err, IsNil)
+	c.Check(q.Code, Equals, uint32(0))
+	c.Check(q.Legs, HasLen, 1)
+	c.Check(q.EmitAmount.Uint64(), Equals, uint64(826446280))
+	c.Check(q.TradeSlip.Uint64(), Equals, uint64(2100))
+	c.Check(q.OutboundFee.Uint64(), Equals, uint64(83395943))
+	c.Check(q.ExpectedAmountOut.Uint64(), Equals, q.EmitAmount.Sub(q.OutboundFee).Uint64())
+
+	// nothing should be committed
+	pool, err = s.k.GetPool(s.ctx, common.BNBAsset)
+	c.Assert(err, IsNil)
+	c.Check(pool.BalanceRune.Uint64(), Equals, uint64(100*common.One))
+	c.Check(pool.BalanceAsset.Uint64(), Equals, uint64(100*common.One))
+
+	// price limit can't be met
+	q, err = quote("/thorchain/quote/swap?from_asset=" + common.RuneAsset().String() + "&to_asset=BNB.BNB&amount=1000000000&price_limit=900000000&destination=" + dest.String())
+	c.Assert(err, IsNil)
+	c.Check(q.Code, Not(Equals), uint32(0))
+	c.Check(q.Reason, Not(Equals), "")
+
+	// pool not enabled
+	q, err = quote("/thorchain/quote/swap?from_asset=BNB.BNB&to_asset=BTC.BTC&amount=1000000000&destination=" + GetRandomBTCAddress().String())
+	c.Assert(err, IsNil)
+	c.Check(q.Code, Equals, CodeInvalidPoolStatus)
+
+	// double swap
+	btcPool.Status = PoolEnabled
+	c.Assert(s.k.SetPool(s.ctx, btcPool), IsNil)
+	q, err = quote("/thorchain/quote/swap?from_asset=BNB.BNB&to_asset=BTC.BTC&amount=1000000000&destination=" + GetRandomBTCAddress().String())
+	c.Assert(err, IsNil)
+	c.Check(q.Code, Equals, uint32(0))
+	c.Assert(q.Legs, HasLen, 2)
+	c.Check(q.Legs[0].Pool.Equals(common.BNBAsset), Equals, true)
+	c.Check(q.Legs[0].EmitAmount.Uint64(), Equals, uint64(826446280))
+	c.Check(q.Legs[1].Pool.Equals(common.BTCAsset), Equals, true)
+	c.Check(q.EmitAmount.Equal(q.Legs[1].EmitAmount), Equals, true)
+	c.Check(q.TradeSlip.Equal(q.Legs[0].TradeSlip.Add(q.Legs[1].TradeSlip)), Equals, true)
+
+	// not enough to pay the fee
+	q, err = quote("/thorchain/quote/swap?from_asset=BNB.BNB&to_asset=" + common.RuneAsset().String() + "&amount=1000000&destination=" + dest.String())
+	c.Assert(err, IsNil)
+	c.Check(q.Code, Not(Equals), uint32(0))
+}
+
+func (s *QuerierSuite) TestQueryStreamingSwap(c *C) {
+	result, err := s.querier(s.ctx, []string{
+		query.QueryStreamingSwaps.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var streams StreamingSwaps
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &streams), IsNil)
+	c.Check(streams, HasLen, 0)
+
+	// tx id not provided
+	result, err = s.querier(s.ctx, []string{
+		query.QueryStreamingSwap.Key,
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	// not exist
+	txID := GetRandomTxHash()
+	result, err = s.querier(s.ctx, []string{
+		query.QueryStreamingSwap.Key,
+		txID.String(),
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	stream := NewStreamingSwap(txID, 2, 5, cosmos.NewUint(100*common.One))
+	stream.Count = 1
+	s.k.SetStreamingSwap(s.ctx, stream)
+	result, err = s.querier(s.ctx, []string{
+		query.QueryStreamingSwap.Key,
+		txID.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var stream2 StreamingSwap
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &stream2), IsNil)
+	c.Check(stream2.Count, Equals, int64(1))
+	c.Check(stream2.Quantity, Equals, int64(5))
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryStreamingSwaps.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &streams), IsNil)
+	c.Check(streams, HasLen, 1)
+}
+
+func (s *QuerierSuite) TestQueryLimitOrder(c *C) {
+	result, err := s.querier(s.ctx, []string{
+		query.QueryLimitOrders.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var orders LimitOrders
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &orders), IsNil)
+	c.Check(orders, HasLen, 0)
+
+	// tx id not provided
+	result, err = s.querier(s.ctx, []string{
+		query.QueryLimitOrder.Key,
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	// not exist
+	tx := GetRandomTx()
+	result, err = s.querier(s.ctx, []string{
+		query.QueryLimitOrder.Key,
+		tx.ID.String(),
+	}, abci.RequestQuery{})
+	c.Assert(result, IsNil)
+	c.Assert(err, NotNil)
+
+	s.k.SetLimitOrder(s.ctx, NewLimitOrder(tx, common.BNBAsset, GetRandomBNBAddress(), cosmos.NewUint(100*common.One), 1, 10))
+	result, err = s.querier(s.ctx, []string{
+		query.QueryLimitOrder.Key,
+		tx.ID.String(),
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	var order LimitOrder
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &order), IsNil)
+	c.Check(order.ExpiryHeight, Equals, int64(10))
+	c.Check(order.MinOut.Equal(cosmos.NewUint(100*common.One)), Equals, true)
+
+	result, err = s.querier(s.ctx, []string{
+		query.QueryLimitOrders.Key,
+	}, abci.RequestQuery{})
+	c.Assert(err, IsNil)
+	c.Assert(s.k.Cdc().UnmarshalJSON(result, &orders), IsNil)
+	c.Check(orders, HasLen, 1)
+}
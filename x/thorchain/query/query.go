@@ -34,14 +34,23 @@ func (q Query) Path(args ...string) string {
 // query endpoints supported by the thorchain Querier
 var (
 	QueryPool               = Query{Key: "pool", EndpointTemplate: "/%s/pool/{%s}"}
+	QueryPoolHistory        = Query{Key: "poolhistory", EndpointTemplate: "/%s/pool/{%s}/history"}
+	QueryPoolTWAP           = Query{Key: "pooltwap", EndpointTemplate: "/%s/pool/{%s}/twap"}
+	QueryPoolSuspension     = Query{Key: "poolsuspension", EndpointTemplate: "/%s/pool/{%s}/suspension"}
 	QueryPools              = Query{Key: "pools", EndpointTemplate: "/%s/pools"}
+	QueryBootstrapPools     = Query{Key: "bootstrappools", EndpointTemplate: "/%s/pools/bootstrap"}
 	QueryStakers            = Query{Key: "stakers", EndpointTemplate: "/%s/pool/{%s}/stakers"}
+	QueryStaker             = Query{Key: "staker", EndpointTemplate: "/%s/staker/{%s}"}
+	QueryStakerPositions    = Query{Key: "stakerpositions", EndpointTemplate: "/%s/staker/{%s}/positions"}
+	QueryPendingStakes      = Query{Key: "pendingstakes", EndpointTemplate: "/%s/stakers/pending"}
 	QueryTxIn               = Query{Key: "txin", EndpointTemplate: "/%s/tx/{%s}"}
 	QueryTxInVoter          = Query{Key: "txinvoter", EndpointTemplate: "/%s/tx/{%s}/voter"}
 	QueryKeysignArray       = Query{Key: "keysign", EndpointTemplate: "/%s/keysign/{%s}"}
 	QueryKeysignArrayPubkey = Query{Key: "keysignpubkey", EndpointTemplate: "/%s/keysign/{%s}/{%s}"}
 	QueryKeygensPubkey      = Query{Key: "keygenspubkey", EndpointTemplate: "/%s/keygen/{%s}/{%s}"}
 	QueryQueue              = Query{Key: "outqueue", EndpointTemplate: "/%s/queue"}
+	QueryQueueSwap          = Query{Key: "queueswap", EndpointTemplate: "/%s/queue/swap"}
+	QueryQueueScheduled     = Query{Key: "queuescheduled", EndpointTemplate: "/%s/queue/scheduled"}
 	QueryHeights            = Query{Key: "heights", EndpointTemplate: "/%s/lastblock"}
 	QueryChainHeights       = Query{Key: "chainheights", EndpointTemplate: "/%s/lastblock/{%s}"}
 	QueryObservers          = Query{Key: "observers", EndpointTemplate: "/%s/observers"}
@@ -49,6 +58,9 @@ var (
 	QueryNodeAccounts       = Query{Key: "nodeaccounts", EndpointTemplate: "/%s/nodeaccounts"}
 	QueryNodeAccount        = Query{Key: "nodeaccount", EndpointTemplate: "/%s/nodeaccount/{%s}"}
 	QueryNodeAccountCheck   = Query{Key: "nodeaccountcheck", EndpointTemplate: "/%s/nodeaccount/{%s}/preflight"}
+	QueryNodeAccountSlashes = Query{Key: "nodeaccountslashes", EndpointTemplate: "/%s/nodeaccount/{%s}/slashes"}
+	QueryNodeObservations   = Query{Key: "nodeaccountobservations", EndpointTemplate: "/%s/nodeaccount/{%s}/observations"}
+	QueryNodesMaintenance   = Query{Key: "maintenance", EndpointTemplate: "/%s/maintenance"}
 	QueryPoolAddresses      = Query{Key: "pooladdresses", EndpointTemplate: "/%s/pool_addresses"}
 	QueryVaultData          = Query{Key: "vaultdata", EndpointTemplate: "/%s/vault"}
 	QueryBalanceModule      = Query{Key: "balancemodule", EndpointTemplate: "/%s/balance/module/{%s}"}
@@ -56,30 +68,50 @@ var (
 	QueryVaultsYggdrasil    = Query{Key: "vaultsyggdrasil", EndpointTemplate: "/%s/vaults/yggdrasil"}
 	QueryVault              = Query{Key: "vault", EndpointTemplate: "/%s/vault/{%s}/{%s}"}
 	QueryVaultPubkeys       = Query{Key: "vaultpubkeys", EndpointTemplate: "/%s/vaults/pubkeys"}
+	QueryVaultsSolvency     = Query{Key: "vaultssolvency", EndpointTemplate: "/%s/vaults/solvency"}
 	QueryTSSSigners         = Query{Key: "tsssigner", EndpointTemplate: "/%s/vaults/{%s}/signers"}
 	QueryConstantValues     = Query{Key: "constants", EndpointTemplate: "/%s/constants"}
 	QueryVersion            = Query{Key: "version", EndpointTemplate: "/%s/version"}
 	QueryMimirValues        = Query{Key: "mimirs", EndpointTemplate: "/%s/mimir"}
 	QueryBan                = Query{Key: "ban", EndpointTemplate: "/%s/ban/{%s}"}
 	QueryRagnarok           = Query{Key: "ragnarok", EndpointTemplate: "/%s/ragnarok"}
+	QueryChainHalts         = Query{Key: "halts", EndpointTemplate: "/%s/halts"}
+	QueryChurnPreview       = Query{Key: "churnpreview", EndpointTemplate: "/%s/churn/preview"}
+	QuerySwapQuote          = Query{Key: "quoteswap", EndpointTemplate: "/%s/quote/swap"}
+	QueryStreamingSwaps     = Query{Key: "streamingswaps", EndpointTemplate: "/%s/swaps/streaming"}
+	QueryStreamingSwap      = Query{Key: "streamingswap", EndpointTemplate: "/%s/swap/streaming/{%s}"}
+	QueryLimitOrders        = Query{Key: "limitorders", EndpointTemplate: "/%s/limit_orders"}
+	QueryLimitOrder         = Query{Key: "limitorder", EndpointTemplate: "/%s/limit_order/{%s}"}
 )
 
 // Queries all queries
 var Queries = []Query{
 	QueryPool,
+	QueryPoolHistory,
+	QueryPoolTWAP,
+	QueryPoolSuspension,
 	QueryPools,
+	QueryBootstrapPools,
 	QueryStakers,
+	QueryStaker,
+	QueryStakerPositions,
+	QueryPendingStakes,
 	QueryTxInVoter,
 	QueryTxIn,
 	QueryKeysignArray,
 	QueryKeysignArrayPubkey,
 	QueryQueue,
+	QueryQueueSwap,
+	QueryQueueScheduled,
 	QueryHeights,
 	QueryChainHeights,
 	QueryObservers,
 	QueryObserver,
 	QueryNodeAccount,
 	QueryNodeAccountCheck,
+	QueryNodeAccountSlashes,
+	QueryNodeObservations,
+	QueryNodesMaintenance,
 	QueryNodeAccounts,
 	QueryPoolAddresses,
 	QueryVaultData,
@@ -87,6 +119,7 @@ var Queries = []Query{
 	QueryVaultsAsgard,
 	QueryVaultsYggdrasil,
 	QueryVaultPubkeys,
+	QueryVaultsSolvency,
 	QueryVault,
 	QueryKeygensPubkey,
 	QueryTSSSigners,
@@ -95,4 +128,11 @@ var Queries = []Query{
 	QueryMimirValues,
 	QueryBan,
 	QueryRagnarok,
+	QueryChainHalts,
+	QueryChurnPreview,
+	QuerySwapQuote,
+	QueryStreamingSwaps,
+	QueryStreamingSwap,
+	QueryLimitOrders,
+	QueryLimitOrder,
 }
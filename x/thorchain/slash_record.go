@@ -0,0 +1,60 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// addSlashPoints change the slash points of the node account by the points of the given record , and add the record to the slash
+// ledger of the node account , so it can tell why the node account got slashed later , records of the same block are aggregated
+func addSlashPoints(ctx cosmos.Context, keeper keeper.Keeper, record SlashRecord) error {
+	if record.Points >= 0 {
+		if err := keeper.IncNodeAccountSlashPoints(ctx, record.NodeAddress, record.Points); err != nil {
+			return fmt.Errorf("fail to increase slash points: %w", err)
+		}
+	} else {
+		if err := keeper.DecNodeAccountSlashPoints(ctx, record.NodeAddress, -record.Points); err != nil {
+			return fmt.Errorf("fail to decrease slash points: %w", err)
+		}
+	}
+	if err := keeper.AppendSlashRecord(ctx, record); err != nil {
+		return fmt.Errorf("fail to save slash record: %w", err)
+	}
+	return nil
+}
+
+// addBondSlashRecord add the RUNE taken from the bond of the node account to its slash ledger , it should only be called once the
+// bond had been taken
+func addBondSlashRecord(ctx cosmos.Context, keeper keeper.Keeper, addr cosmos.AccAddress, amount cosmos.Uint, reason string, pubKey common.PubKey, chain common.Chain) {
+	if amount.IsZero() {
+		return
+	}
+	record := NewSlashRecord(addr, common.BlockHeight(ctx), 0, reason, common.BlankTxID, pubKey)
+	record.Bond = amount
+	record.Chain = chain
+	if err := keeper.AppendSlashRecord(ctx, record); err != nil {
+		ctx.Logger().Error("fail to save slash record", "error", err, "address", addr.String())
+	}
+}
+
+// getSlashRecordRetention return the number of blocks the slash records are kept
+func getSlashRecordRetention(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) int64 {
+	retention, err := keeper.GetMimir(ctx, constants.SlashRecordRetentionBlocks.String())
+	if retention <= 0 || err != nil {
+		retention = constAccessor.GetInt64Value(constants.SlashRecordRetentionBlocks)
+	}
+	return retention
+}
+
+// pruneSlashRecords remove the slash records that are older than the retention window
+func pruneSlashRecords(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) {
+	before := common.BlockHeight(ctx) - getSlashRecordRetention(ctx, keeper, constAccessor) + 1
+	if before <= 0 {
+		return
+	}
+	keeper.PruneSlashRecords(ctx, before)
+}
@@ -0,0 +1,88 @@
+package thorchain
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/constants"
+)
+
+type SlashRecordSuite struct{}
+
+var _ = Suite(&SlashRecordSuite{})
+
+func (s *SlashRecordSuite) TestAddSlashPoints(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	txID := GetRandomTxHash()
+
+	record := NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), 10, SlashReasonObserve, txID, common.EmptyPubKey)
+	c.Assert(addSlashPoints(ctx, k, record), IsNil)
+	record = NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), 2, SlashReasonObserve, txID, common.EmptyPubKey)
+	c.Assert(addSlashPoints(ctx, k, record), IsNil)
+	record = NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), -4, SlashReasonObserveRedeem, txID, common.EmptyPubKey)
+	c.Assert(addSlashPoints(ctx, k, record), IsNil)
+	record = NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), 720, SlashReasonFailKeygen, common.BlankTxID, GetRandomPubKey())
+	c.Assert(addSlashPoints(ctx, k, record), IsNil)
+
+	points, err := k.GetNodeAccountSlashPoints(ctx, na.NodeAddress)
+	c.Assert(err, IsNil)
+	c.Check(points, Equals, int64(728))
+	// the observations of the same block and tx are aggregated , the redemption is kept apart
+	records, err := k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 3)
+	c.Check(records[0].Points, Equals, int64(12))
+	c.Check(records[0].Reason, Equals, SlashReasonObserve)
+	c.Check(records[0].TxID.Equals(txID), Equals, true)
+	c.Check(records[1].Points, Equals, int64(-4))
+	c.Check(records[1].Reason, Equals, SlashReasonObserveRedeem)
+	c.Check(records[2].Points, Equals, int64(720))
+	c.Check(records[2].Reason, Equals, SlashReasonFailKeygen)
+
+	// the observations of a different tx are recorded apart
+	record = NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), 2, SlashReasonObserve, GetRandomTxHash(), common.EmptyPubKey)
+	c.Assert(addSlashPoints(ctx, k, record), IsNil)
+	records, err = k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 4)
+
+	// the record is removed once it adds up to nothing
+	record = NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), -12, SlashReasonObserve, txID, common.EmptyPubKey)
+	c.Assert(addSlashPoints(ctx, k, record), IsNil)
+	records, err = k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 3)
+
+	// invalid record should not change the slash points
+	record = NewSlashRecord(na.NodeAddress, common.BlockHeight(ctx), 10, "", txID, common.EmptyPubKey)
+	c.Assert(addSlashPoints(ctx, k, record), NotNil)
+}
+
+func (s *SlashRecordSuite) TestPruneSlashRecords(c *C) {
+	ctx, k := setupKeeperForTest(c)
+	constAccessor := constants.GetConstantValues(constants.SWVersion)
+	na := GetRandomNodeAccount(NodeActive)
+	c.Assert(k.SetNodeAccount(ctx, na), IsNil)
+	k.SetMimir(ctx, constants.SlashRecordRetentionBlocks.String(), 10)
+
+	for _, height := range []int64{1, 5, 10, 15} {
+		record := NewSlashRecord(na.NodeAddress, height, 1, SlashReasonLackOfObserving, common.BlankTxID, common.EmptyPubKey)
+		c.Assert(k.AppendSlashRecord(ctx, record), IsNil)
+	}
+
+	ctx = ctx.WithBlockHeight(8)
+	pruneSlashRecords(ctx, k, constAccessor)
+	records, err := k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Check(records, HasLen, 4)
+
+	ctx = ctx.WithBlockHeight(15)
+	pruneSlashRecords(ctx, k, constAccessor)
+	records, err = k.GetSlashRecords(ctx, na.NodeAddress, 0)
+	c.Assert(err, IsNil)
+	c.Assert(records, HasLen, 2)
+	c.Check(records[0].Height, Equals, int64(10))
+	c.Check(records[1].Height, Equals, int64(15))
+}
@@ -0,0 +1,134 @@
+package thorchain
+
+import (
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+	"gitlab.com/thorchain/thornode/constants"
+	"gitlab.com/thorchain/thornode/x/thorchain/keeper"
+)
+
+// solvencyCheck is the balance of an asset THORChain expect a vault to hold , and the balance observed on chain
+type solvencyCheck struct {
+	Asset    common.Asset
+	Expected cosmos.Uint
+	Observed cosmos.Uint
+}
+
+// isInsolvent return true when the observed balance is short of the expected balance by more than the given basis points
+func (c solvencyCheck) isInsolvent(thresholdBasisPoints int64) bool {
+	if c.Observed.GTE(c.Expected) {
+		return false
+	}
+	shortfall := common.SafeSub(c.Expected, c.Observed)
+	return shortfall.MulUint64(MaxUnstakeBasisPoints).GT(c.Expected.MulUint64(uint64(thresholdBasisPoints)))
+}
+
+// getSolvencyChecks compare the coins of the vault on the chain of the solvency report with the observed coins ,
+// an asset missing on either side counts as zero
+func getSolvencyChecks(vault Vault, solvency VaultSolvency) []solvencyCheck {
+	checks := make([]solvencyCheck, 0)
+	seen := make(map[common.Asset]bool)
+	for _, coin := range vault.Coins {
+		if !coin.Asset.Chain.Equals(solvency.Chain) {
+			continue
+		}
+		seen[coin.Asset] = true
+		checks = append(checks, solvencyCheck{
+			Asset:    coin.Asset,
+			Expected: coin.Amount,
+			Observed: solvency.Coins.GetCoin(coin.Asset).Amount,
+		})
+	}
+	for _, coin := range solvency.Coins {
+		if seen[coin.Asset] {
+			continue
+		}
+		checks = append(checks, solvencyCheck{
+			Asset:    coin.Asset,
+			Expected: cosmos.ZeroUint(),
+			Observed: coin.Amount,
+		})
+	}
+	return checks
+}
+
+// deductPendingOutbounds deduct the outbounds the vault is sending on the given chain from the coins THORChain expect it to hold ,
+// the coins of a vault are reduced once its outbound is observed , while the balance on chain drop as soon as it is sent.
+// The outbounds of the last SigningTransactionPeriod blocks that are not observed yet , and the ones held in the schedule ,
+// are deducted with the most gas they can spend
+func deductPendingOutbounds(ctx cosmos.Context, keeper keeper.Keeper, vault Vault, chain common.Chain, constAccessor constants.ConstantValues) (Vault, error) {
+	// leave the coins of the given vault alone
+	coins := make(common.Coins, len(vault.Coins))
+	copy(coins, vault.Coins)
+	vault.Coins = coins
+
+	height := common.BlockHeight(ctx)
+	signingPeriod := constAccessor.GetInt64Value(constants.SigningTransactionPeriod)
+	blocks := make([]*TxOut, 0)
+	for h := height - signingPeriod; h <= height; h++ {
+		if h <= 0 {
+			continue
+		}
+		txOut, err := keeper.GetTxOut(ctx, h)
+		if err != nil {
+			return vault, fmt.Errorf("fail to get tx out: %w", err)
+		}
+		blocks = append(blocks, txOut)
+	}
+	iterator := keeper.GetScheduledOutboundIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var txOut TxOut
+		if err := keeper.Cdc().UnmarshalBinaryBare(iterator.Value(), &txOut); err != nil {
+			return vault, fmt.Errorf("fail to unmarshal scheduled outbound: %w", err)
+		}
+		blocks = append(blocks, &txOut)
+	}
+	for _, block := range blocks {
+		for _, item := range block.TxArray {
+			if !item.Chain.Equals(chain) || !item.VaultPubKey.Equals(vault.PubKey) || !item.OutHash.IsEmpty() {
+				continue
+			}
+			vault.SubFunds(common.Coins{item.Coin})
+			vault.SubFunds(item.MaxGas.ToCoins())
+		}
+	}
+	return vault, nil
+}
+
+// getSolvencyThreshold return the basis points a vault can be short before it is considered insolvent ,
+// SolvencyThresholdBasisPoints mimir takes precedence over the constant
+func getSolvencyThreshold(ctx cosmos.Context, keeper keeper.Keeper, constAccessor constants.ConstantValues) int64 {
+	threshold, err := keeper.GetMimir(ctx, constants.SolvencyThresholdBasisPoints.String())
+	if threshold < 0 || err != nil {
+		threshold = constAccessor.GetInt64Value(constants.SolvencyThresholdBasisPoints)
+	}
+	return threshold
+}
+
+// checkVaultSolvency compare the observed balance of a vault with what THORChain expect once its pending outbounds are sent , and emit a solvency event for every
+// asset the vault is short of
+func checkVaultSolvency(ctx cosmos.Context, keeper keeper.Keeper, mgr Manager, solvency VaultSolvency, constAccessor constants.ConstantValues) error {
+	vault, err := keeper.GetVault(ctx, solvency.PubKey)
+	if err != nil {
+		return fmt.Errorf("fail to get vault: %w", err)
+	}
+	vault, err = deductPendingOutbounds(ctx, keeper, vault, solvency.Chain, constAccessor)
+	if err != nil {
+		return err
+	}
+	threshold := getSolvencyThreshold(ctx, keeper, constAccessor)
+	for _, check := range getSolvencyChecks(vault, solvency) {
+		if !check.isInsolvent(threshold) {
+			continue
+		}
+		ctx.Logger().Error("vault is insolvent", "pubkey", solvency.PubKey, "asset", check.Asset, "expected", check.Expected, "observed", check.Observed)
+		evt := NewEventSolvency(solvency.Chain, solvency.PubKey, check.Asset, check.Expected, check.Observed, solvency.Height)
+		if err := mgr.EventMgr().EmitEvent(ctx, evt); err != nil {
+			return fmt.Errorf("fail to emit solvency event: %w", err)
+		}
+	}
+	return nil
+}
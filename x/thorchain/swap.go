@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+
 	"gitlab.com/thorchain/thornode/common"
 	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
 	keeper "gitlab.com/thorchain/thornode/x/thorchain/keeper"
@@ -29,6 +31,27 @@ func validatePools(ctx cosmos.Context, keeper keeper.Keeper, assets ...common.As
 	return nil
 }
 
+// getSpotSwapOut return the amount of target asset the given coin is worth at the current pool prices , that is the output
+// of a swap without slip and fees , no swap can emit more than this
+func getSpotSwapOut(ctx cosmos.Context, keeper keeper.Keeper, source common.Coin, target common.Asset) (cosmos.Uint, error) {
+	runeAmt := source.Amount
+	if !source.Asset.IsRune() {
+		pool, err := keeper.GetPool(ctx, source.Asset)
+		if err != nil {
+			return cosmos.ZeroUint(), ErrInternal(err, fmt.Sprintf("fail to get %s pool", source.Asset))
+		}
+		runeAmt = pool.AssetValueInRune(source.Amount)
+	}
+	if target.IsRune() {
+		return runeAmt, nil
+	}
+	pool, err := keeper.GetPool(ctx, target)
+	if err != nil {
+		return cosmos.ZeroUint(), ErrInternal(err, fmt.Sprintf("fail to get %s pool", target))
+	}
+	return pool.RuneValueInAsset(runeAmt), nil
+}
+
 // validateMessage is trying to validate the legitimacy of the incoming message and decide whether THORNode can handle it
 func validateMessage(tx common.Tx, target common.Asset, destination common.Address) error {
 	if err := tx.Valid(); err != nil {
@@ -49,6 +72,8 @@ func swap(ctx cosmos.Context,
 	target common.Asset,
 	destination common.Address,
 	tradeTarget cosmos.Uint,
+	maxSlip cosmos.Uint,
+	maxLiquidityFee cosmos.Uint,
 	transactionFee cosmos.Uint) (cosmos.Uint, []EventSwap, error) {
 	var swapEvents []EventSwap
 
@@ -57,6 +82,12 @@ func swap(ctx cosmos.Context,
 	}
 	source := tx.Coins[0].Asset
 
+	for _, asset := range []common.Asset{source, target} {
+		if !asset.IsRune() && isTradingHalted(ctx, keeper, asset.Chain) {
+			return cosmos.ZeroUint(), swapEvents, errTradingHalted
+		}
+	}
+
 	if err := validatePools(ctx, keeper, source, target); err != nil {
 		return cosmos.ZeroUint(), swapEvents, err
 	}
@@ -84,6 +115,19 @@ func swap(ctx cosmos.Context,
 	}
 	swapEvents = append(swapEvents, swapEvt)
 	pools = append(pools, pool)
+	// for double swap , the slip and liquidity fee limits apply to both legs combined
+	tradeSlip := cosmos.ZeroUint()
+	liquidityFee := cosmos.ZeroUint()
+	for _, evt := range swapEvents {
+		tradeSlip = tradeSlip.Add(evt.TradeSlip)
+		liquidityFee = liquidityFee.Add(evt.LiquidityFeeInRune)
+	}
+	if !maxSlip.IsZero() && tradeSlip.GT(maxSlip) {
+		return cosmos.ZeroUint(), swapEvents, se.Wrapf(errSwapFailSlipLimit, "trade slip %s more than slip limit %s", tradeSlip, maxSlip)
+	}
+	if !maxLiquidityFee.IsZero() && liquidityFee.GT(maxLiquidityFee) {
+		return cosmos.ZeroUint(), swapEvents, se.Wrapf(errSwapFailLiquidityFeeLimit, "liquidity fee %s more than liquidity fee limit %s", liquidityFee, maxLiquidityFee)
+	}
 	if !tradeTarget.IsZero() && assetAmount.LT(tradeTarget) {
 		return cosmos.ZeroUint(), swapEvents, fmt.Errorf("emit asset %s less than price limit %s", assetAmount, tradeTarget)
 	}
@@ -205,6 +249,20 @@ func swapOne(ctx cosmos.Context,
 	return emitAssets, pool, swapEvt, nil
 }
 
+// getSwapRefundCode return the code a refund of the given swap error should carry
+func getSwapRefundCode(err error) uint32 {
+	switch {
+	case errors.Is(err, errSwapFailSlipLimit):
+		return CodeSwapFailSlipLimit
+	case errors.Is(err, errSwapFailLiquidityFeeLimit):
+		return CodeSwapFailLiquidityFeeLimit
+	case errors.Is(err, errSwapFailInvalidStream):
+		return CodeSwapFailInvalidStream
+	default:
+		return CodeSwapFail
+	}
+}
+
 // calculate the number of assets sent to the address (includes liquidity fee)
 func calcAssetEmission(X, x, Y cosmos.Uint) cosmos.Uint {
 	// ( x * X * Y ) / ( x + X )^2
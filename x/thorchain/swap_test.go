@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/blang/semver"
+	se "github.com/cosmos/cosmos-sdk/types/errors"
 	. "gopkg.in/check.v1"
 
 	"gitlab.com/thorchain/thornode/common"
@@ -60,6 +61,8 @@ func (k *TestSwapKeeper) GetStaker(ctx cosmos.Context, asset common.Asset, addr
 		AssetAddress: addr,
 		Units:        cosmos.NewUint(100),
 		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}, nil
 }
 
@@ -92,6 +95,8 @@ func (s *SwapSuite) TestSwap(c *C) {
 		destination   common.Address
 		returnAmount  cosmos.Uint
 		tradeTarget   cosmos.Uint
+		maxSlip       uint64
+		maxFee        uint64
 		expectedErr   error
 		events        int
 	}{
@@ -236,6 +241,59 @@ func (s *SwapSuite) TestSwap(c *C) {
 			expectedErr:   nil,
 			events:        1,
 		},
+		{
+			name:          "swap-over-slip-limit",
+			requestTxHash: "hash",
+			source:        common.RuneAsset(),
+			target:        common.BNBAsset,
+			amount:        cosmos.NewUint(5 * common.One),
+			requester:     "tester",
+			destination:   "don'tknow",
+			returnAmount:  cosmos.ZeroUint(),
+			tradeTarget:   cosmos.ZeroUint(),
+			maxSlip:       1000,
+			expectedErr:   errors.New("fail swap, trade slip over limit: trade slip 1025 more than slip limit 1000"),
+		},
+		{
+			name:          "swap-within-slip-limit",
+			requestTxHash: "hash",
+			source:        common.RuneAsset(),
+			target:        common.BNBAsset,
+			amount:        cosmos.NewUint(5 * common.One),
+			requester:     "tester",
+			destination:   "don'tknow",
+			returnAmount:  cosmos.NewUint(453514739),
+			tradeTarget:   cosmos.ZeroUint(),
+			maxSlip:       1025,
+			expectedErr:   nil,
+			events:        1,
+		},
+		{
+			name:          "swap-over-liquidity-fee-limit",
+			requestTxHash: "hash",
+			source:        common.RuneAsset(),
+			target:        common.BNBAsset,
+			amount:        cosmos.NewUint(5 * common.One),
+			requester:     "tester",
+			destination:   "don'tknow",
+			returnAmount:  cosmos.ZeroUint(),
+			tradeTarget:   cosmos.ZeroUint(),
+			maxFee:        common.One / 10,
+			expectedErr:   errors.New("fail swap, liquidity fee over limit: liquidity fee 22675736 more than liquidity fee limit 10000000"),
+		},
+		{
+			name:          "double-swap-over-combined-slip-limit",
+			requestTxHash: "hash",
+			source:        common.Asset{Chain: common.BTCChain, Ticker: "BTC", Symbol: "BTC"},
+			target:        common.BNBAsset,
+			amount:        cosmos.NewUint(5 * common.One),
+			requester:     "tester",
+			destination:   "don'tknow",
+			returnAmount:  cosmos.ZeroUint(),
+			tradeTarget:   cosmos.ZeroUint(),
+			maxSlip:       1500,
+			expectedErr:   errors.New("fail swap, trade slip over limit: trade slip 1953 more than slip limit 1500"),
+		},
 		{
 			name:          "double-swap",
 			requestTxHash: "hash",
@@ -264,7 +322,7 @@ func (s *SwapSuite) TestSwap(c *C) {
 			"",
 		)
 		tx.Chain = common.BNBChain
-		amount, evts, err := swap(ctx, poolStorage, tx, item.target, item.destination, item.tradeTarget, cosmos.NewUint(1000_000))
+		amount, evts, err := swap(ctx, poolStorage, tx, item.target, item.destination, item.tradeTarget, cosmos.NewUint(item.maxSlip), cosmos.NewUint(item.maxFee), cosmos.NewUint(1000_000))
 		if item.expectedErr == nil {
 			c.Assert(err, IsNil)
 			c.Assert(evts, HasLen, item.events)
@@ -398,3 +456,10 @@ func (s SwapSuite) TestCalculators(c *C) {
 	c.Check(calcLiquidityFee(X, x, Y).Uint64(), Equals, uint64(82644628))
 	c.Check(calcTradeSlip(X, x).Uint64(), Equals, uint64(2100))
 }
+
+func (s *SwapSuite) TestGetSwapRefundCode(c *C) {
+	c.Check(getSwapRefundCode(errors.New("emit asset 1 less than price limit 2")), Equals, CodeSwapFail)
+	c.Check(getSwapRefundCode(errSwapFailNotEnoughFee), Equals, CodeSwapFail)
+	c.Check(getSwapRefundCode(se.Wrapf(errSwapFailSlipLimit, "slip")), Equals, CodeSwapFailSlipLimit)
+	c.Check(getSwapRefundCode(se.Wrapf(errSwapFailLiquidityFeeLimit, "fee")), Equals, CodeSwapFailLiquidityFeeLimit)
+}
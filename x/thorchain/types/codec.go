@@ -39,4 +39,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMigrate{}, "thorchain/MsgMigrate", nil)
 	cdc.RegisterConcrete(MsgRagnarok{}, "thorchain/MsgRagnarok", nil)
 	cdc.RegisterConcrete(MsgRefundTx{}, "thorchain/MsgRefundTx", nil)
+	cdc.RegisterConcrete(MsgLimitOrder{}, "thorchain/MsgLimitOrder", nil)
+	cdc.RegisterConcrete(MsgCancelLimitOrder{}, "thorchain/MsgCancelLimitOrder", nil)
+	cdc.RegisterConcrete(MsgSolvency{}, "thorchain/MsgSolvency", nil)
+	cdc.RegisterConcrete(MsgMaintenance{}, "thorchain/MsgMaintenance", nil)
 }
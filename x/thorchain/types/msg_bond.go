@@ -6,22 +6,27 @@ import (
 )
 
 // MsgBond when a user would like to become a validator, and run a full set, they need send an `apply:bepaddress` with a bond to our pool address
+// the node operator can also whitelist a bond provider with BondProviderAddress , and set the node operator fee(basis points) with NodeOperatorFee
+// NodeOperatorFee is -1 when it is not set
 type MsgBond struct {
-	TxIn        common.Tx         `json:"tx_in"`
-	NodeAddress cosmos.AccAddress `json:"node_address"`
-	Bond        cosmos.Uint       `json:"bond"`
-	BondAddress common.Address    `json:"bond_address"`
-	Signer      cosmos.AccAddress `json:"signer"`
+	TxIn                common.Tx         `json:"tx_in"`
+	NodeAddress         cosmos.AccAddress `json:"node_address"`
+	Bond                cosmos.Uint       `json:"bond"`
+	BondAddress         common.Address    `json:"bond_address"`
+	BondProviderAddress common.Address    `json:"bond_provider_address"`
+	NodeOperatorFee     int64             `json:"node_operator_fee"`
+	Signer              cosmos.AccAddress `json:"signer"`
 }
 
 // NewMsgBond create new MsgBond message
 func NewMsgBond(txin common.Tx, nodeAddr cosmos.AccAddress, bond cosmos.Uint, bondAddress common.Address, signer cosmos.AccAddress) MsgBond {
 	return MsgBond{
-		TxIn:        txin,
-		NodeAddress: nodeAddr,
-		Bond:        bond,
-		BondAddress: bondAddress,
-		Signer:      signer,
+		TxIn:            txin,
+		NodeAddress:     nodeAddr,
+		Bond:            bond,
+		BondAddress:     bondAddress,
+		NodeOperatorFee: -1,
+		Signer:          signer,
 	}
 }
 
@@ -42,6 +47,9 @@ func (msg MsgBond) ValidateBasic() error {
 	if msg.BondAddress.IsEmpty() {
 		return cosmos.ErrInvalidAddress("bond address cannot be empty")
 	}
+	if msg.NodeOperatorFee < -1 || msg.NodeOperatorFee > MaxUnstakeBasisPoints {
+		return cosmos.ErrUnknownRequest("node operator fee must be between 0 and 10000")
+	}
 	if err := msg.TxIn.Valid(); err != nil {
 		return cosmos.ErrUnknownRequest(err.Error())
 	}
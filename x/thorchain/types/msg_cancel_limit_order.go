@@ -0,0 +1,52 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// MsgCancelLimitOrder defines a MsgCancelLimitOrder message , it cancel a limit order placed by the same sender
+type MsgCancelLimitOrder struct {
+	Tx        common.Tx         `json:"tx"`
+	OrderTxID common.TxID       `json:"order_tx_id"` // the tx id of the limit order to cancel
+	Signer    cosmos.AccAddress `json:"signer"`
+}
+
+// NewMsgCancelLimitOrder is a constructor function for MsgCancelLimitOrder
+func NewMsgCancelLimitOrder(tx common.Tx, orderTxID common.TxID, signer cosmos.AccAddress) MsgCancelLimitOrder {
+	return MsgCancelLimitOrder{
+		Tx:        tx,
+		OrderTxID: orderTxID,
+		Signer:    signer,
+	}
+}
+
+// Route should return the route key of the module
+func (msg MsgCancelLimitOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelLimitOrder) Type() string { return "cancel_limit_order" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelLimitOrder) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(msg.Signer.String())
+	}
+	if err := msg.Tx.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	if msg.OrderTxID.IsEmpty() {
+		return cosmos.ErrUnknownRequest("limit order tx id cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelLimitOrder) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelLimitOrder) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{msg.Signer}
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type MsgCancelLimitOrderSuite struct{}
+
+var _ = Suite(&MsgCancelLimitOrderSuite{})
+
+func (MsgCancelLimitOrderSuite) TestMsgCancelLimitOrder(c *C) {
+	addr := GetRandomBech32Addr()
+	tx := GetRandomTx()
+	orderTxID := GetRandomTxHash()
+	m := NewMsgCancelLimitOrder(tx, orderTxID, addr)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "cancel_limit_order")
+
+	c.Check(NewMsgCancelLimitOrder(tx, orderTxID, cosmos.AccAddress{}).ValidateBasic(), NotNil)
+	c.Check(NewMsgCancelLimitOrder(common.Tx{}, orderTxID, addr).ValidateBasic(), NotNil)
+	c.Check(NewMsgCancelLimitOrder(tx, common.TxID(""), addr).ValidateBasic(), NotNil)
+}
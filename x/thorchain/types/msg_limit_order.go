@@ -0,0 +1,80 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// MaxLimitOrderMinOutBits is the most bits the min out of a limit order can take , so its rate , the min out scaled by
+// common.One twice , always fit in 256 bits
+const MaxLimitOrderMinOutBits = 192
+
+// MsgLimitOrder defines a MsgLimitOrder message , it place a limit order which is kept until it can be filled or expired
+type MsgLimitOrder struct {
+	Tx           common.Tx         `json:"tx"`
+	TargetAsset  common.Asset      `json:"target_asset"`
+	Destination  common.Address    `json:"destination"`
+	MinOut       cosmos.Uint       `json:"min_out"`       // minimum amount of target asset to emit
+	ExpiryHeight int64             `json:"expiry_height"` // the block height the order will be refunded if it is not filled
+	Signer       cosmos.AccAddress `json:"signer"`
+}
+
+// NewMsgLimitOrder is a constructor function for MsgLimitOrder
+func NewMsgLimitOrder(tx common.Tx, target common.Asset, destination common.Address, minOut cosmos.Uint, expiryHeight int64, signer cosmos.AccAddress) MsgLimitOrder {
+	return MsgLimitOrder{
+		Tx:           tx,
+		TargetAsset:  target,
+		Destination:  destination,
+		MinOut:       minOut,
+		ExpiryHeight: expiryHeight,
+		Signer:       signer,
+	}
+}
+
+// Route should return the route key of the module
+func (msg MsgLimitOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgLimitOrder) Type() string { return "limit_order" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgLimitOrder) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(msg.Signer.String())
+	}
+	if err := msg.Tx.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	if msg.TargetAsset.IsEmpty() {
+		return cosmos.ErrUnknownRequest("limit order target cannot be empty")
+	}
+	if len(msg.Tx.Coins) != 1 {
+		return cosmos.ErrUnknownRequest("expecting exactly one coin in a limit order")
+	}
+	if msg.Tx.Coins[0].Asset.Equals(msg.TargetAsset) {
+		return cosmos.ErrUnknownRequest("limit order source and target cannot be the same")
+	}
+	if msg.Destination.IsEmpty() {
+		return cosmos.ErrUnknownRequest("limit order destination cannot be empty")
+	}
+	if msg.MinOut.IsZero() {
+		return cosmos.ErrUnknownRequest("limit order min out cannot be zero")
+	}
+	if msg.MinOut.BigInt().BitLen() > MaxLimitOrderMinOutBits {
+		return cosmos.ErrUnknownRequest("limit order min out is too large")
+	}
+	if msg.ExpiryHeight <= 0 {
+		return cosmos.ErrUnknownRequest("limit order expiry height must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgLimitOrder) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgLimitOrder) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{msg.Signer}
+}
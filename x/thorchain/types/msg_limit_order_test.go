@@ -0,0 +1,37 @@
+package types
+
+import (
+	"math/big"
+
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type MsgLimitOrderSuite struct{}
+
+var _ = Suite(&MsgLimitOrderSuite{})
+
+func (MsgLimitOrderSuite) TestMsgLimitOrder(c *C) {
+	addr := GetRandomBech32Addr()
+	bnbAddress := GetRandomBNBAddress()
+	tx := GetRandomTx()
+	m := NewMsgLimitOrder(tx, common.RuneAsset(), bnbAddress, cosmos.NewUint(100), 20, addr)
+	EnsureMsgBasicCorrect(m, c)
+	c.Check(m.Type(), Equals, "limit_order")
+
+	inputs := []MsgLimitOrder{
+		NewMsgLimitOrder(tx, common.RuneAsset(), bnbAddress, cosmos.NewUint(100), 20, cosmos.AccAddress{}),
+		NewMsgLimitOrder(common.Tx{}, common.RuneAsset(), bnbAddress, cosmos.NewUint(100), 20, addr),
+		NewMsgLimitOrder(tx, common.Asset{}, bnbAddress, cosmos.NewUint(100), 20, addr),
+		NewMsgLimitOrder(tx, common.BNBAsset, bnbAddress, cosmos.NewUint(100), 20, addr),
+		NewMsgLimitOrder(tx, common.RuneAsset(), common.NoAddress, cosmos.NewUint(100), 20, addr),
+		NewMsgLimitOrder(tx, common.RuneAsset(), bnbAddress, cosmos.ZeroUint(), 20, addr),
+		NewMsgLimitOrder(tx, common.RuneAsset(), bnbAddress, cosmos.NewUint(100), 0, addr),
+		NewMsgLimitOrder(tx, common.RuneAsset(), bnbAddress, cosmos.NewUintFromBigInt(new(big.Int).Lsh(big.NewInt(1), MaxLimitOrderMinOutBits)), 20, addr),
+	}
+	for i, item := range inputs {
+		c.Check(item.ValidateBasic(), NotNil, Commentf("%d", i))
+	}
+}
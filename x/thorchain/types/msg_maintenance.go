@@ -0,0 +1,47 @@
+package types
+
+import (
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// MsgMaintenance defines a MsgMaintenance message , a node account ask to be in maintenance for the given number of blocks
+// zero blocks end the maintenance of the node account
+type MsgMaintenance struct {
+	Blocks int64             `json:"blocks"`
+	Signer cosmos.AccAddress `json:"signer"`
+}
+
+// NewMsgMaintenance is a constructor function for NewMsgMaintenance
+func NewMsgMaintenance(blocks int64, signer cosmos.AccAddress) MsgMaintenance {
+	return MsgMaintenance{
+		Blocks: blocks,
+		Signer: signer,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMaintenance) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMaintenance) Type() string { return "maintenance" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMaintenance) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(msg.Signer.String())
+	}
+	if msg.Blocks < 0 {
+		return cosmos.ErrUnknownRequest("maintenance blocks cannot be negative")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMaintenance) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMaintenance) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{msg.Signer}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"errors"
+
+	se "github.com/cosmos/cosmos-sdk/types/errors"
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type MsgMaintenanceSuite struct{}
+
+var _ = Suite(&MsgMaintenanceSuite{})
+
+func (MsgMaintenanceSuite) TestMsgMaintenance(c *C) {
+	acc := GetRandomBech32Addr()
+	msg := NewMsgMaintenance(100, acc)
+	c.Assert(msg.Route(), Equals, RouterKey)
+	c.Assert(msg.Type(), Equals, "maintenance")
+	c.Assert(msg.ValidateBasic(), IsNil)
+	c.Assert(len(msg.GetSignBytes()) > 0, Equals, true)
+	c.Assert(msg.GetSigners()[0].String(), Equals, acc.String())
+	c.Assert(NewMsgMaintenance(0, acc).ValidateBasic(), IsNil)
+	c.Assert(NewMsgMaintenance(-1, acc).ValidateBasic(), NotNil)
+
+	err := NewMsgMaintenance(100, cosmos.AccAddress{}).ValidateBasic()
+	c.Assert(err, NotNil)
+	c.Assert(errors.Is(err, se.ErrInvalidAddress), Equals, true)
+}
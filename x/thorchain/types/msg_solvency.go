@@ -0,0 +1,67 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// MsgSolvency is the message bifrost use to report the balance of a vault it observed on an external chain to THORNode
+type MsgSolvency struct {
+	Chain  common.Chain      `json:"chain"`
+	PubKey common.PubKey     `json:"pub_key"`
+	Coins  common.Coins      `json:"coins"`
+	Height int64             `json:"height"`
+	Signer cosmos.AccAddress `json:"signer"`
+}
+
+// NewMsgSolvency create a new instance of MsgSolvency
+func NewMsgSolvency(chain common.Chain, pubKey common.PubKey, coins common.Coins, height int64, signer cosmos.AccAddress) MsgSolvency {
+	return MsgSolvency{
+		Chain:  chain,
+		PubKey: pubKey,
+		Coins:  coins,
+		Height: height,
+		Signer: signer,
+	}
+}
+
+// Route should return the Route of the module
+func (msg MsgSolvency) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSolvency) Type() string { return "set_solvency" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSolvency) ValidateBasic() error {
+	if msg.Height <= 0 {
+		return cosmos.ErrUnknownRequest("block height must be positive")
+	}
+	if msg.Signer.Empty() {
+		return cosmos.ErrInvalidAddress(msg.Signer.String())
+	}
+	if msg.Chain.IsEmpty() {
+		return cosmos.ErrUnknownRequest("chain can't be empty")
+	}
+	if msg.PubKey.IsEmpty() {
+		return cosmos.ErrUnknownRequest("pubkey can't be empty")
+	}
+	if err := msg.Coins.Valid(); err != nil {
+		return cosmos.ErrUnknownRequest(err.Error())
+	}
+	for _, coin := range msg.Coins {
+		if !coin.Asset.Chain.Equals(msg.Chain) {
+			return cosmos.ErrUnknownRequest("coin is not on the reported chain")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSolvency) GetSignBytes() []byte {
+	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSolvency) GetSigners() []cosmos.AccAddress {
+	return []cosmos.AccAddress{msg.Signer}
+}
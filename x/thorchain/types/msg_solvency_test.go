@@ -0,0 +1,102 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	cosmos "gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type MsgSolvencySuite struct{}
+
+var _ = Suite(&MsgSolvencySuite{})
+
+func (MsgSolvencySuite) TestMsgSolvency(c *C) {
+	coins := common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One))}
+	msg := NewMsgSolvency(common.BNBChain, GetRandomPubKey(), coins, 1024, GetRandomBech32Addr())
+	c.Assert(msg.Type(), Equals, "set_solvency")
+	EnsureMsgBasicCorrect(msg, c)
+
+	testCases := []struct {
+		name      string
+		chain     common.Chain
+		pubKey    common.PubKey
+		coins     common.Coins
+		height    int64
+		signer    cosmos.AccAddress
+		expectErr bool
+	}{
+		{
+			name:      "empty chain should return error",
+			chain:     common.EmptyChain,
+			pubKey:    GetRandomPubKey(),
+			coins:     coins,
+			height:    1024,
+			signer:    GetRandomBech32Addr(),
+			expectErr: true,
+		},
+		{
+			name:      "empty pubkey should return error",
+			chain:     common.BNBChain,
+			pubKey:    common.EmptyPubKey,
+			coins:     coins,
+			height:    1024,
+			signer:    GetRandomBech32Addr(),
+			expectErr: true,
+		},
+		{
+			name:      "coin on another chain should return error",
+			chain:     common.BTCChain,
+			pubKey:    GetRandomPubKey(),
+			coins:     coins,
+			height:    1024,
+			signer:    GetRandomBech32Addr(),
+			expectErr: true,
+		},
+		{
+			name:      "zero coin should return error",
+			chain:     common.BNBChain,
+			pubKey:    GetRandomPubKey(),
+			coins:     common.Coins{common.NewCoin(common.BNBAsset, cosmos.ZeroUint())},
+			height:    1024,
+			signer:    GetRandomBech32Addr(),
+			expectErr: true,
+		},
+		{
+			name:      "zero block height should return error",
+			chain:     common.BNBChain,
+			pubKey:    GetRandomPubKey(),
+			coins:     coins,
+			height:    0,
+			signer:    GetRandomBech32Addr(),
+			expectErr: true,
+		},
+		{
+			name:      "empty signer should return error",
+			chain:     common.BNBChain,
+			pubKey:    GetRandomPubKey(),
+			coins:     coins,
+			height:    1024,
+			signer:    cosmos.AccAddress(""),
+			expectErr: true,
+		},
+		{
+			name:      "empty vault is fine",
+			chain:     common.BNBChain,
+			pubKey:    GetRandomPubKey(),
+			coins:     common.Coins{},
+			height:    1024,
+			signer:    GetRandomBech32Addr(),
+			expectErr: false,
+		},
+	}
+	for _, tc := range testCases {
+		msg := NewMsgSolvency(tc.chain, tc.pubKey, tc.coins, tc.height, tc.signer)
+		err := msg.ValidateBasic()
+		if tc.expectErr {
+			c.Assert(err, NotNil, Commentf("name:%s", tc.name))
+		} else {
+			c.Assert(err, IsNil, Commentf("name:%s", tc.name))
+		}
+	}
+}
@@ -14,18 +14,23 @@ type MsgStake struct {
 	RuneAddress  common.Address    `json:"rune_address"`  // staker's rune address
 	AssetAddress common.Address    `json:"asset_address"` // staker's asset address
 	Signer       cosmos.AccAddress `json:"signer"`
+	// AffiliateAddress is the RUNE address the affiliate fee will be paid to
+	AffiliateAddress common.Address `json:"affiliate_address"`
+	// AffiliateBasisPoints is the affiliate fee in basis points of the staked amount, zero means no affiliate fee
+	AffiliateBasisPoints cosmos.Uint `json:"affiliate_basis_points"`
 }
 
 // NewMsgStake is a constructor function for MsgStake
 func NewMsgStake(tx common.Tx, asset common.Asset, r, amount cosmos.Uint, runeAddr, assetAddr common.Address, signer cosmos.AccAddress) MsgStake {
 	return MsgStake{
-		Tx:           tx,
-		Asset:        asset,
-		AssetAmount:  amount,
-		RuneAmount:   r,
-		RuneAddress:  runeAddr,
-		AssetAddress: assetAddr,
-		Signer:       signer,
+		Tx:                   tx,
+		Asset:                asset,
+		AssetAmount:          amount,
+		RuneAmount:           r,
+		RuneAddress:          runeAddr,
+		AssetAddress:         assetAddr,
+		Signer:               signer,
+		AffiliateBasisPoints: cosmos.ZeroUint(),
 	}
 }
 
@@ -71,9 +76,17 @@ func (msg MsgStake) ValidateBasic() error {
 			return cosmos.ErrUnknownRequest("asset address cannot be empty")
 		}
 	}
+	if err := validateAffiliate(msg.AffiliateAddress, msg.AffiliateBasisPoints); err != nil {
+		return err
+	}
 	return nil
 }
 
+// HasAffiliateFee return true when the stake need to pay an affiliate fee
+func (msg MsgStake) HasAffiliateFee() bool {
+	return !msg.AffiliateAddress.IsEmpty() && !msg.AffiliateBasisPoints.IsZero()
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgStake) GetSignBytes() []byte {
 	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -31,6 +31,14 @@ func (MsgStakeSuite) TestMsgStake(c *C) {
 	m := NewMsgStake(tx, common.BNBAsset, cosmos.NewUint(100000000), cosmos.NewUint(100000000), runeAddress, assetAddress, addr)
 	EnsureMsgBasicCorrect(m, c)
 	c.Check(m.Type(), Equals, "stake")
+	c.Check(m.HasAffiliateFee(), Equals, false)
+	m.AffiliateBasisPoints = cosmos.NewUint(100)
+	c.Check(m.ValidateBasic(), NotNil)
+	m.AffiliateAddress = GetRandomRUNEAddress()
+	c.Check(m.ValidateBasic(), IsNil)
+	c.Check(m.HasAffiliateFee(), Equals, true)
+	m.AffiliateBasisPoints = cosmos.NewUint(10001)
+	c.Check(m.ValidateBasic(), NotNil)
 
 	inputs := []struct {
 		asset     common.Asset
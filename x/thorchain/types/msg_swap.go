@@ -12,19 +12,57 @@ type MsgSwap struct {
 	Destination common.Address    `json:"destination"`  // destination , used for swap and send , the destination address THORNode send it to
 	TradeTarget cosmos.Uint       `json:"trade_target"`
 	Signer      cosmos.AccAddress `json:"signer"`
+	// MaxSlip is the maximum trade slip in basis points, zero means no limit
+	MaxSlip cosmos.Uint `json:"max_slip"`
+	// MaxLiquidityFee is the maximum liquidity fee in RUNE, zero means no limit
+	MaxLiquidityFee cosmos.Uint `json:"max_liquidity_fee"`
+	// StreamInterval is the number of blocks between each sub-swap of a streaming swap
+	StreamInterval int64 `json:"stream_interval"`
+	// StreamQuantity is the number of sub-swaps of a streaming swap, zero or one means the swap is done in one go
+	StreamQuantity int64 `json:"stream_quantity"`
+	// AffiliateAddress is the RUNE address the affiliate fee will be paid to
+	AffiliateAddress common.Address `json:"affiliate_address"`
+	// AffiliateBasisPoints is the affiliate fee in basis points of the inbound amount, zero means no affiliate fee
+	AffiliateBasisPoints cosmos.Uint `json:"affiliate_basis_points"`
 }
 
 // NewMsgSwap is a constructor function for MsgSwap
 func NewMsgSwap(tx common.Tx, target common.Asset, destination common.Address, tradeTarget cosmos.Uint, signer cosmos.AccAddress) MsgSwap {
 	return MsgSwap{
-		Tx:          tx,
-		TargetAsset: target,
-		Destination: destination,
-		TradeTarget: tradeTarget,
-		Signer:      signer,
+		Tx:                   tx,
+		TargetAsset:          target,
+		Destination:          destination,
+		TradeTarget:          tradeTarget,
+		Signer:               signer,
+		MaxSlip:              cosmos.ZeroUint(),
+		MaxLiquidityFee:      cosmos.ZeroUint(),
+		AffiliateBasisPoints: cosmos.ZeroUint(),
 	}
 }
 
+// IsStreaming return true when the swap should be split into multiple sub-swaps
+func (msg MsgSwap) IsStreaming() bool {
+	return msg.StreamQuantity > 1
+}
+
+// WithDefaults return the swap with any missing limit or affiliate fee set to zero , swaps saved in the queue before those
+// fields existed are decoded without them
+func (msg MsgSwap) WithDefaults() MsgSwap {
+	if msg.TradeTarget == (cosmos.Uint{}) {
+		msg.TradeTarget = cosmos.ZeroUint()
+	}
+	if msg.MaxSlip == (cosmos.Uint{}) {
+		msg.MaxSlip = cosmos.ZeroUint()
+	}
+	if msg.MaxLiquidityFee == (cosmos.Uint{}) {
+		msg.MaxLiquidityFee = cosmos.ZeroUint()
+	}
+	if msg.AffiliateBasisPoints == (cosmos.Uint{}) {
+		msg.AffiliateBasisPoints = cosmos.ZeroUint()
+	}
+	return msg
+}
+
 // Route should return the route key of the module
 func (msg MsgSwap) Route() string { return RouterKey }
 
@@ -56,9 +94,23 @@ func (msg MsgSwap) ValidateBasic() error {
 	if msg.Destination.IsEmpty() {
 		return cosmos.ErrUnknownRequest("swap Destination cannot be empty")
 	}
+	if msg.StreamInterval < 0 || msg.StreamQuantity < 0 {
+		return cosmos.ErrUnknownRequest("swap stream interval and quantity cannot be negative")
+	}
+	if msg.IsStreaming() && msg.Tx.Coins[0].Amount.LT(cosmos.NewUint(uint64(msg.StreamQuantity))) {
+		return cosmos.ErrUnknownRequest("swap stream quantity cannot be more than the swap amount")
+	}
+	if err := validateAffiliate(msg.AffiliateAddress, msg.AffiliateBasisPoints); err != nil {
+		return err
+	}
 	return nil
 }
 
+// HasAffiliateFee return true when the swap need to pay an affiliate fee
+func (msg MsgSwap) HasAffiliateFee() bool {
+	return !msg.AffiliateAddress.IsEmpty() && !msg.AffiliateBasisPoints.IsZero()
+}
+
 // GetSignBytes encodes the message for signing
 func (msg MsgSwap) GetSignBytes() []byte {
 	return cosmos.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -68,3 +120,17 @@ func (msg MsgSwap) GetSignBytes() []byte {
 func (msg MsgSwap) GetSigners() []cosmos.AccAddress {
 	return []cosmos.AccAddress{msg.Signer}
 }
+
+// validateAffiliate make sure the affiliate fee doesn't exceed 100% and the affiliate address is a RUNE address
+func validateAffiliate(affiliate common.Address, basisPoints cosmos.Uint) error {
+	if basisPoints.GT(cosmos.NewUint(10000)) {
+		return cosmos.ErrUnknownRequest("affiliate fee basis points cannot be more than 10000")
+	}
+	if !basisPoints.IsZero() && affiliate.IsEmpty() {
+		return cosmos.ErrUnknownRequest("affiliate address cannot be empty when affiliate fee is set")
+	}
+	if !affiliate.IsEmpty() && !affiliate.IsChain(common.RuneAsset().Chain) {
+		return cosmos.ErrUnknownRequest("affiliate address must be a RUNE address")
+	}
+	return nil
+}
@@ -11,6 +11,17 @@ type MsgSwapSuite struct{}
 
 var _ = Suite(&MsgSwapSuite{})
 
+func (MsgSwapSuite) TestMsgSwapWithDefaults(c *C) {
+	msg := MsgSwap{Tx: GetRandomTx()}.WithDefaults()
+	c.Check(msg.TradeTarget.IsZero(), Equals, true)
+	c.Check(msg.MaxSlip.IsZero(), Equals, true)
+	c.Check(msg.MaxLiquidityFee.IsZero(), Equals, true)
+	c.Check(msg.AffiliateBasisPoints.IsZero(), Equals, true)
+
+	msg.MaxSlip = cosmos.NewUint(100)
+	c.Check(msg.WithDefaults().MaxSlip.Equal(cosmos.NewUint(100)), Equals, true)
+}
+
 func (MsgSwapSuite) TestMsgSwap(c *C) {
 	addr := GetRandomBech32Addr()
 	c.Check(addr.Empty(), Equals, false)
@@ -32,6 +43,35 @@ func (MsgSwapSuite) TestMsgSwap(c *C) {
 	m := NewMsgSwap(tx, common.BNBAsset, bnbAddress, cosmos.NewUint(200000000), addr)
 	EnsureMsgBasicCorrect(m, c)
 	c.Check(m.Type(), Equals, "swap")
+	c.Check(m.IsStreaming(), Equals, false)
+
+	// streaming swap
+	streamTx := tx
+	streamTx.Coins = common.Coins{common.NewCoin(common.BTCAsset, cosmos.NewUint(2))}
+	stream := NewMsgSwap(streamTx, common.BNBAsset, bnbAddress, cosmos.NewUint(200000000), addr)
+	stream.StreamInterval = 1
+	stream.StreamQuantity = 2
+	c.Check(stream.IsStreaming(), Equals, true)
+	c.Check(stream.ValidateBasic(), IsNil)
+	stream.StreamQuantity = 3
+	c.Check(stream.ValidateBasic(), NotNil)
+	stream.StreamQuantity = 2
+	stream.StreamInterval = -1
+	c.Check(stream.ValidateBasic(), NotNil)
+
+	// affiliate fee
+	affiliate := NewMsgSwap(tx, common.BNBAsset, bnbAddress, cosmos.NewUint(200000000), addr)
+	c.Check(affiliate.HasAffiliateFee(), Equals, false)
+	affiliate.AffiliateBasisPoints = cosmos.NewUint(100)
+	c.Check(affiliate.ValidateBasic(), NotNil)
+	affiliate.AffiliateAddress = GetRandomRUNEAddress()
+	c.Check(affiliate.ValidateBasic(), IsNil)
+	c.Check(affiliate.HasAffiliateFee(), Equals, true)
+	affiliate.AffiliateBasisPoints = cosmos.NewUint(10001)
+	c.Check(affiliate.ValidateBasic(), NotNil)
+	affiliate.AffiliateBasisPoints = cosmos.NewUint(100)
+	affiliate.AffiliateAddress = GetRandomBTCAddress()
+	c.Check(affiliate.ValidateBasic(), NotNil)
 
 	inputs := []struct {
 		requestTxHash common.TxID
@@ -6,12 +6,14 @@ import (
 )
 
 // MsgUnBond when a user would like to remove some bond
+// the node operator can unbond on behalf of a bond provider with BondProviderAddress
 type MsgUnBond struct {
-	TxIn        common.Tx         `json:"tx_in"`
-	NodeAddress cosmos.AccAddress `json:"node_address"`
-	Amount      cosmos.Uint       `json:"amount"`
-	BondAddress common.Address    `json:"bond_address"`
-	Signer      cosmos.AccAddress `json:"signer"`
+	TxIn                common.Tx         `json:"tx_in"`
+	NodeAddress         cosmos.AccAddress `json:"node_address"`
+	Amount              cosmos.Uint       `json:"amount"`
+	BondAddress         common.Address    `json:"bond_address"`
+	BondProviderAddress common.Address    `json:"bond_provider_address"`
+	Signer              cosmos.AccAddress `json:"signer"`
 }
 
 // NewMsgUnBond create new MsgUnBond message
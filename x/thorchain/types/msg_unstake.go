@@ -16,6 +16,7 @@ type MsgUnStake struct {
 	RuneAddress        common.Address    `json:"rune_address"`          // it should be the rune address
 	UnstakeBasisPoints cosmos.Uint       `json:"withdraw_basis_points"` // withdraw basis points
 	Asset              common.Asset      `json:"asset"`                 // asset asset asset
+	TargetAsset        common.Asset      `json:"target_asset"`          // optional , withdraw everything to either RUNE or the pool asset
 	Signer             cosmos.AccAddress `json:"signer"`
 }
 
@@ -59,6 +60,9 @@ func (msg MsgUnStake) ValidateBasic() error {
 	if msg.UnstakeBasisPoints.GT(cosmos.NewUint(MaxUnstakeBasisPoints)) {
 		return cosmos.ErrUnknownRequest("UnstakeBasisPoints is larger than maximum withdraw basis points")
 	}
+	if !msg.TargetAsset.IsEmpty() && !msg.TargetAsset.IsRune() && !msg.TargetAsset.Equals(msg.Asset) {
+		return cosmos.ErrUnknownRequest("TargetAsset must be either RUNE or the pool asset")
+	}
 	return nil
 }
 
@@ -28,6 +28,12 @@ func (MsgUnstakeSuite) TestMsgUnstake(c *C) {
 	m := NewMsgUnStake(tx, runeAddr, cosmos.NewUint(10000), common.BNBAsset, acc1)
 	EnsureMsgBasicCorrect(m, c)
 	c.Check(m.Type(), Equals, "unstake")
+	m.TargetAsset = common.RuneAsset()
+	EnsureMsgBasicCorrect(m, c)
+	m.TargetAsset = common.BNBAsset
+	EnsureMsgBasicCorrect(m, c)
+	m.TargetAsset = common.BTCAsset
+	c.Check(m.ValidateBasic(), NotNil)
 
 	inputs := []struct {
 		tx                  common.Tx
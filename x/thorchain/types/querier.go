@@ -50,6 +50,43 @@ func (n QueryNodeAccountPreflightCheck) String() string {
 	return sb.String()
 }
 
+// QueryNodeSlashes is structure to hold the slash points of a node account , and the slash records explain how it get there
+type QueryNodeSlashes struct {
+	NodeAddress cosmos.AccAddress `json:"node_address"`
+	SlashPoints int64             `json:"slash_points"`
+	Records     []SlashRecord     `json:"records"`
+}
+
+// QueryNodeObservation is how well a node account observe a chain over the rolling window , Total is the number of blocks the chain
+// reached consensus on an observation , Missed is the number of those blocks the node account didn't observe it , MissRate is in basis points
+type QueryNodeObservation struct {
+	Chain    common.Chain `json:"chain"`
+	Total    int64        `json:"total"`
+	Missed   int64        `json:"missed"`
+	MissRate int64        `json:"miss_rate"`
+}
+
+// QueryChurnNode is a node account that would be churned in or out of the active node accounts , and why it would be churned out
+type QueryChurnNode struct {
+	NodeAddress cosmos.AccAddress `json:"node_address"`
+	Status      NodeStatus        `json:"status"`
+	Bond        cosmos.Uint       `json:"bond"`
+	Reason      string            `json:"reason,omitempty"`
+}
+
+// QueryChurnPreview is what the next churn would look like if it happened with the current state , Blocked explain why the
+// churn wouldn't happen at all , Rotation is false when the churn wouldn't change the active node accounts
+type QueryChurnPreview struct {
+	Height          int64            `json:"height"`
+	LastChurnHeight int64            `json:"last_churn_height"`
+	NextChurnHeight int64            `json:"next_churn_height"`
+	Blocked         string           `json:"blocked,omitempty"`
+	Rotation        bool             `json:"rotation"`
+	Removed         []QueryChurnNode `json:"removed"`
+	Added           []QueryChurnNode `json:"added"`
+	VaultMembers    int64            `json:"vault_members"`
+}
+
 // QueryKeygenBlock query keygen, displays signed keygen requests
 type QueryKeygenBlock struct {
 	KeygenBlock KeygenBlock `json:"keygen_block"`
@@ -82,23 +119,31 @@ type QueryVersion struct {
 
 // QueryNodeAccount hold all the information related to node account
 type QueryNodeAccount struct {
-	NodeAddress         cosmos.AccAddress `json:"node_address"`
-	Status              NodeStatus        `json:"status"`
-	PubKeySet           common.PubKeySet  `json:"pub_key_set"`
-	ValidatorConsPubKey string            `json:"validator_cons_pub_key"`
-	Bond                cosmos.Uint       `json:"bond"`
-	ActiveBlockHeight   int64             `json:"active_block_height"`
-	BondAddress         common.Address    `json:"bond_address"`
-	StatusSince         int64             `json:"status_since"`
-	SignerMembership    common.PubKeys    `json:"signer_membership"`
-	RequestedToLeave    bool              `json:"requested_to_leave"`
-	ForcedToLeave       bool              `json:"forced_to_leave"`
-	LeaveHeight         int64             `json:"leave_height"`
-	IPAddress           string            `json:"ip_address"`
-	Version             semver.Version    `json:"version"`
-	SlashPoints         int64             `json:"slash_points"`
-	Jail                Jail              `json:"jail"`
-	CurrentAward        cosmos.Uint       `json:"current_award"`
+	NodeAddress         cosmos.AccAddress   `json:"node_address"`
+	Status              NodeStatus          `json:"status"`
+	PubKeySet           common.PubKeySet    `json:"pub_key_set"`
+	ValidatorConsPubKey string              `json:"validator_cons_pub_key"`
+	Bond                cosmos.Uint         `json:"bond"`
+	ActiveBlockHeight   int64               `json:"active_block_height"`
+	BondAddress         common.Address      `json:"bond_address"`
+	StatusSince         int64               `json:"status_since"`
+	SignerMembership    common.PubKeys      `json:"signer_membership"`
+	RequestedToLeave    bool                `json:"requested_to_leave"`
+	ForcedToLeave       bool                `json:"forced_to_leave"`
+	LeaveHeight         int64               `json:"leave_height"`
+	IPAddress           string              `json:"ip_address"`
+	Version             semver.Version      `json:"version"`
+	SlashPoints         int64               `json:"slash_points"`
+	Jail                Jail                `json:"jail"`
+	CurrentAward        cosmos.Uint         `json:"current_award"`
+	NodeOperatorFee     int64               `json:"node_operator_fee"`
+	BondProviders       []QueryBondProvider `json:"bond_providers"`
+}
+
+// QueryBondProvider is a bond provider of a node account , Bond is its share of the bond of the node account
+type QueryBondProvider struct {
+	BondAddress common.Address `json:"bond_address"`
+	Bond        cosmos.Uint    `json:"bond"`
 }
 
 // NewQueryNodeAccount create a new QueryNodeAccount based on the given node account parameter
@@ -120,3 +165,161 @@ func NewQueryNodeAccount(na NodeAccount) QueryNodeAccount {
 		Version:             na.Version,
 	}
 }
+
+// QuerySwapQuoteLeg is a single pool swap within a swap quote, a double swap has two legs
+type QuerySwapQuoteLeg struct {
+	Pool               common.Asset `json:"pool"`
+	EmitAmount         cosmos.Uint  `json:"emit_amount"`
+	TradeSlip          cosmos.Uint  `json:"trade_slip"`
+	LiquidityFee       cosmos.Uint  `json:"liquidity_fee"`
+	LiquidityFeeInRune cosmos.Uint  `json:"liquidity_fee_in_rune"`
+}
+
+// QuerySwapQuote is the result of a swap dry-run, nothing is committed to the key value store
+// when the swap would be rejected, Code and Reason explain why
+type QuerySwapQuote struct {
+	FromAsset          common.Asset        `json:"from_asset"`
+	ToAsset            common.Asset        `json:"to_asset"`
+	Amount             cosmos.Uint         `json:"amount"`
+	EmitAmount         cosmos.Uint         `json:"emit_amount"`
+	OutboundFee        cosmos.Uint         `json:"outbound_fee"`
+	ExpectedAmountOut  cosmos.Uint         `json:"expected_amount_out"`
+	LiquidityFeeInRune cosmos.Uint         `json:"liquidity_fee_in_rune"`
+	TradeSlip          cosmos.Uint         `json:"trade_slip"`
+	Legs               []QuerySwapQuoteLeg `json:"legs"`
+	Code               uint32              `json:"code"`
+	Reason             string              `json:"reason,omitempty"`
+}
+
+// NewQuerySwapQuote create a new instance of QuerySwapQuote with all amounts set to zero
+func NewQuerySwapQuote(from, to common.Asset, amount cosmos.Uint) QuerySwapQuote {
+	return QuerySwapQuote{
+		FromAsset:          from,
+		ToAsset:            to,
+		Amount:             amount,
+		EmitAmount:         cosmos.ZeroUint(),
+		OutboundFee:        cosmos.ZeroUint(),
+		ExpectedAmountOut:  cosmos.ZeroUint(),
+		LiquidityFeeInRune: cosmos.ZeroUint(),
+		TradeSlip:          cosmos.ZeroUint(),
+		Legs:               make([]QuerySwapQuoteLeg, 0),
+	}
+}
+
+// String implement fmt.Stringer
+func (q QuerySwapQuote) String() string {
+	return fmt.Sprintf("%s %s -> %s: emit %s, outbound fee %s, slip %s", q.Amount, q.FromAsset, q.ToAsset, q.EmitAmount, q.OutboundFee, q.TradeSlip)
+}
+
+// QuerySwapQueueItem is a swap waiting in the swap queue, along with the score it is ordered by
+// Position starts from 1, it is 0 for streaming swaps waiting for their next sub-swap to be due
+type QuerySwapQueueItem struct {
+	Msg            MsgSwap     `json:"msg"`
+	LiquidityFee   cosmos.Uint `json:"liquidity_fee"`
+	TradeSlip      cosmos.Uint `json:"trade_slip"`
+	Position       int64       `json:"position"`
+	EstimatedBlock int64       `json:"estimated_block"`
+}
+
+// QueryStakerPosition is the position of a staker in a pool , valued with the current pool depths
+// Gain is the redeemable value versus holding the deposits , both valued in RUNE , in basis points
+type QueryStakerPosition struct {
+	Asset           common.Asset `json:"asset"`
+	Units           cosmos.Uint  `json:"units"`
+	PendingRune     cosmos.Uint  `json:"pending_rune"`
+	RuneDeposit     cosmos.Uint  `json:"rune_deposit"`
+	AssetDeposit    cosmos.Uint  `json:"asset_deposit"`
+	RuneRedeemable  cosmos.Uint  `json:"rune_redeemable"`
+	AssetRedeemable cosmos.Uint  `json:"asset_redeemable"`
+	HoldValue       cosmos.Uint  `json:"hold_value"`
+	RedeemableValue cosmos.Uint  `json:"redeemable_value"`
+	Gain            int64        `json:"gain"`
+	LastStakeHeight int64        `json:"last_stake"`
+	UnlockHeight    int64        `json:"unlock_height"`
+	Locked          bool         `json:"locked"`
+}
+
+// QueryPoolTWAP is the time weighted average price of one asset in RUNE over the given window , next to the current spot price
+// TWAP is zero when the pool does not have enough price history to cover the window
+type QueryPoolTWAP struct {
+	Asset     common.Asset `json:"asset"`
+	Height    int64        `json:"height"`
+	Window    int64        `json:"window"`
+	SpotPrice cosmos.Uint  `json:"spot_price"`
+	TWAP      cosmos.Uint  `json:"twap"`
+}
+
+// QueryPoolSuspension is the suspension of a pool , and how far its delist got
+// Stakers is the number of stakers still have units in the pool , PendingStakers only have pending RUNE
+type QueryPoolSuspension struct {
+	Asset           common.Asset `json:"asset"`
+	Status          PoolStatus   `json:"status"`
+	PreviousStatus  PoolStatus   `json:"previous_status"`
+	SuspendedHeight int64        `json:"suspended_height"`
+	Delisting       bool         `json:"delisting"`
+	DelistHeight    int64        `json:"delist_height"`
+	UnstakedHeight  int64        `json:"unstaked_height"`
+	Stakers         int64        `json:"stakers"`
+	PendingStakers  int64        `json:"pending_stakers"`
+	BalanceRune     cosmos.Uint  `json:"balance_rune"`
+	BalanceAsset    cosmos.Uint  `json:"balance_asset"`
+	PoolUnits       cosmos.Uint  `json:"pool_units"`
+}
+
+// QuerySolvencyCoin is the balance of an asset THORChain expect a vault to hold , and the balance observed on chain
+type QuerySolvencyCoin struct {
+	Asset    common.Asset `json:"asset"`
+	Expected cosmos.Uint  `json:"expected"`
+	Observed cosmos.Uint  `json:"observed"`
+	Solvent  bool         `json:"solvent"`
+}
+
+// QueryVaultSolvency is the latest balance of a vault on a chain that reached consensus , compared with what THORChain expect
+type QueryVaultSolvency struct {
+	PubKey      common.PubKey       `json:"pub_key"`
+	Chain       common.Chain        `json:"chain"`
+	Type        VaultType           `json:"type"`
+	Status      VaultStatus         `json:"status"`
+	Height      int64               `json:"height"`
+	BlockHeight int64               `json:"block_height"`
+	Solvent     bool                `json:"solvent"`
+	Coins       []QuerySolvencyCoin `json:"coins"`
+}
+
+// QueryBootstrapPool is a bootstrap pool ranked by the pool enable policy , Next is true for the pool enabled at the next cycle
+type QueryBootstrapPool struct {
+	Rank         int          `json:"rank"`
+	Asset        common.Asset `json:"asset"`
+	BalanceRune  cosmos.Uint  `json:"balance_rune"`
+	BalanceAsset cosmos.Uint  `json:"balance_asset"`
+	Eligible     bool         `json:"eligible"`
+	Reason       string       `json:"reason,omitempty"`
+	Next         bool         `json:"next"`
+}
+
+// QueryBootstrapPools is the ranking of the bootstrap pools , and the height a pool is enabled next
+type QueryBootstrapPools struct {
+	NewPoolCycle    int64                `json:"new_pool_cycle"`
+	NextCycleHeight int64                `json:"next_cycle_height"`
+	Pools           []QueryBootstrapPool `json:"pools"`
+}
+
+// QueryChainHalt is the halt state of a chain , the mimir halt heights are 0 when not set , the auto halt fields are empty unless
+// trading on the chain had been halted automatically
+type QueryChainHalt struct {
+	Chain             common.Chain `json:"chain"`
+	TradingHalted     bool         `json:"trading_halted"`
+	ChainHalted       bool         `json:"chain_halted"`
+	HaltTradingHeight int64        `json:"halt_trading_height"`
+	HaltChainHeight   int64        `json:"halt_chain_height"`
+	AutoHaltHeight    int64        `json:"auto_halt_height,omitempty"`
+	AutoHaltReason    string       `json:"auto_halt_reason,omitempty"`
+}
+
+// QueryPendingStake is a staker waiting for the asset side of a cross chain stake
+// Age is the number of blocks since the RUNE was received , ExpiryHeight is when the RUNE will be staked or refunded
+type QueryPendingStake struct {
+	Staker       Staker `json:"staker"`
+	Age          int64  `json:"age"`
+	ExpiryHeight int64  `json:"expiry_height"`
+}
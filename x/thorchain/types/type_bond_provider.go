@@ -0,0 +1,158 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// BondProvider is an address provide bond to a node account , Units is its share of the bond of the node account
+// bond providers hold units rather than RUNE , so rewards and slashes on the bond are shared pro-rata
+type BondProvider struct {
+	BondAddress common.Address `json:"bond_address"`
+	Units       cosmos.Uint    `json:"units"`
+}
+
+// NewBondProvider create a new instance of BondProvider
+func NewBondProvider(addr common.Address) BondProvider {
+	return BondProvider{
+		BondAddress: addr,
+		Units:       cosmos.ZeroUint(),
+	}
+}
+
+// BondProviders are the addresses whitelisted by the node operator to bond to a node account , include the node operator itself
+// NodeOperatorFee is the basis points of the bond rewards paid to the node operator , before the rest is shared by bond providers
+type BondProviders struct {
+	NodeAddress     cosmos.AccAddress `json:"node_address"`
+	NodeOperatorFee int64             `json:"node_operator_fee"`
+	Providers       []BondProvider    `json:"providers"`
+}
+
+// NewBondProviders create a new instance of BondProviders
+func NewBondProviders(addr cosmos.AccAddress) BondProviders {
+	return BondProviders{
+		NodeAddress: addr,
+	}
+}
+
+// Valid check whether the bond providers have all the fields they need
+func (bp BondProviders) Valid() error {
+	if bp.NodeAddress.Empty() {
+		return errors.New("node address cannot be empty")
+	}
+	if bp.NodeOperatorFee < 0 || bp.NodeOperatorFee > MaxUnstakeBasisPoints {
+		return fmt.Errorf("node operator fee(%d) is invalid", bp.NodeOperatorFee)
+	}
+	for i, p := range bp.Providers {
+		if p.BondAddress.IsEmpty() {
+			return errors.New("bond provider address cannot be empty")
+		}
+		for _, other := range bp.Providers[i+1:] {
+			if p.BondAddress.Equals(other.BondAddress) {
+				return fmt.Errorf("bond provider(%s) is duplicated", p.BondAddress)
+			}
+		}
+	}
+	return nil
+}
+
+// Has check whether the given address is a bond provider
+func (bp BondProviders) Has(addr common.Address) bool {
+	for _, p := range bp.Providers {
+		if p.BondAddress.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get return the bond provider of the given address , an empty bond provider is returned when it doesn't exist
+func (bp BondProviders) Get(addr common.Address) BondProvider {
+	for _, p := range bp.Providers {
+		if p.BondAddress.Equals(addr) {
+			return p
+		}
+	}
+	return NewBondProvider(addr)
+}
+
+// Add whitelist the given address as a bond provider , do nothing if it is already a bond provider
+func (bp *BondProviders) Add(addr common.Address) {
+	if bp.Has(addr) {
+		return
+	}
+	bp.Providers = append(bp.Providers, NewBondProvider(addr))
+}
+
+// Remove the given address from the bond providers
+func (bp *BondProviders) Remove(addr common.Address) {
+	for i, p := range bp.Providers {
+		if p.BondAddress.Equals(addr) {
+			bp.Providers = append(bp.Providers[:i], bp.Providers[i+1:]...)
+			return
+		}
+	}
+}
+
+// TotalUnits return the total units of all bond providers
+func (bp BondProviders) TotalUnits() cosmos.Uint {
+	total := cosmos.ZeroUint()
+	for _, p := range bp.Providers {
+		total = total.Add(p.Units)
+	}
+	return total
+}
+
+// GetBond return the share of the given total bond owned by the given address
+func (bp BondProviders) GetBond(addr common.Address, totalBond cosmos.Uint) cosmos.Uint {
+	totalUnits := bp.TotalUnits()
+	if totalUnits.IsZero() {
+		return cosmos.ZeroUint()
+	}
+	return common.GetShare(bp.Get(addr).Units, totalUnits, totalBond)
+}
+
+// Bond give the given address the units of the given amount of RUNE , totalBond is the bond of the node account before the amount is added
+// the address will be added as a bond provider if it is not one already
+func (bp *BondProviders) Bond(addr common.Address, amt, totalBond cosmos.Uint) {
+	totalUnits := bp.TotalUnits()
+	if totalUnits.IsZero() || totalBond.IsZero() {
+		// there is nothing left of the bond , start over
+		for i := range bp.Providers {
+			bp.Providers[i].Units = cosmos.ZeroUint()
+		}
+		totalUnits = cosmos.ZeroUint()
+	}
+	units := amt
+	if !totalUnits.IsZero() {
+		units = common.GetShare(amt, totalBond, totalUnits)
+	}
+	bp.Add(addr)
+	for i, p := range bp.Providers {
+		if p.BondAddress.Equals(addr) {
+			bp.Providers[i].Units = p.Units.Add(units)
+		}
+	}
+}
+
+// Unbond take the units of the given amount of RUNE away from the given address , totalBond is the bond of the node account before
+// the amount is taken away , the amount is capped at the bond owned by the address , the amount actually unbonded is returned
+func (bp *BondProviders) Unbond(addr common.Address, amt, totalBond cosmos.Uint) cosmos.Uint {
+	bond := bp.GetBond(addr, totalBond)
+	if bond.IsZero() {
+		return cosmos.ZeroUint()
+	}
+	if amt.GT(bond) {
+		amt = bond
+	}
+	units := common.GetShare(amt, bond, bp.Get(addr).Units)
+	for i, p := range bp.Providers {
+		if p.BondAddress.Equals(addr) {
+			bp.Providers[i].Units = common.SafeSub(p.Units, units)
+		}
+	}
+	return amt
+}
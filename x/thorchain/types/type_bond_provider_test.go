@@ -0,0 +1,67 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type BondProviderSuite struct{}
+
+var _ = Suite(&BondProviderSuite{})
+
+func (s *BondProviderSuite) TestValid(c *C) {
+	bp := NewBondProviders(GetRandomBech32Addr())
+	c.Check(bp.Valid(), IsNil)
+	bp.Add(GetRandomBNBAddress())
+	c.Check(bp.Valid(), IsNil)
+
+	bp.NodeOperatorFee = 10001
+	c.Check(bp.Valid(), NotNil)
+	bp.NodeOperatorFee = 2000
+	bp.Providers = append(bp.Providers, bp.Providers[0])
+	c.Check(bp.Valid(), NotNil)
+	bp.Providers = []BondProvider{NewBondProvider(common.NoAddress)}
+	c.Check(bp.Valid(), NotNil)
+	c.Check(NewBondProviders(cosmos.AccAddress{}).Valid(), NotNil)
+}
+
+func (s *BondProviderSuite) TestBondUnbond(c *C) {
+	operator := GetRandomBNBAddress()
+	provider := GetRandomBNBAddress()
+	bp := NewBondProviders(GetRandomBech32Addr())
+	bp.Add(provider)
+	bp.Add(provider)
+	c.Assert(bp.Providers, HasLen, 1)
+
+	totalBond := cosmos.ZeroUint()
+	bp.Bond(operator, cosmos.NewUint(100*common.One), totalBond)
+	totalBond = cosmos.NewUint(100 * common.One)
+	bp.Bond(provider, cosmos.NewUint(300*common.One), totalBond)
+	totalBond = cosmos.NewUint(400 * common.One)
+	c.Check(bp.GetBond(operator, totalBond).Equal(cosmos.NewUint(100*common.One)), Equals, true)
+	c.Check(bp.GetBond(provider, totalBond).Equal(cosmos.NewUint(300*common.One)), Equals, true)
+
+	// bond is slashed by half , both providers lose half
+	totalBond = cosmos.NewUint(200 * common.One)
+	c.Check(bp.GetBond(operator, totalBond).Equal(cosmos.NewUint(50*common.One)), Equals, true)
+	c.Check(bp.GetBond(provider, totalBond).Equal(cosmos.NewUint(150*common.One)), Equals, true)
+
+	// unbond is capped at the bond of the provider
+	amt := bp.Unbond(provider, cosmos.NewUint(1000*common.One), totalBond)
+	c.Check(amt.Equal(cosmos.NewUint(150*common.One)), Equals, true)
+	c.Check(bp.Get(provider).Units.IsZero(), Equals, true)
+	totalBond = cosmos.NewUint(50 * common.One)
+	c.Check(bp.GetBond(operator, totalBond).Equal(totalBond), Equals, true)
+	c.Check(bp.Unbond(GetRandomBNBAddress(), cosmos.NewUint(common.One), totalBond).IsZero(), Equals, true)
+
+	// nothing left of the bond , units start over
+	bp.Bond(provider, cosmos.NewUint(10*common.One), cosmos.ZeroUint())
+	c.Check(bp.Get(operator).Units.IsZero(), Equals, true)
+	c.Check(bp.GetBond(provider, cosmos.NewUint(10*common.One)).Equal(cosmos.NewUint(10*common.One)), Equals, true)
+
+	bp.Remove(provider)
+	c.Check(bp.Has(provider), Equals, false)
+	c.Check(bp.Has(operator), Equals, true)
+}
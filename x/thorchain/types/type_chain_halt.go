@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// the reasons trading on a chain is halted automatically
+const (
+	ChainHaltInsolvency    = `insolvency`
+	ChainHaltOutboundLimit = `outbound_limit`
+)
+
+// ChainHalt is set when trading on a chain is halted automatically , only mimir can clear it
+type ChainHalt struct {
+	Chain  common.Chain `json:"chain"`
+	Height int64        `json:"height"`
+	Reason string       `json:"reason"`
+}
+
+// NewChainHalt create a new instance of ChainHalt
+func NewChainHalt(chain common.Chain, height int64, reason string) ChainHalt {
+	return ChainHalt{
+		Chain:  chain,
+		Height: height,
+		Reason: reason,
+	}
+}
+
+// Valid check whether the chain halt has all the fields it needs
+func (h ChainHalt) Valid() error {
+	if h.Chain.IsEmpty() {
+		return errors.New("chain cannot be empty")
+	}
+	if h.Height <= 0 {
+		return errors.New("height must be positive")
+	}
+	if len(h.Reason) == 0 {
+		return errors.New("reason cannot be empty")
+	}
+	return nil
+}
+
+// IsEmpty return true when the chain is empty
+func (h ChainHalt) IsEmpty() bool {
+	return h.Chain.IsEmpty()
+}
+
+// OutboundValue is the RUNE value of the coins sent to users from the vaults of a chain in a block
+type OutboundValue struct {
+	Chain  common.Chain `json:"chain"`
+	Height int64        `json:"height"`
+	Value  cosmos.Uint  `json:"value"`
+}
+
+// NewOutboundValue create a new instance of OutboundValue
+func NewOutboundValue(chain common.Chain, height int64, value cosmos.Uint) OutboundValue {
+	return OutboundValue{
+		Chain:  chain,
+		Height: height,
+		Value:  value,
+	}
+}
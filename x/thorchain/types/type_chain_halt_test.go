@@ -0,0 +1,27 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type ChainHaltSuite struct{}
+
+var _ = Suite(&ChainHaltSuite{})
+
+func (ChainHaltSuite) TestChainHalt(c *C) {
+	halt := NewChainHalt(common.BNBChain, 1024, ChainHaltInsolvency)
+	c.Check(halt.Valid(), IsNil)
+	c.Check(halt.IsEmpty(), Equals, false)
+	c.Check(ChainHalt{}.IsEmpty(), Equals, true)
+	c.Check(NewChainHalt(common.EmptyChain, 1024, ChainHaltInsolvency).Valid(), NotNil)
+	c.Check(NewChainHalt(common.BNBChain, 0, ChainHaltInsolvency).Valid(), NotNil)
+	c.Check(NewChainHalt(common.BNBChain, 1024, "").Valid(), NotNil)
+
+	value := NewOutboundValue(common.BNBChain, 1024, cosmos.NewUint(common.One))
+	c.Check(value.Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(value.Height, Equals, int64(1024))
+	c.Check(value.Value.Equal(cosmos.NewUint(common.One)), Equals, true)
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// ChainObservation keep track of how many blocks a node account was expected to observe a chain , which are the blocks
+// the chain reached consensus on an observation , and in how many of them the node account didn't observe it
+// the blocks are counted over a rolling window , the counters of the current period and the period before it are kept , Height is
+// the height the current period started at
+type ChainObservation struct {
+	NodeAddress cosmos.AccAddress `json:"node_address"`
+	Chain       common.Chain      `json:"chain"`
+	Height      int64             `json:"height"`
+	Total       int64             `json:"total"`
+	Missed      int64             `json:"missed"`
+	PrevTotal   int64             `json:"prev_total"`
+	PrevMissed  int64             `json:"prev_missed"`
+}
+
+// NewChainObservation create a new instance of ChainObservation
+func NewChainObservation(addr cosmos.AccAddress, chain common.Chain) ChainObservation {
+	return ChainObservation{
+		NodeAddress: addr,
+		Chain:       chain,
+	}
+}
+
+// Valid check whether the chain observation has all the fields it needs
+func (c ChainObservation) Valid() error {
+	if c.NodeAddress.Empty() {
+		return errors.New("node address cannot be empty")
+	}
+	if c.Chain.IsEmpty() {
+		return errors.New("chain cannot be empty")
+	}
+	if c.Total < 0 || c.Missed < 0 || c.PrevTotal < 0 || c.PrevMissed < 0 {
+		return errors.New("total and missed cannot be negative")
+	}
+	if c.Missed > c.Total || c.PrevMissed > c.PrevTotal {
+		return errors.New("missed cannot be more than total")
+	}
+	return nil
+}
+
+// Roll move the counters to the period of the given window the given height is in , the counters of the current period become the
+// counters of the previous period , anything older than that is dropped
+func (c *ChainObservation) Roll(height, window int64) {
+	if window <= 0 {
+		return
+	}
+	start := height - height%window
+	if c.Height >= start {
+		return
+	}
+	if c.Height == start-window {
+		c.PrevTotal, c.PrevMissed = c.Total, c.Missed
+	} else {
+		c.PrevTotal, c.PrevMissed = 0, 0
+	}
+	c.Total, c.Missed = 0, 0
+	c.Height = start
+}
+
+// GetTotal return the number of blocks the node account was expected to observe the chain , in the current and the previous period
+func (c ChainObservation) GetTotal() int64 {
+	return c.Total + c.PrevTotal
+}
+
+// GetMissed return the number of blocks the node account missed observing the chain , in the current and the previous period
+func (c ChainObservation) GetMissed() int64 {
+	return c.Missed + c.PrevMissed
+}
+
+// MissRate return the percentage of blocks the node account missed observing the chain , in basis points
+func (c ChainObservation) MissRate() int64 {
+	if c.GetTotal() == 0 {
+		return 0
+	}
+	return c.GetMissed() * MaxUnstakeBasisPoints / c.GetTotal()
+}
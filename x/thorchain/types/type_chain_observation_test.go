@@ -0,0 +1,58 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type ChainObservationSuite struct{}
+
+var _ = Suite(&ChainObservationSuite{})
+
+func (s *ChainObservationSuite) TestChainObservation(c *C) {
+	addr := GetRandomBech32Addr()
+	observation := NewChainObservation(addr, common.BTCChain)
+	c.Check(observation.Valid(), IsNil)
+	c.Check(observation.MissRate(), Equals, int64(0))
+
+	observation.Total = 4
+	observation.Missed = 1
+	c.Check(observation.Valid(), IsNil)
+	c.Check(observation.MissRate(), Equals, int64(2500))
+
+	observation.Missed = 5
+	c.Check(observation.Valid(), NotNil)
+	c.Check(NewChainObservation(nil, common.BTCChain).Valid(), NotNil)
+	c.Check(NewChainObservation(addr, common.EmptyChain).Valid(), NotNil)
+}
+
+func (s *ChainObservationSuite) TestRoll(c *C) {
+	observation := NewChainObservation(GetRandomBech32Addr(), common.BTCChain)
+	observation.Roll(1050, 100)
+	c.Check(observation.Height, Equals, int64(1000))
+	observation.Total = 10
+	observation.Missed = 5
+
+	// same period
+	observation.Roll(1099, 100)
+	c.Check(observation.Total, Equals, int64(10))
+	c.Check(observation.GetTotal(), Equals, int64(10))
+
+	// next period keeps the counters of the current one
+	observation.Roll(1100, 100)
+	c.Check(observation.Height, Equals, int64(1100))
+	c.Check(observation.Total, Equals, int64(0))
+	c.Check(observation.PrevTotal, Equals, int64(10))
+	observation.Total = 10
+	c.Check(observation.GetTotal(), Equals, int64(20))
+	c.Check(observation.GetMissed(), Equals, int64(5))
+	c.Check(observation.MissRate(), Equals, int64(2500))
+	c.Check(observation.Valid(), IsNil)
+
+	// the counters older than the previous period are dropped
+	observation.Roll(1350, 100)
+	c.Check(observation.Height, Equals, int64(1300))
+	c.Check(observation.GetTotal(), Equals, int64(0))
+	c.Check(observation.MissRate(), Equals, int64(0))
+}
@@ -24,6 +24,41 @@ const (
 	ErrataEventType   = `errata`
 	FeeEventType      = `fee`
 	OutboundEventType = `outbound`
+
+	StreamingSwapEventType = `streaming_swap`
+	AffiliateFeeEventType  = `affiliate_fee`
+	LimitOrderEventType    = `limit_order`
+	PendingRuneEventType   = `pending_rune`
+	PoolDelistEventType    = `pool_delist`
+	SolvencyEventType      = `solvency`
+	ChainHaltEventType     = `chain_halt`
+)
+
+// all the status of a limit order reported by EventLimitOrder
+const (
+	LimitOrderPlaced    = `placed`
+	LimitOrderFilled    = `filled`
+	LimitOrderExpired   = `expired`
+	LimitOrderCancelled = `cancelled`
+)
+
+// what happened to the expired pending RUNE reported by EventPendingRune
+const (
+	PendingRuneStaked   = `staked`
+	PendingRuneRefunded = `refunded`
+	PendingRuneReserved = `reserved`
+)
+
+// the phases of a pool delist reported by EventPoolDelist
+const (
+	PoolDelistStarted   = `started`
+	PoolDelistCompleted = `completed`
+)
+
+// what happened to the trading on a chain reported by EventChainHalt
+const (
+	ChainHaltHalted  = `halted`
+	ChainHaltResumed = `resumed`
 )
 
 // PoolMod pool modifications
@@ -90,6 +125,247 @@ func (e EventSwap) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// EventStreamingSwap event emitted after each sub-swap of a streaming swap
+type EventStreamingSwap struct {
+	TxID       common.TxID `json:"tx_id"`
+	Count      int64       `json:"count"`
+	Quantity   int64       `json:"quantity"`
+	Interval   int64       `json:"interval"`
+	SwapIn     cosmos.Uint `json:"swap_in"`
+	SwapOut    cosmos.Uint `json:"swap_out"`
+	Deposit    cosmos.Uint `json:"deposit"`
+	In         cosmos.Uint `json:"in"`
+	Out        cosmos.Uint `json:"out"`
+	FailReason string      `json:"fail_reason"`
+}
+
+// NewEventStreamingSwap create a new streaming swap event, swapIn and swapOut are the amount of the sub-swap
+func NewEventStreamingSwap(stream StreamingSwap, swapIn, swapOut cosmos.Uint, failReason string) EventStreamingSwap {
+	return EventStreamingSwap{
+		TxID:       stream.TxID,
+		Count:      stream.Count,
+		Quantity:   stream.Quantity,
+		Interval:   stream.Interval,
+		SwapIn:     swapIn,
+		SwapOut:    swapOut,
+		Deposit:    stream.Deposit,
+		In:         stream.In,
+		Out:        stream.Out,
+		FailReason: failReason,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (e EventStreamingSwap) Type() string {
+	return StreamingSwapEventType
+}
+
+// Events convert EventStreamingSwap to key value pairs used in cosmos
+func (e EventStreamingSwap) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("tx_id", e.TxID.String()),
+		cosmos.NewAttribute("count", strconv.FormatInt(e.Count, 10)),
+		cosmos.NewAttribute("quantity", strconv.FormatInt(e.Quantity, 10)),
+		cosmos.NewAttribute("interval", strconv.FormatInt(e.Interval, 10)),
+		cosmos.NewAttribute("swap_in", e.SwapIn.String()),
+		cosmos.NewAttribute("swap_out", e.SwapOut.String()),
+		cosmos.NewAttribute("deposit", e.Deposit.String()),
+		cosmos.NewAttribute("in", e.In.String()),
+		cosmos.NewAttribute("out", e.Out.String()),
+		cosmos.NewAttribute("fail_reason", e.FailReason),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// EventLimitOrder event emitted when a limit order is placed, filled, expired or cancelled
+type EventLimitOrder struct {
+	TxID         common.TxID    `json:"tx_id"`
+	Status       string         `json:"status"`
+	Coin         common.Coin    `json:"coin"`
+	TargetAsset  common.Asset   `json:"target_asset"`
+	Destination  common.Address `json:"destination"`
+	MinOut       cosmos.Uint    `json:"min_out"`
+	ExpiryHeight int64          `json:"expiry_height"`
+	Out          cosmos.Uint    `json:"out"` // the amount of target asset emitted when the order is filled
+}
+
+// NewEventLimitOrder create a new limit order event
+func NewEventLimitOrder(order LimitOrder, status string, out cosmos.Uint) EventLimitOrder {
+	return EventLimitOrder{
+		TxID:         order.Tx.ID,
+		Status:       status,
+		Coin:         order.Tx.Coins[0],
+		TargetAsset:  order.TargetAsset,
+		Destination:  order.Destination,
+		MinOut:       order.MinOut,
+		ExpiryHeight: order.ExpiryHeight,
+		Out:          out,
+	}
+}
+
+// Type return a string that represent the type, it should not duplicated with other event
+func (e EventLimitOrder) Type() string {
+	return LimitOrderEventType
+}
+
+// Events convert EventLimitOrder to key value pairs used in cosmos
+func (e EventLimitOrder) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("tx_id", e.TxID.String()),
+		cosmos.NewAttribute("status", e.Status),
+		cosmos.NewAttribute("coin", e.Coin.String()),
+		cosmos.NewAttribute("target_asset", e.TargetAsset.String()),
+		cosmos.NewAttribute("destination", e.Destination.String()),
+		cosmos.NewAttribute("min_out", e.MinOut.String()),
+		cosmos.NewAttribute("expiry_height", strconv.FormatInt(e.ExpiryHeight, 10)),
+		cosmos.NewAttribute("out", e.Out.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// EventPendingRune represent the RUNE side of a stake which didn't get its asset side before it expired
+type EventPendingRune struct {
+	Pool        common.Asset   `json:"pool"`
+	RuneAddress common.Address `json:"rune_address"`
+	RuneAmount  cosmos.Uint    `json:"rune_amount"`
+	TxID        common.TxID    `json:"tx_id"`
+	Action      string         `json:"action"`
+}
+
+// NewEventPendingRune create a new instance of EventPendingRune
+func NewEventPendingRune(staker Staker, action string) EventPendingRune {
+	return EventPendingRune{
+		Pool:        staker.Asset,
+		RuneAddress: staker.RuneAddress,
+		RuneAmount:  staker.PendingRune,
+		TxID:        staker.PendingTxID,
+		Action:      action,
+	}
+}
+
+// Type return the pending rune event type
+func (e EventPendingRune) Type() string {
+	return PendingRuneEventType
+}
+
+// Events return the cosmos event
+func (e EventPendingRune) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("pool", e.Pool.String()),
+		cosmos.NewAttribute("rune_address", e.RuneAddress.String()),
+		cosmos.NewAttribute("rune_amount", e.RuneAmount.String()),
+		cosmos.NewAttribute("tx_id", e.TxID.String()),
+		cosmos.NewAttribute("action", e.Action),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// EventPoolDelist represent a suspended pool which is delisted , all of its stakers are unstaked and the pool is removed
+// Rune is the RUNE left in the pool that is moved to the reserve , Asset is the asset left in the pool when it is removed
+type EventPoolDelist struct {
+	Pool   common.Asset `json:"pool"`
+	Action string       `json:"action"`
+	Rune   cosmos.Uint  `json:"rune"`
+	Asset  cosmos.Uint  `json:"asset"`
+}
+
+// NewEventPoolDelist create a new instance of EventPoolDelist
+func NewEventPoolDelist(pool common.Asset, action string) EventPoolDelist {
+	return EventPoolDelist{
+		Pool:   pool,
+		Action: action,
+		Rune:   cosmos.ZeroUint(),
+		Asset:  cosmos.ZeroUint(),
+	}
+}
+
+// Type return the pool delist event type
+func (e EventPoolDelist) Type() string {
+	return PoolDelistEventType
+}
+
+// Events return the cosmos event
+func (e EventPoolDelist) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("pool", e.Pool.String()),
+		cosmos.NewAttribute("action", e.Action),
+		cosmos.NewAttribute("rune", e.Rune.String()),
+		cosmos.NewAttribute("asset", e.Asset.String()),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// EventSolvency is emitted when the balance of a vault observed on an external chain is short of what THORChain expect
+type EventSolvency struct {
+	Chain    common.Chain  `json:"chain"`
+	PubKey   common.PubKey `json:"pub_key"`
+	Asset    common.Asset  `json:"asset"`
+	Expected cosmos.Uint   `json:"expected"`
+	Observed cosmos.Uint   `json:"observed"`
+	Height   int64         `json:"height"`
+}
+
+// NewEventSolvency create a new instance of EventSolvency
+func NewEventSolvency(chain common.Chain, pubKey common.PubKey, asset common.Asset, expected, observed cosmos.Uint, height int64) EventSolvency {
+	return EventSolvency{
+		Chain:    chain,
+		PubKey:   pubKey,
+		Asset:    asset,
+		Expected: expected,
+		Observed: observed,
+		Height:   height,
+	}
+}
+
+// Type return the solvency event type
+func (e EventSolvency) Type() string {
+	return SolvencyEventType
+}
+
+// Events return the cosmos event
+func (e EventSolvency) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("chain", e.Chain.String()),
+		cosmos.NewAttribute("pub_key", e.PubKey.String()),
+		cosmos.NewAttribute("asset", e.Asset.String()),
+		cosmos.NewAttribute("expected", e.Expected.String()),
+		cosmos.NewAttribute("observed", e.Observed.String()),
+		cosmos.NewAttribute("height", strconv.FormatInt(e.Height, 10)),
+	)
+	return cosmos.Events{evt}, nil
+}
+
+// EventChainHalt is emitted when trading on a chain is halted automatically , and when mimir resume it
+type EventChainHalt struct {
+	Chain  common.Chain `json:"chain"`
+	Action string       `json:"action"`
+	Reason string       `json:"reason"`
+}
+
+// NewEventChainHalt create a new instance of EventChainHalt
+func NewEventChainHalt(chain common.Chain, action, reason string) EventChainHalt {
+	return EventChainHalt{
+		Chain:  chain,
+		Action: action,
+		Reason: reason,
+	}
+}
+
+// Type return the chain halt event type
+func (e EventChainHalt) Type() string {
+	return ChainHaltEventType
+}
+
+// Events return the cosmos event
+func (e EventChainHalt) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("chain", e.Chain.String()),
+		cosmos.NewAttribute("action", e.Action),
+		cosmos.NewAttribute("reason", e.Reason),
+	)
+	return cosmos.Events{evt}, nil
+}
+
 // EventStake stake event
 type EventStake struct {
 	Pool        common.Asset   `json:"pool"`
@@ -147,6 +423,7 @@ type EventUnstake struct {
 	StakeUnits  cosmos.Uint  `json:"stake_units"`
 	BasisPoints int64        `json:"basis_points"` // 1 ==> 10,0000
 	Asymmetry   cosmos.Dec   `json:"asymmetry"`    // -1.0 <==> 1.0
+	Slip        cosmos.Uint  `json:"slip"`         // slip of the internal swap when withdraw to a single asset , in basis points
 	InTx        common.Tx    `json:"in_tx"`
 }
 
@@ -157,6 +434,7 @@ func NewEventUnstake(pool common.Asset, su cosmos.Uint, basisPts int64, asym cos
 		StakeUnits:  su,
 		BasisPoints: basisPts,
 		Asymmetry:   asym,
+		Slip:        cosmos.ZeroUint(),
 		InTx:        inTx,
 	}
 }
@@ -172,7 +450,8 @@ func (e EventUnstake) Events() (cosmos.Events, error) {
 		cosmos.NewAttribute("pool", e.Pool.String()),
 		cosmos.NewAttribute("stake_units", e.StakeUnits.String()),
 		cosmos.NewAttribute("basis_points", strconv.FormatInt(e.BasisPoints, 10)),
-		cosmos.NewAttribute("asymmetry", e.Asymmetry.String()))
+		cosmos.NewAttribute("asymmetry", e.Asymmetry.String()),
+		cosmos.NewAttribute("slip", e.Slip.String()))
 	evt = evt.AppendAttributes(e.InTx.ToAttributes()...)
 	return cosmos.Events{evt}, nil
 }
@@ -507,6 +786,45 @@ func (e EventFee) Events() (cosmos.Events, error) {
 	return cosmos.Events{evt}, nil
 }
 
+// EventAffiliateFee represent the affiliate fee skimmed from a swap or stake and paid to the affiliate in RUNE
+type EventAffiliateFee struct {
+	TxID        common.TxID    `json:"tx_id"`
+	Memo        string         `json:"memo"`
+	Affiliate   common.Address `json:"affiliate"`
+	BasisPoints cosmos.Uint    `json:"basis_points"`
+	Coin        common.Coin    `json:"coin"`        // the coin skimmed from the inbound tx
+	RuneAmount  cosmos.Uint    `json:"rune_amount"` // the amount of RUNE paid to the affiliate
+}
+
+// NewEventAffiliateFee create a new EventAffiliateFee
+func NewEventAffiliateFee(txID common.TxID, memo string, affiliate common.Address, basisPoints cosmos.Uint, coin common.Coin, runeAmt cosmos.Uint) EventAffiliateFee {
+	return EventAffiliateFee{
+		TxID:        txID,
+		Memo:        memo,
+		Affiliate:   affiliate,
+		BasisPoints: basisPoints,
+		Coin:        coin,
+		RuneAmount:  runeAmt,
+	}
+}
+
+// Type get a string represent the event type
+func (e EventAffiliateFee) Type() string {
+	return AffiliateFeeEventType
+}
+
+// Events return events of cosmos.Event type
+func (e EventAffiliateFee) Events() (cosmos.Events, error) {
+	evt := cosmos.NewEvent(e.Type(),
+		cosmos.NewAttribute("tx_id", e.TxID.String()),
+		cosmos.NewAttribute("memo", e.Memo),
+		cosmos.NewAttribute("affiliate", e.Affiliate.String()),
+		cosmos.NewAttribute("basis_points", e.BasisPoints.String()),
+		cosmos.NewAttribute("coin", e.Coin.String()),
+		cosmos.NewAttribute("rune_amount", e.RuneAmount.String()))
+	return cosmos.Events{evt}, nil
+}
+
 // EventOutbound represent an outbound message from thornode
 type EventOutbound struct {
 	InTxID common.TxID // the inbound tx hash which triggered this outbound , it could be empty, because there are migration etc
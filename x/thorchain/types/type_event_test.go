@@ -26,6 +26,73 @@ func (s EventSuite) TestSwapEvent(c *C) {
 	c.Check(events, NotNil)
 }
 
+func (s EventSuite) TestStreamingSwapEvent(c *C) {
+	stream := NewStreamingSwap(GetRandomTxHash(), 1, 3, cosmos.NewUint(300))
+	evt := NewEventStreamingSwap(stream, cosmos.NewUint(100), cosmos.NewUint(90), "")
+	c.Check(evt.Type(), Equals, "streaming_swap")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestLimitOrderEvent(c *C) {
+	order := NewLimitOrder(GetRandomTx(), common.RuneAsset(), GetRandomBNBAddress(), cosmos.NewUint(100), 1, 10)
+	evt := NewEventLimitOrder(order, LimitOrderFilled, cosmos.NewUint(120))
+	c.Check(evt.Type(), Equals, "limit_order")
+	c.Check(evt.Status, Equals, "filled")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestPendingRuneEvent(c *C) {
+	staker := Staker{
+		Asset:       common.BTCAsset,
+		RuneAddress: GetRandomRUNEAddress(),
+		PendingRune: cosmos.NewUint(100),
+		PendingTxID: GetRandomTxHash(),
+	}
+	evt := NewEventPendingRune(staker, PendingRuneRefunded)
+	c.Check(evt.Type(), Equals, "pending_rune")
+	c.Check(evt.Action, Equals, "refunded")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestPoolDelistEvent(c *C) {
+	evt := NewEventPoolDelist(common.BNBAsset, PoolDelistStarted)
+	c.Check(evt.Type(), Equals, "pool_delist")
+	c.Check(evt.Pool.Equals(common.BNBAsset), Equals, true)
+	c.Check(evt.Action, Equals, "started")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestSolvencyEvent(c *C) {
+	pubKey := GetRandomPubKey()
+	evt := NewEventSolvency(common.BNBChain, pubKey, common.BNBAsset, cosmos.NewUint(100), cosmos.NewUint(90), 1024)
+	c.Check(evt.Type(), Equals, "solvency")
+	c.Check(evt.PubKey.Equals(pubKey), Equals, true)
+	c.Check(evt.Expected.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(evt.Observed.Equal(cosmos.NewUint(90)), Equals, true)
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
+func (s EventSuite) TestChainHaltEvent(c *C) {
+	evt := NewEventChainHalt(common.BNBChain, ChainHaltHalted, ChainHaltInsolvency)
+	c.Check(evt.Type(), Equals, "chain_halt")
+	c.Check(evt.Chain.Equals(common.BNBChain), Equals, true)
+	c.Check(evt.Action, Equals, "halted")
+	c.Check(evt.Reason, Equals, "insolvency")
+	events, err := evt.Events()
+	c.Check(err, IsNil)
+	c.Check(events, NotNil)
+}
+
 func (s EventSuite) TestStakeEvent(c *C) {
 	evt := NewEventStake(
 		common.BNBAsset,
@@ -172,6 +239,14 @@ func (s EventSuite) TestEventFee(c *C) {
 	c.Assert(evts, HasLen, 1)
 }
 
+func (s EventSuite) TestEventAffiliateFee(c *C) {
+	event := NewEventAffiliateFee(GetRandomTxHash(), "SWAP:BNB.BNB", GetRandomRUNEAddress(), cosmos.NewUint(50), common.NewCoin(common.BNBAsset, cosmos.NewUint(1024)), cosmos.NewUint(2048))
+	c.Assert(event.Type(), Equals, AffiliateFeeEventType)
+	evts, err := event.Events()
+	c.Assert(err, IsNil)
+	c.Assert(evts, HasLen, 1)
+}
+
 func (s EventSuite) TestEventAdd(c *C) {
 	e := NewEventAdd(common.BNBAsset, GetRandomTx())
 	c.Check(e.Type(), Equals, "add")
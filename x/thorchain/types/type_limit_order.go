@@ -0,0 +1,93 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// LimitOrder is a swap that is kept in the store until the pool depths allow it to emit at least MinOut of the target asset
+// the order is refunded when it is not filled by ExpiryHeight
+type LimitOrder struct {
+	Tx           common.Tx      `json:"tx"`
+	TargetAsset  common.Asset   `json:"target_asset"`
+	Destination  common.Address `json:"destination"`
+	MinOut       cosmos.Uint    `json:"min_out"`
+	Height       int64          `json:"height"`
+	ExpiryHeight int64          `json:"expiry_height"`
+}
+
+// LimitOrders a list of limit orders
+type LimitOrders []LimitOrder
+
+// LimitOrderPair is the source and target asset of the open limit orders , orders are evaluated pair by pair
+type LimitOrderPair struct {
+	Source common.Asset `json:"source"`
+	Target common.Asset `json:"target"`
+}
+
+// NewLimitOrder create a new instance of LimitOrder
+func NewLimitOrder(tx common.Tx, target common.Asset, destination common.Address, minOut cosmos.Uint, height, expiryHeight int64) LimitOrder {
+	return LimitOrder{
+		Tx:           tx,
+		TargetAsset:  target,
+		Destination:  destination,
+		MinOut:       minOut,
+		Height:       height,
+		ExpiryHeight: expiryHeight,
+	}
+}
+
+// Valid check whether the limit order has all the necessary fields
+func (o LimitOrder) Valid() error {
+	if err := o.Tx.Valid(); err != nil {
+		return err
+	}
+	if len(o.Tx.Coins) != 1 {
+		return errors.New("limit order must have exactly one coin")
+	}
+	if o.TargetAsset.IsEmpty() {
+		return errors.New("target asset cannot be empty")
+	}
+	if o.Tx.Coins[0].Asset.Equals(o.TargetAsset) {
+		return errors.New("source and target asset cannot be the same")
+	}
+	if o.Destination.IsEmpty() {
+		return errors.New("destination cannot be empty")
+	}
+	if o.MinOut.IsZero() {
+		return errors.New("min out cannot be zero")
+	}
+	if o.ExpiryHeight <= o.Height {
+		return errors.New("expiry height must be after the placed height")
+	}
+	return nil
+}
+
+// IsEmpty return true when the limit order doesn't exist
+func (o LimitOrder) IsEmpty() bool {
+	return o.Tx.ID.IsEmpty()
+}
+
+// IsExpired return true when the limit order can no longer be filled on the given block height
+func (o LimitOrder) IsExpired(height int64) bool {
+	return height >= o.ExpiryHeight
+}
+
+// Source return the asset the limit order swap from
+func (o LimitOrder) Source() common.Asset {
+	if len(o.Tx.Coins) == 0 {
+		return common.EmptyAsset
+	}
+	return o.Tx.Coins[0].Asset
+}
+
+// Rate return the min out the limit order ask for every common.One of its deposit , scaled by another common.One for
+// precision , the lower the rate the sooner the order can be filled
+func (o LimitOrder) Rate() cosmos.Uint {
+	if len(o.Tx.Coins) == 0 || o.Tx.Coins[0].Amount.IsZero() {
+		return cosmos.ZeroUint()
+	}
+	return o.MinOut.MulUint64(common.One * common.One).Quo(o.Tx.Coins[0].Amount)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type LimitOrderSuite struct{}
+
+var _ = Suite(&LimitOrderSuite{})
+
+func (LimitOrderSuite) TestLimitOrder(c *C) {
+	tx := GetRandomTx()
+	tx.Coins = common.Coins{common.NewCoin(common.BNBAsset, cosmos.NewUint(100))}
+	dest := GetRandomRUNEAddress()
+	o := NewLimitOrder(tx, common.RuneAsset(), dest, cosmos.NewUint(50), 10, 20)
+	c.Check(o.Valid(), IsNil)
+	c.Check(o.IsEmpty(), Equals, false)
+	c.Check(o.IsExpired(19), Equals, false)
+	c.Check(o.IsExpired(20), Equals, true)
+	c.Check(LimitOrder{}.IsEmpty(), Equals, true)
+
+	c.Check(NewLimitOrder(common.Tx{}, common.RuneAsset(), dest, cosmos.NewUint(50), 10, 20).Valid(), NotNil)
+	c.Check(NewLimitOrder(tx, common.Asset{}, dest, cosmos.NewUint(50), 10, 20).Valid(), NotNil)
+	c.Check(NewLimitOrder(tx, common.BNBAsset, dest, cosmos.NewUint(50), 10, 20).Valid(), NotNil)
+	c.Check(NewLimitOrder(tx, common.RuneAsset(), common.NoAddress, cosmos.NewUint(50), 10, 20).Valid(), NotNil)
+	c.Check(NewLimitOrder(tx, common.RuneAsset(), dest, cosmos.ZeroUint(), 10, 20).Valid(), NotNil)
+	c.Check(NewLimitOrder(tx, common.RuneAsset(), dest, cosmos.NewUint(50), 20, 20).Valid(), NotNil)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// MaxMaintenanceBasisPoints basis points for the share of active node accounts allowed in maintenance
+const MaxMaintenanceBasisPoints = 10_000
+
+// NodeMaintenance is the latest maintenance requested by a node account , while in maintenance the node account is not
+// slashed for lack of observing or signing , and it is not funded with yggdrasil nor chosen to be part of a keysign party
+// the record is kept after the maintenance is over , so how often a node account can request maintenance is limited
+type NodeMaintenance struct {
+	NodeAddress cosmos.AccAddress `json:"node_address"`
+	StartHeight int64             `json:"start_height"`
+	EndHeight   int64             `json:"end_height"`
+}
+
+// NewNodeMaintenance create a new instance of NodeMaintenance
+func NewNodeMaintenance(addr cosmos.AccAddress, startHeight, endHeight int64) NodeMaintenance {
+	return NodeMaintenance{
+		NodeAddress: addr,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// Valid check whether the node maintenance has all the fields it needs
+func (m NodeMaintenance) Valid() error {
+	if m.NodeAddress.Empty() {
+		return errors.New("node address cannot be empty")
+	}
+	if m.StartHeight <= 0 {
+		return errors.New("start height must be greater than zero")
+	}
+	if m.EndHeight < m.StartHeight {
+		return errors.New("end height cannot be less than start height")
+	}
+	return nil
+}
+
+// IsEmpty return true when the node account never requested maintenance
+func (m NodeMaintenance) IsEmpty() bool {
+	return m.StartHeight == 0
+}
+
+// InMaintenance return true when the node account is in maintenance at the given block height
+func (m NodeMaintenance) InMaintenance(height int64) bool {
+	return !m.IsEmpty() && height >= m.StartHeight && height < m.EndHeight
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type NodeMaintenanceSuite struct{}
+
+var _ = Suite(&NodeMaintenanceSuite{})
+
+func (s *NodeMaintenanceSuite) TestNodeMaintenance(c *C) {
+	addr := GetRandomBech32Addr()
+	var empty NodeMaintenance
+	c.Check(empty.IsEmpty(), Equals, true)
+	c.Check(empty.InMaintenance(0), Equals, false)
+
+	maintenance := NewNodeMaintenance(addr, 10, 20)
+	c.Check(maintenance.Valid(), IsNil)
+	c.Check(maintenance.IsEmpty(), Equals, false)
+	c.Check(maintenance.InMaintenance(9), Equals, false)
+	c.Check(maintenance.InMaintenance(10), Equals, true)
+	c.Check(maintenance.InMaintenance(19), Equals, true)
+	c.Check(maintenance.InMaintenance(20), Equals, false)
+
+	c.Check(NewNodeMaintenance(nil, 10, 20).Valid(), NotNil)
+	c.Check(NewNodeMaintenance(addr, 0, 20).Valid(), NotNil)
+	c.Check(NewNodeMaintenance(addr, 10, 5).Valid(), NotNil)
+}
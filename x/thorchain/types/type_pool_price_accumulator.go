@@ -0,0 +1,75 @@
+package types
+
+import (
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// PoolPriceAccumulator accumulates the price of one asset in RUNE (1e8 precision) at the end of every block ,
+// weighted by the number of blocks the price was held. The time weighted average price between two heights is
+// the difference of CumulativePrice divided by the number of blocks in between.
+type PoolPriceAccumulator struct {
+	Asset           common.Asset `json:"asset"`
+	CumulativePrice cosmos.Uint  `json:"cumulative_price"`
+	LastPrice       cosmos.Uint  `json:"last_price"`
+	LastHeight      int64        `json:"last_height"`
+	FirstHeight     int64        `json:"first_height"`
+}
+
+// NewPoolPriceAccumulator create a new instance of PoolPriceAccumulator
+func NewPoolPriceAccumulator(asset common.Asset) PoolPriceAccumulator {
+	return PoolPriceAccumulator{
+		Asset:           asset,
+		CumulativePrice: cosmos.ZeroUint(),
+		LastPrice:       cosmos.ZeroUint(),
+	}
+}
+
+// IsEmpty return true when the accumulator has never been updated
+func (a PoolPriceAccumulator) IsEmpty() bool {
+	return a.LastHeight == 0
+}
+
+// Update add the last price for the blocks since the last update , and record the new price
+func (a *PoolPriceAccumulator) Update(price cosmos.Uint, height int64) {
+	if height <= a.LastHeight {
+		return
+	}
+	if a.IsEmpty() {
+		a.FirstHeight = height
+	} else {
+		a.CumulativePrice = a.CumulativePrice.Add(a.LastPrice.MulUint64(uint64(height - a.LastHeight)))
+	}
+	a.LastPrice = price
+	a.LastHeight = height
+}
+
+// AveragePrice return the time weighted average price since the given cumulative price was recorded at the given height
+// zero is returned when the given height is not before the last update
+func (a PoolPriceAccumulator) AveragePrice(cumulativePrice cosmos.Uint, height int64) cosmos.Uint {
+	if height >= a.LastHeight || cumulativePrice.GT(a.CumulativePrice) {
+		return cosmos.ZeroUint()
+	}
+	return a.CumulativePrice.Sub(cumulativePrice).QuoUint64(uint64(a.LastHeight - height))
+}
+
+// PoolPriceCheckpoint is the value of the price accumulator of a pool at a given block height
+type PoolPriceCheckpoint struct {
+	Asset           common.Asset `json:"asset"`
+	Height          int64        `json:"height"`
+	CumulativePrice cosmos.Uint  `json:"cumulative_price"`
+}
+
+// NewPoolPriceCheckpoint create a checkpoint of the given accumulator at its last update
+func NewPoolPriceCheckpoint(acc PoolPriceAccumulator) PoolPriceCheckpoint {
+	return PoolPriceCheckpoint{
+		Asset:           acc.Asset,
+		Height:          acc.LastHeight,
+		CumulativePrice: acc.CumulativePrice,
+	}
+}
+
+// IsEmpty return true when the checkpoint doesn't have a height
+func (c PoolPriceCheckpoint) IsEmpty() bool {
+	return c.Height == 0
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type PoolPriceAccumulatorSuite struct{}
+
+var _ = Suite(&PoolPriceAccumulatorSuite{})
+
+func (PoolPriceAccumulatorSuite) TestPoolPriceAccumulator(c *C) {
+	a := NewPoolPriceAccumulator(common.BNBAsset)
+	c.Check(a.IsEmpty(), Equals, true)
+
+	a.Update(cosmos.NewUint(100), 10)
+	c.Check(a.IsEmpty(), Equals, false)
+	c.Check(a.FirstHeight, Equals, int64(10))
+	c.Check(a.CumulativePrice.IsZero(), Equals, true)
+	c.Check(a.LastPrice.Equal(cosmos.NewUint(100)), Equals, true)
+
+	// same height is ignored
+	a.Update(cosmos.NewUint(1000), 10)
+	c.Check(a.LastPrice.Equal(cosmos.NewUint(100)), Equals, true)
+
+	a.Update(cosmos.NewUint(200), 12)
+	c.Check(a.CumulativePrice.Equal(cosmos.NewUint(200)), Equals, true)
+	a.Update(cosmos.NewUint(400), 14)
+	c.Check(a.CumulativePrice.Equal(cosmos.NewUint(600)), Equals, true)
+	c.Check(a.LastHeight, Equals, int64(14))
+	c.Check(a.FirstHeight, Equals, int64(10))
+
+	// price 100 for 2 blocks , price 200 for 2 blocks
+	c.Check(a.AveragePrice(cosmos.ZeroUint(), 10).Equal(cosmos.NewUint(150)), Equals, true)
+	c.Check(a.AveragePrice(cosmos.NewUint(200), 12).Equal(cosmos.NewUint(200)), Equals, true)
+	c.Check(a.AveragePrice(cosmos.NewUint(600), 14).IsZero(), Equals, true)
+
+	cp := NewPoolPriceCheckpoint(a)
+	c.Check(cp.IsEmpty(), Equals, false)
+	c.Check(cp.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(cp.Height, Equals, int64(14))
+	c.Check(cp.CumulativePrice.Equal(cosmos.NewUint(600)), Equals, true)
+	c.Check(PoolPriceCheckpoint{}.IsEmpty(), Equals, true)
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// PoolSnapshot is the depth of a pool at a given block height
+// LiquidityFees is the total liquidity fees the pool collected since its first snapshot
+type PoolSnapshot struct {
+	Asset         common.Asset `json:"asset"`
+	Height        int64        `json:"height"`
+	BalanceRune   cosmos.Uint  `json:"balance_rune"`
+	BalanceAsset  cosmos.Uint  `json:"balance_asset"`
+	PoolUnits     cosmos.Uint  `json:"pool_units"`
+	LiquidityFees cosmos.Uint  `json:"liquidity_fees"`
+}
+
+// NewPoolSnapshot create a new snapshot of the given pool
+func NewPoolSnapshot(pool Pool, height int64, liquidityFees cosmos.Uint) PoolSnapshot {
+	return PoolSnapshot{
+		Asset:         pool.Asset,
+		Height:        height,
+		BalanceRune:   pool.BalanceRune,
+		BalanceAsset:  pool.BalanceAsset,
+		PoolUnits:     pool.PoolUnits,
+		LiquidityFees: liquidityFees,
+	}
+}
+
+// Valid check whether the snapshot has all the necessary fields
+func (s PoolSnapshot) Valid() error {
+	if s.Asset.IsEmpty() {
+		return errors.New("asset cannot be empty")
+	}
+	if s.Height <= 0 {
+		return errors.New("height must be greater than zero")
+	}
+	return nil
+}
+
+// IsEmpty return true when the snapshot doesn't have a height
+func (s PoolSnapshot) IsEmpty() bool {
+	return s.Height == 0
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type PoolSnapshotSuite struct{}
+
+var _ = Suite(&PoolSnapshotSuite{})
+
+func (PoolSnapshotSuite) TestPoolSnapshot(c *C) {
+	pool := NewPool()
+	pool.Asset = common.BNBAsset
+	pool.BalanceRune = cosmos.NewUint(100)
+	pool.BalanceAsset = cosmos.NewUint(50)
+	pool.PoolUnits = cosmos.NewUint(100)
+	s := NewPoolSnapshot(pool, 10, cosmos.NewUint(3))
+	c.Check(s.Valid(), IsNil)
+	c.Check(s.IsEmpty(), Equals, false)
+	c.Check(s.Asset.Equals(common.BNBAsset), Equals, true)
+	c.Check(s.BalanceRune.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(s.BalanceAsset.Equal(cosmos.NewUint(50)), Equals, true)
+	c.Check(s.PoolUnits.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(s.LiquidityFees.Equal(cosmos.NewUint(3)), Equals, true)
+	c.Check(PoolSnapshot{}.IsEmpty(), Equals, true)
+
+	c.Check(NewPoolSnapshot(pool, 0, cosmos.ZeroUint()).Valid(), NotNil)
+	c.Check(NewPoolSnapshot(NewPool(), 10, cosmos.ZeroUint()).Valid(), NotNil)
+}
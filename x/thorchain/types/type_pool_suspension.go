@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+// PoolSuspension keep track of a pool governance suspended , the status it had before , and the progress of its delist
+// DelistHeight is zero until the delist starts , Position is the staker the delist continues to unstake from
+// UnstakedHeight is the height all the stakers were out of the delisting pool , zero until then
+type PoolSuspension struct {
+	Asset           common.Asset `json:"asset"`
+	PreviousStatus  PoolStatus   `json:"previous_status"`
+	SuspendedHeight int64        `json:"suspended_height"`
+	DelistHeight    int64        `json:"delist_height"`
+	Position        int64        `json:"position"`
+	UnstakedHeight  int64        `json:"unstaked_height"`
+}
+
+// NewPoolSuspension create a new instance of PoolSuspension
+func NewPoolSuspension(asset common.Asset, previousStatus PoolStatus, height int64) PoolSuspension {
+	return PoolSuspension{
+		Asset:           asset,
+		PreviousStatus:  previousStatus,
+		SuspendedHeight: height,
+	}
+}
+
+// Valid check whether the pool suspension has all the necessary fields
+func (s PoolSuspension) Valid() error {
+	if s.Asset.IsEmpty() {
+		return errors.New("asset cannot be empty")
+	}
+	if s.SuspendedHeight <= 0 {
+		return errors.New("suspended height must be greater than zero")
+	}
+	if s.PreviousStatus == Suspended {
+		return errors.New("previous status cannot be suspended")
+	}
+	return nil
+}
+
+// IsEmpty return true when the pool is not suspended
+func (s PoolSuspension) IsEmpty() bool {
+	return s.Asset.IsEmpty()
+}
+
+// IsDelisting return true when the delist of the pool started
+func (s PoolSuspension) IsDelisting() bool {
+	return s.DelistHeight > 0
+}
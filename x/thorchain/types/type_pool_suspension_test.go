@@ -0,0 +1,25 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+)
+
+type PoolSuspensionSuite struct{}
+
+var _ = Suite(&PoolSuspensionSuite{})
+
+func (PoolSuspensionSuite) TestPoolSuspension(c *C) {
+	s := NewPoolSuspension(common.BNBAsset, Enabled, 10)
+	c.Check(s.Valid(), IsNil)
+	c.Check(s.IsEmpty(), Equals, false)
+	c.Check(s.IsDelisting(), Equals, false)
+	s.DelistHeight = 20
+	c.Check(s.IsDelisting(), Equals, true)
+	c.Check(PoolSuspension{}.IsEmpty(), Equals, true)
+
+	c.Check(NewPoolSuspension(common.Asset{}, Enabled, 10).Valid(), NotNil)
+	c.Check(NewPoolSuspension(common.BNBAsset, Enabled, 0).Valid(), NotNil)
+	c.Check(NewPoolSuspension(common.BNBAsset, Suspended, 10).Valid(), NotNil)
+}
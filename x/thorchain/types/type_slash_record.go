@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// the reasons a node account get slashed
+const (
+	SlashReasonObserve          = `observe`
+	SlashReasonObserveRedeem    = `observe_flex_redemption`
+	SlashReasonLackOfObserving  = `lack_of_observing`
+	SlashReasonFailKeygen       = `fail_keygen`
+	SlashReasonFailKeysign      = `fail_keysign`
+	SlashReasonFailSendOutbound = `fail_send_outbound`
+	SlashReasonDoubleSign       = `double_sign`
+	SlashReasonLostFunds        = `lost_funds`
+)
+
+// SlashRecord is an entry in the slash ledger of a node account , Points is the change of slash points , negative when they are redeemed
+// Bond is the RUNE taken from the bond of the node account , if any , TxID , PubKey and Chain are the tx , vault and chain it relates to , if any
+type SlashRecord struct {
+	NodeAddress cosmos.AccAddress `json:"node_address"`
+	Height      int64             `json:"height"`
+	Points      int64             `json:"points"`
+	Bond        cosmos.Uint       `json:"bond"`
+	Reason      string            `json:"reason"`
+	TxID        common.TxID       `json:"tx_id,omitempty"`
+	PubKey      common.PubKey     `json:"pub_key,omitempty"`
+	Chain       common.Chain      `json:"chain,omitempty"`
+}
+
+// NewSlashRecord create a new instance of SlashRecord
+func NewSlashRecord(addr cosmos.AccAddress, height, points int64, reason string, txID common.TxID, pubKey common.PubKey) SlashRecord {
+	return SlashRecord{
+		NodeAddress: addr,
+		Height:      height,
+		Points:      points,
+		Bond:        cosmos.ZeroUint(),
+		Reason:      reason,
+		TxID:        txID,
+		PubKey:      pubKey,
+	}
+}
+
+// Valid check whether the slash record has all the fields it needs
+func (r SlashRecord) Valid() error {
+	if r.NodeAddress.Empty() {
+		return errors.New("node address cannot be empty")
+	}
+	if r.Height <= 0 {
+		return errors.New("height must be positive")
+	}
+	if len(r.Reason) == 0 {
+		return errors.New("reason cannot be empty")
+	}
+	return nil
+}
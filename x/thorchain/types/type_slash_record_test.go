@@ -0,0 +1,27 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type SlashRecordSuite struct{}
+
+var _ = Suite(&SlashRecordSuite{})
+
+func (SlashRecordSuite) TestSlashRecord(c *C) {
+	addr := GetRandomBech32Addr()
+	txID := GetRandomTxHash()
+	record := NewSlashRecord(addr, 10, 2, SlashReasonObserve, txID, common.EmptyPubKey)
+	c.Check(record.Valid(), IsNil)
+	c.Check(record.NodeAddress.Equals(addr), Equals, true)
+	c.Check(record.Points, Equals, int64(2))
+	c.Check(record.Bond.IsZero(), Equals, true)
+	c.Check(record.TxID.Equals(txID), Equals, true)
+
+	c.Check(NewSlashRecord(cosmos.AccAddress{}, 10, 2, SlashReasonObserve, txID, common.EmptyPubKey).Valid(), NotNil)
+	c.Check(NewSlashRecord(addr, 0, 2, SlashReasonObserve, txID, common.EmptyPubKey).Valid(), NotNil)
+	c.Check(NewSlashRecord(addr, 10, 2, "", txID, common.EmptyPubKey).Valid(), NotNil)
+}
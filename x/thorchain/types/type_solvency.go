@@ -0,0 +1,154 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// SolvencyVoter is used to book keep who voted for the balance of a vault observed on an external chain at a height , the
+// nodes don't have to observe exactly the same coins , once enough nodes voted the balance is aggregated from their reports
+// into a VaultSolvency , and the voter is removed
+type SolvencyVoter struct {
+	ID      common.TxID         `json:"id"`
+	Chain   common.Chain        `json:"chain"`
+	PubKey  common.PubKey       `json:"pub_key"`
+	Height  int64               `json:"height"` // block height of the external chain
+	Signers []cosmos.AccAddress `json:"signers"`
+	Reports []common.Coins      `json:"reports"` // the coins observed by each of the signers , in the same order
+}
+
+// VaultSolvency is the latest balance of a vault on an external chain that reached consensus
+type VaultSolvency struct {
+	PubKey      common.PubKey `json:"pub_key"`
+	Chain       common.Chain  `json:"chain"`
+	Coins       common.Coins  `json:"coins"`
+	Height      int64         `json:"height"`       // block height of the external chain
+	BlockHeight int64         `json:"block_height"` // the THORNode block height the balance reach consensus
+}
+
+// NewSolvencyVoter create a new instance of SolvencyVoter
+func NewSolvencyVoter(chain common.Chain, pubKey common.PubKey, height int64) SolvencyVoter {
+	return SolvencyVoter{
+		ID:     GetSolvencyID(chain, pubKey, height),
+		Chain:  chain,
+		PubKey: pubKey,
+		Height: height,
+	}
+}
+
+// GetSolvencyID return the hash that identify the solvency reports of a vault on a chain at the given height
+func GetSolvencyID(chain common.Chain, pubKey common.PubKey, height int64) common.TxID {
+	str := fmt.Sprintf("%s|%s|%d", chain, pubKey, height)
+	return common.TxID(fmt.Sprintf("%X", sha256.Sum256([]byte(str))))
+}
+
+// HasSigned - check if given address has signed
+func (s *SolvencyVoter) HasSigned(signer cosmos.AccAddress) bool {
+	for _, sign := range s.Signers {
+		if sign.Equals(signer) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign this voter with given signer address and the coins the signer observed
+func (s *SolvencyVoter) Sign(signer cosmos.AccAddress, coins common.Coins) bool {
+	if s.HasSigned(signer) {
+		return false
+	}
+	s.Signers = append(s.Signers, signer)
+	s.Reports = append(s.Reports, coins)
+	return true
+}
+
+// HasConsensus Determine if this solvency report has enough signers
+func (s *SolvencyVoter) HasConsensus(nas NodeAccounts) bool {
+	var count int
+	for _, signer := range s.Signers {
+		if nas.IsNodeKeys(signer) {
+			count++
+		}
+	}
+	return HasSuperMajority(count, len(nas))
+}
+
+// GetConsensusCoins aggregate the coins reported by the given node accounts , the amount of every asset is the median of
+// the amounts they reported , an asset missing from a report counts as zero. As long as more than half of the reports are
+// honest , the result is within the range of the honest reports
+func (s *SolvencyVoter) GetConsensusCoins(nas NodeAccounts) common.Coins {
+	assets := make([]common.Asset, 0)
+	reports := make([]common.Coins, 0, len(s.Reports))
+	for i, signer := range s.Signers {
+		if i >= len(s.Reports) || !nas.IsNodeKeys(signer) {
+			continue
+		}
+		reports = append(reports, s.Reports[i])
+		for _, coin := range s.Reports[i] {
+			found := false
+			for _, asset := range assets {
+				if asset.Equals(coin.Asset) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				assets = append(assets, coin.Asset)
+			}
+		}
+	}
+	sort.SliceStable(assets, func(i, j int) bool {
+		return assets[i].String() < assets[j].String()
+	})
+	coins := make(common.Coins, 0, len(assets))
+	for _, asset := range assets {
+		amounts := make([]cosmos.Uint, len(reports))
+		for i, report := range reports {
+			amounts[i] = report.GetCoin(asset).Amount
+		}
+		sort.SliceStable(amounts, func(i, j int) bool {
+			return amounts[i].LT(amounts[j])
+		})
+		median := amounts[len(amounts)/2]
+		if median.IsZero() {
+			continue
+		}
+		coins = append(coins, common.NewCoin(asset, median))
+	}
+	return coins
+}
+
+// IsEmpty return true when the ID is empty
+func (s *SolvencyVoter) IsEmpty() bool {
+	return s.ID.IsEmpty()
+}
+
+// String implement fmt.Stringer
+func (s *SolvencyVoter) String() string {
+	return s.ID.String()
+}
+
+// NewVaultSolvency create a new instance of VaultSolvency
+func NewVaultSolvency(pubKey common.PubKey, chain common.Chain, coins common.Coins, height, blockHeight int64) VaultSolvency {
+	return VaultSolvency{
+		PubKey:      pubKey,
+		Chain:       chain,
+		Coins:       coins,
+		Height:      height,
+		BlockHeight: blockHeight,
+	}
+}
+
+// IsEmpty return true when the vault pubkey is empty
+func (s VaultSolvency) IsEmpty() bool {
+	return s.PubKey.IsEmpty()
+}
+
+// String implement fmt.Stringer
+func (s VaultSolvency) String() string {
+	return fmt.Sprintf("%s-%s", s.PubKey, s.Chain)
+}
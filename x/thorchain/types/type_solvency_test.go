@@ -0,0 +1,87 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type SolvencyTestSuite struct{}
+
+var _ = Suite(&SolvencyTestSuite{})
+
+func (SolvencyTestSuite) TestSolvencyVoter(c *C) {
+	pubKey := GetRandomPubKey()
+	coins := common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(common.One)),
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(2*common.One)),
+	}
+	voter := NewSolvencyVoter(common.BNBChain, pubKey, 1024)
+	c.Check(voter.IsEmpty(), Equals, false)
+	c.Check(voter.String(), Equals, voter.ID.String())
+	c.Check(GetSolvencyID(common.BNBChain, pubKey, 1024).Equals(voter.ID), Equals, true)
+	c.Check(GetSolvencyID(common.BNBChain, pubKey, 1025).Equals(voter.ID), Equals, false)
+	c.Check(GetSolvencyID(common.BTCChain, pubKey, 1024).Equals(voter.ID), Equals, false)
+
+	addr := GetRandomBech32Addr()
+	c.Check(voter.HasSigned(addr), Equals, false)
+	c.Check(voter.Sign(addr, coins), Equals, true)
+	c.Check(voter.HasSigned(addr), Equals, true)
+	c.Check(voter.Sign(addr, coins), Equals, false)
+	c.Check(voter.Signers, HasLen, 1)
+	c.Check(voter.Reports, HasLen, 1)
+	c.Check(voter.HasConsensus(nil), Equals, false)
+	nas := NodeAccounts{
+		NodeAccount{NodeAddress: addr, Status: Active},
+		NodeAccount{NodeAddress: GetRandomBech32Addr(), Status: Active},
+	}
+	c.Check(voter.HasConsensus(nas), Equals, false)
+	// nodes can observe a different balance and still vote for the same voter
+	c.Check(voter.Sign(nas[1].NodeAddress, coins[:1]), Equals, true)
+	c.Check(voter.HasConsensus(nas), Equals, true)
+
+	var empty SolvencyVoter
+	c.Check(empty.IsEmpty(), Equals, true)
+}
+
+func (SolvencyTestSuite) TestGetConsensusCoins(c *C) {
+	voter := NewSolvencyVoter(common.BNBChain, GetRandomPubKey(), 1024)
+	nas := NodeAccounts{
+		NodeAccount{NodeAddress: GetRandomBech32Addr(), Status: Active},
+		NodeAccount{NodeAddress: GetRandomBech32Addr(), Status: Active},
+		NodeAccount{NodeAddress: GetRandomBech32Addr(), Status: Active},
+	}
+	c.Check(voter.GetConsensusCoins(nas), HasLen, 0)
+	c.Check(voter.Sign(nas[0].NodeAddress, common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(100)),
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(50)),
+	}), Equals, true)
+	c.Check(voter.Sign(nas[1].NodeAddress, common.Coins{
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(90)),
+	}), Equals, true)
+	c.Check(voter.Sign(nas[2].NodeAddress, common.Coins{
+		common.NewCoin(common.RuneAsset(), cosmos.NewUint(60)),
+		common.NewCoin(common.BNBAsset, cosmos.NewUint(1000000)),
+	}), Equals, true)
+	// the report of a node that is no longer active is left out
+	c.Check(voter.Sign(GetRandomBech32Addr(), common.Coins{
+		common.NewCoin(common.BTCAsset, cosmos.NewUint(1000000)),
+	}), Equals, true)
+
+	coins := voter.GetConsensusCoins(nas)
+	c.Assert(coins, HasLen, 2)
+	c.Check(coins.GetCoin(common.BNBAsset).Amount.Equal(cosmos.NewUint(100)), Equals, true)
+	c.Check(coins.GetCoin(common.RuneAsset()).Amount.Equal(cosmos.NewUint(50)), Equals, true)
+	c.Check(coins.GetCoin(common.BTCAsset).IsEmpty(), Equals, true)
+}
+
+func (SolvencyTestSuite) TestVaultSolvency(c *C) {
+	pubKey := GetRandomPubKey()
+	solvency := NewVaultSolvency(pubKey, common.BNBChain, common.Coins{}, 1024, 10)
+	c.Check(solvency.IsEmpty(), Equals, false)
+	c.Check(solvency.Height, Equals, int64(1024))
+	c.Check(solvency.BlockHeight, Equals, int64(10))
+	c.Check(len(solvency.String()) > 0, Equals, true)
+	c.Check(VaultSolvency{}.IsEmpty(), Equals, true)
+}
@@ -18,6 +18,8 @@ type Staker struct {
 	Units             cosmos.Uint    `json:"units"`
 	PendingRune       cosmos.Uint    `json:"pending_rune"` // number of rune coins
 	PendingTxID       common.TxID    `json:"pending_tx_id"`
+	RuneDeposit       cosmos.Uint    `json:"rune_deposit"`  // total rune staked , reduced pro-rata on unstake
+	AssetDeposit      cosmos.Uint    `json:"asset_deposit"` // total asset staked , reduced pro-rata on unstake
 }
 
 // Valid check whether staker represent valid information
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+// MaxStreamingSwapFailures is the number of the latest failed sub-swaps a streaming swap keep the reason of
+const MaxStreamingSwapFailures = 10
+
+// StreamingSwap keep track of a swap that is split into multiple sub-swaps , one sub-swap is executed every Interval blocks
+// the swapped output is accumulated and sent out in one outbound when all sub-swaps are done
+type StreamingSwap struct {
+	TxID              common.TxID `json:"tx_id"`
+	Interval          int64       `json:"interval"`
+	Quantity          int64       `json:"quantity"`
+	Count             int64       `json:"count"`
+	LastHeight        int64       `json:"last_height"`
+	Deposit           cosmos.Uint `json:"deposit"`
+	In                cosmos.Uint `json:"in"`
+	Out               cosmos.Uint `json:"out"`
+	FailedSwaps       []int64     `json:"failed_swaps"`
+	FailedSwapReasons []string    `json:"failed_swap_reasons"`
+}
+
+// StreamingSwaps a list of streaming swaps
+type StreamingSwaps []StreamingSwap
+
+// NewStreamingSwap create a new instance of StreamingSwap
+func NewStreamingSwap(txID common.TxID, interval, quantity int64, deposit cosmos.Uint) StreamingSwap {
+	return StreamingSwap{
+		TxID:              txID,
+		Interval:          interval,
+		Quantity:          quantity,
+		Deposit:           deposit,
+		In:                cosmos.ZeroUint(),
+		Out:               cosmos.ZeroUint(),
+		FailedSwaps:       make([]int64, 0),
+		FailedSwapReasons: make([]string, 0),
+	}
+}
+
+// Valid check whether the streaming swap has all the necessary fields
+func (s StreamingSwap) Valid() error {
+	if s.TxID.IsEmpty() {
+		return errors.New("tx id cannot be empty")
+	}
+	if s.Interval < 0 {
+		return errors.New("interval cannot be negative")
+	}
+	if s.Quantity <= 0 {
+		return errors.New("quantity must be positive")
+	}
+	if s.Deposit.IsZero() {
+		return errors.New("deposit cannot be zero")
+	}
+	return nil
+}
+
+// IsEmpty return true when the streaming swap has not been initialised yet
+func (s StreamingSwap) IsEmpty() bool {
+	return s.Quantity == 0
+}
+
+// IsDone return true when all the sub-swaps have been processed
+func (s StreamingSwap) IsDone() bool {
+	return s.Count >= s.Quantity
+}
+
+// IsReady return true when the next sub-swap can be executed on the given block height
+func (s StreamingSwap) IsReady(height int64) bool {
+	if s.IsDone() {
+		return false
+	}
+	return s.Count == 0 || height >= s.LastHeight+s.Interval
+}
+
+// NextSize return the amount of source asset to swap in the next sub-swap
+// the last sub-swap take whatever the rounding left behind
+func (s StreamingSwap) NextSize() cosmos.Uint {
+	if s.IsDone() {
+		return cosmos.ZeroUint()
+	}
+	size := s.Deposit.QuoUint64(uint64(s.Quantity))
+	if s.Count == s.Quantity-1 {
+		return common.SafeSub(s.Deposit, size.MulUint64(uint64(s.Quantity-1)))
+	}
+	return size
+}
+
+// AddFailedSwap record the given sub-swap failed for the given reason , only the latest MaxStreamingSwapFailures are kept
+func (s *StreamingSwap) AddFailedSwap(count int64, reason string) {
+	s.FailedSwaps = append(s.FailedSwaps, count)
+	s.FailedSwapReasons = append(s.FailedSwapReasons, reason)
+	if len(s.FailedSwaps) > MaxStreamingSwapFailures {
+		s.FailedSwaps = s.FailedSwaps[len(s.FailedSwaps)-MaxStreamingSwapFailures:]
+		s.FailedSwapReasons = s.FailedSwapReasons[len(s.FailedSwapReasons)-MaxStreamingSwapFailures:]
+	}
+}
+
+// Remainder return the amount of source asset that has not been swapped
+func (s StreamingSwap) Remainder() cosmos.Uint {
+	return common.SafeSub(s.Deposit, s.In)
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	. "gopkg.in/check.v1"
+
+	"gitlab.com/thorchain/thornode/common"
+	"gitlab.com/thorchain/thornode/common/cosmos"
+)
+
+type StreamingSwapSuite struct{}
+
+var _ = Suite(&StreamingSwapSuite{})
+
+func (StreamingSwapSuite) TestStreamingSwap(c *C) {
+	txID := GetRandomTxHash()
+	s := NewStreamingSwap(txID, 2, 3, cosmos.NewUint(100))
+	c.Check(s.Valid(), IsNil)
+	c.Check(s.IsEmpty(), Equals, false)
+	c.Check(s.IsDone(), Equals, false)
+	c.Check(s.IsReady(1), Equals, true)
+	c.Check(s.NextSize().Uint64(), Equals, uint64(33))
+
+	s.Count = 1
+	s.LastHeight = 10
+	s.In = cosmos.NewUint(33)
+	c.Check(s.IsReady(11), Equals, false)
+	c.Check(s.IsReady(12), Equals, true)
+	c.Check(s.NextSize().Uint64(), Equals, uint64(33))
+	c.Check(s.Remainder().Uint64(), Equals, uint64(67))
+
+	// last sub-swap take the rounding left over
+	s.Count = 2
+	c.Check(s.NextSize().Uint64(), Equals, uint64(34))
+
+	s.Count = 3
+	c.Check(s.IsDone(), Equals, true)
+	c.Check(s.IsReady(100), Equals, false)
+	c.Check(s.NextSize().IsZero(), Equals, true)
+
+	c.Check(StreamingSwap{}.IsEmpty(), Equals, true)
+	c.Check(NewStreamingSwap(common.TxID(""), 1, 1, cosmos.NewUint(1)).Valid(), NotNil)
+	c.Check(NewStreamingSwap(txID, -1, 1, cosmos.NewUint(1)).Valid(), NotNil)
+	c.Check(NewStreamingSwap(txID, 1, 0, cosmos.NewUint(1)).Valid(), NotNil)
+	c.Check(NewStreamingSwap(txID, 1, 1, cosmos.ZeroUint()).Valid(), NotNil)
+
+	// only the latest failed sub-swaps are kept
+	s = NewStreamingSwap(txID, 1, 20, cosmos.NewUint(100))
+	for i := int64(1); i <= 15; i++ {
+		s.AddFailedSwap(i, "fail")
+	}
+	c.Check(s.FailedSwaps, HasLen, MaxStreamingSwapFailures)
+	c.Check(s.FailedSwapReasons, HasLen, MaxStreamingSwapFailures)
+	c.Check(s.FailedSwaps[0], Equals, int64(6))
+	c.Check(s.FailedSwaps[MaxStreamingSwapFailures-1], Equals, int64(15))
+}
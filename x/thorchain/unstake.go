@@ -117,7 +117,9 @@ func unstake(ctx cosmos.Context, version semver.Version, keeper keeper.Keeper, m
 	pool.BalanceAsset = common.SafeSub(poolAsset, withDrawAsset)
 
 	ctx.Logger().Info("pool after unstake", "pool unit", pool.PoolUnits, "balance RUNE", pool.BalanceRune, "balance asset", pool.BalanceAsset)
-	// update staker
+	// update staker , the deposits are reduced in the same proportion as the units
+	stakerUnit.RuneDeposit = common.GetShare(unitAfter, fStakerUnit, stakerUnit.RuneDeposit)
+	stakerUnit.AssetDeposit = common.GetShare(unitAfter, fStakerUnit, stakerUnit.AssetDeposit)
 	stakerUnit.Units = unitAfter
 	stakerUnit.LastUnStakeHeight = common.BlockHeight(ctx)
 
@@ -145,6 +147,37 @@ func unstake(ctx cosmos.Context, version semver.Version, keeper keeper.Keeper, m
 	return withdrawRune, withDrawAsset, common.SafeSub(fStakerUnit, unitAfter), gasAsset, nil
 }
 
+// swapUnstakeToTarget swap the withdrawn side which is not the target asset through the same pool , so the staker get back a single asset
+// it returns runeAmt,assetAmount after the swap , and the swap event
+// the swap is refused when trading on the chain of the pool is halted
+func swapUnstakeToTarget(ctx cosmos.Context, keeper keeper.Keeper, msg MsgUnStake, runeAmt, assetAmount, transactionFee cosmos.Uint) (cosmos.Uint, cosmos.Uint, EventSwap, error) {
+	if isTradingHalted(ctx, keeper, msg.Asset.Chain) {
+		return runeAmt, assetAmount, EventSwap{}, errTradingHalted
+	}
+	source := common.RuneAsset()
+	amount := runeAmt
+	if msg.TargetAsset.IsRune() {
+		source = msg.Asset
+		amount = assetAmount
+	}
+	if amount.IsZero() {
+		return runeAmt, assetAmount, EventSwap{}, errSwapFailInvalidAmount
+	}
+	tx := msg.Tx
+	tx.Coins = common.Coins{common.NewCoin(source, amount)}
+	emit, pool, evt, err := swapOne(ctx, keeper, tx, msg.TargetAsset, msg.RuneAddress, cosmos.ZeroUint(), transactionFee)
+	if err != nil {
+		return runeAmt, assetAmount, EventSwap{}, err
+	}
+	if err := keeper.SetPool(ctx, pool); err != nil {
+		return runeAmt, assetAmount, EventSwap{}, ErrInternal(err, "fail to save pool")
+	}
+	if msg.TargetAsset.IsRune() {
+		return runeAmt.Add(emit), cosmos.ZeroUint(), evt, nil
+	}
+	return cosmos.ZeroUint(), assetAmount.Add(emit), evt, nil
+}
+
 func calculateUnstake(poolUnits, poolRune, poolAsset, stakerUnits, withdrawBasisPoints cosmos.Uint) (cosmos.Uint, cosmos.Uint, cosmos.Uint, error) {
 	if poolUnits.IsZero() {
 		return cosmos.ZeroUint(), cosmos.ZeroUint(), cosmos.ZeroUint(), errors.New("poolUnits can't be zero")
@@ -70,10 +70,12 @@ func (p *UnstakeTestKeeper) GetStaker(ctx cosmos.Context, asset common.Asset, ad
 		return Staker{}, errors.New("simulate error for test")
 	}
 	staker := Staker{
-		Asset:       asset,
-		RuneAddress: addr,
-		Units:       cosmos.ZeroUint(),
-		PendingRune: cosmos.ZeroUint(),
+		Asset:        asset,
+		RuneAddress:  addr,
+		Units:        cosmos.ZeroUint(),
+		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}
 	key := p.GetKey(ctx, "staker/", staker.Key())
 	if res, ok := p.store[key]; ok {
@@ -513,6 +515,8 @@ func getUnstakeTestKeeper(c *C) keeper.Keeper {
 		AssetAddress: runeAddress,
 		Units:        cosmos.NewUint(100 * common.One),
 		PendingRune:  cosmos.ZeroUint(),
+		RuneDeposit:  cosmos.ZeroUint(),
+		AssetDeposit: cosmos.ZeroUint(),
 	}
 	store.SetStaker(ctx, staker)
 	return store